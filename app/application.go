@@ -2,8 +2,13 @@ package app
 
 import (
 	"fmt"
+	"shpankids/domain/ai"
+	"shpankids/domain/aiusage"
 	"shpankids/domain/assignment"
+	"shpankids/domain/audit"
 	"shpankids/domain/family"
+	"shpankids/domain/onboarding"
+	"shpankids/domain/points"
 	"shpankids/domain/session"
 	"shpankids/domain/user"
 	"shpankids/infra/database/kvstore"
@@ -11,15 +16,28 @@ import (
 	"shpankids/webserver/auth"
 )
 
-func Start(kvs kvstore.RawJsonStore) error {
+func Start(kvs kvstore.RawJsonStore, aiProvider ai.Provider) error {
 	userManager := user.NewUserManager(kvs)
-	familyManager := family.NewFamilyManager(kvs, auth.GetUserInfo)
+	familyManager := family.NewFamilyManager(kvs, auth.GetUserInfo, aiProvider, userManager)
 	sessionManager := session.NewSessionManager(kvs)
 	assignmentManager := assignment.NewAssignmentManager(kvs, auth.GetUserInfo, familyManager, sessionManager)
+	onboardingManager := onboarding.NewOnboardingManager(auth.GetUserInfo, userManager, familyManager, sessionManager)
+	pointsManager := points.NewPointsManager(kvs, auth.GetUserInfo, familyManager, sessionManager)
+	auditManager := audit.NewAuditManager(kvs, auth.GetUserInfo, familyManager, sessionManager)
+	aiUsageManager := aiusage.NewAiUsageManager(kvs, auth.GetUserInfo, familyManager)
 
 	err := appBootstrap(userManager, familyManager, sessionManager)
 	if err != nil {
 		return fmt.Errorf("failed to bootstrap app: %v", err)
 	}
-	return webserver.Start(assignmentManager, userManager, familyManager, sessionManager)
+	return webserver.Start(
+		assignmentManager,
+		userManager,
+		familyManager,
+		sessionManager,
+		onboardingManager,
+		pointsManager,
+		auditManager,
+		aiUsageManager,
+	)
 }
@@ -2,30 +2,43 @@ package gemini
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/generative-ai-go/genai"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
-	"shpankids/shpankids"
+	"io"
+	"shpankids/domain/ai"
+	"shpankids/infra/shpanstream"
 	"sync"
 )
 
-var client *genai.Client
-var once sync.Once
-
 const defaultGeminiModel = "gemini-2.0-flash"
 
-func GetClient(ctx context.Context) (*genai.Client, error) {
-	var innerErr error
-	once.Do(func() {
-		client, innerErr = genai.NewClient(ctx, option.WithAPIKey(shpankids.GetSecrets().Gemini.ApiKey))
+// Provider is the Google Gemini ai.Provider, the client is created on first use
+type Provider struct {
+	apiKey    string
+	client    *genai.Client
+	clientErr error
+	once      sync.Once
+}
+
+func NewProvider(apiKey string) *Provider {
+	return &Provider{apiKey: apiKey}
+}
+
+func (p *Provider) GetClient(ctx context.Context) (*genai.Client, error) {
+	p.once.Do(func() {
+		p.client, p.clientErr = genai.NewClient(ctx, option.WithAPIKey(p.apiKey))
 	})
-	if innerErr != nil {
-		return nil, innerErr
+	if p.clientErr != nil {
+		return nil, p.clientErr
 	}
-	return client, nil
+	return p.client, nil
 }
 
-func GetDefaultModel(ctx context.Context) (*genai.GenerativeModel, error) {
-	c, err := GetClient(ctx)
+func (p *Provider) GetDefaultModel(ctx context.Context) (*genai.GenerativeModel, error) {
+	c, err := p.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -38,3 +51,105 @@ func GetDefaultModel(ctx context.Context) (*genai.GenerativeModel, error) {
 	return model, err
 
 }
+
+func (p *Provider) GenerateJsonStream(ctx context.Context, request ai.JsonRequest) shpanstream.Stream[string] {
+	if len(request.Messages) == 0 {
+		return shpanstream.NewErrorStream[string](fmt.Errorf("at least one message is required"))
+	}
+	model, err := p.GetDefaultModel(ctx)
+	if err != nil {
+		return shpanstream.NewErrorStream[string](err)
+	}
+	model.ResponseMIMEType = "application/json"
+	model.ResponseSchema = toGenaiSchema(request.Schema)
+
+	session := model.StartChat()
+	for _, m := range request.Messages[:len(request.Messages)-1] {
+		session.History = append(session.History, &genai.Content{
+			Role:  string(m.Role),
+			Parts: []genai.Part{genai.Text(m.Text)},
+		})
+	}
+
+	var respIterator *genai.GenerateContentResponseIterator
+
+	// Each response holds the usage of the request so far, the last one holds the total
+	var usage *genai.UsageMetadata
+	return shpanstream.NewSimpleStream(
+		func(ctx context.Context) (*string, error) {
+			for {
+				resp, err := respIterator.Next()
+				if err != nil {
+					if errors.Is(err, iterator.Done) {
+						if usage != nil {
+							request.ReportUsage(ai.Usage{
+								PromptTokens:   int(usage.PromptTokenCount),
+								ResponseTokens: int(usage.CandidatesTokenCount),
+							})
+						}
+						return nil, io.EOF
+					}
+					return nil, err
+				}
+				if resp.UsageMetadata != nil {
+					usage = resp.UsageMetadata
+				}
+				if len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil {
+					continue
+				}
+				chunk := ""
+				for _, part := range resp.Candidates[0].Content.Parts {
+					chunk += fmt.Sprintf("%s", part)
+				}
+				if chunk != "" {
+					return &chunk, nil
+				}
+			}
+		},
+		shpanstream.WithOpenFuncOption(func(ctx context.Context) error {
+			respIterator = session.SendMessageStream(ctx, genai.Text(request.Messages[len(request.Messages)-1].Text))
+			return nil
+		}),
+	)
+}
+
+func toGenaiSchema(s *ai.Schema) *genai.Schema {
+	if s == nil {
+		return nil
+	}
+	ret := &genai.Schema{
+		Type:        toGenaiType(s.Type),
+		Description: s.Description,
+		Enum:        s.Enum,
+		Nullable:    s.Nullable,
+		Required:    s.Required,
+		Items:       toGenaiSchema(s.Items),
+	}
+	if len(s.Enum) > 0 {
+		ret.Format = "enum"
+	}
+	if s.Properties != nil {
+		ret.Properties = map[string]*genai.Schema{}
+		for name, propSchema := range s.Properties {
+			ret.Properties[name] = toGenaiSchema(propSchema)
+		}
+	}
+	return ret
+}
+
+func toGenaiType(t ai.SchemaType) genai.Type {
+	switch t {
+	case ai.SchemaTypeObject:
+		return genai.TypeObject
+	case ai.SchemaTypeArray:
+		return genai.TypeArray
+	case ai.SchemaTypeString:
+		return genai.TypeString
+	case ai.SchemaTypeNumber:
+		return genai.TypeNumber
+	case ai.SchemaTypeBoolean:
+		return genai.TypeBoolean
+	default:
+		return genai.TypeUnspecified
+	}
+}
@@ -0,0 +1,89 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Accuracy thresholds for asking the model to adjust the difficulty of the problems
+const (
+	highAccuracyPercent = 85
+	lowAccuracyPercent  = 60
+)
+
+// LearnerProfile describes the family member problems are generated for, so the problems fit the member's level
+type LearnerProfile struct {
+	UserId    string
+	FirstName string
+
+	// Age in years, 0 when unknown
+	Age int
+
+	// RecentPerformance is the accuracy of the member in each of the problem sets with recent solutions
+	RecentPerformance []ProblemSetPerformance
+
+	// MissedProblems are titles of problems of the current problem set recently answered wrong, latest first
+	MissedProblems []string
+
+	// CurrentAccuracyPercent is the recent accuracy in the current problem set, -1 when there are no recent solutions
+	CurrentAccuracyPercent int
+}
+
+type ProblemSetPerformance struct {
+	ProblemSetTitle string
+	SolvedCount     int
+	CorrectCount    int
+}
+
+func (p ProblemSetPerformance) AccuracyPercent() int {
+	if p.SolvedCount == 0 {
+		return 0
+	}
+	return p.CorrectCount * 100 / p.SolvedCount
+}
+
+// DisplayName is how the member is referred to in prompts
+func (l LearnerProfile) DisplayName() string {
+	if l.FirstName != "" {
+		return l.FirstName
+	}
+	return l.UserId
+}
+
+// describe explains the model who the member is, and how the difficulty should adapt to the member's performance
+func (l LearnerProfile) describe() string {
+	var sb strings.Builder
+	if l.Age > 0 {
+		sb.WriteString(fmt.Sprintf("%s is %d years old, the problems must fit this age. ", l.DisplayName(), l.Age))
+	}
+	if len(l.RecentPerformance) > 0 {
+		performance := make([]string, len(l.RecentPerformance))
+		for i, p := range l.RecentPerformance {
+			performance[i] = fmt.Sprintf(
+				"%q %d of %d correct (%d%%)",
+				p.ProblemSetTitle,
+				p.CorrectCount,
+				p.SolvedCount,
+				p.AccuracyPercent(),
+			)
+		}
+		sb.WriteString(fmt.Sprintf("Recent accuracy of %s per topic: %s. ", l.DisplayName(), strings.Join(performance, ", ")))
+	}
+	switch {
+	case l.CurrentAccuracyPercent >= highAccuracyPercent:
+		sb.WriteString("The accuracy on this topic is high, make the problems more challenging. ")
+	case l.CurrentAccuracyPercent >= 0 && l.CurrentAccuracyPercent < lowAccuracyPercent:
+		sb.WriteString("The accuracy on this topic is low, make the problems easier and build up gradually. ")
+	}
+	if len(l.MissedProblems) > 0 {
+		missed := make([]string, len(l.MissedProblems))
+		for i, title := range l.MissedProblems {
+			missed[i] = fmt.Sprintf("%q", title)
+		}
+		sb.WriteString(fmt.Sprintf(
+			"Recently missed problems: %s. Include problems practicing the same skills, without repeating them. ",
+			strings.Join(missed, ", "),
+		))
+	}
+	return sb.String()
+}
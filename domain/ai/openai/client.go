@@ -0,0 +1,194 @@
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"shpankids/domain/ai"
+	"shpankids/infra/shpanstream"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBaseUrl = "https://api.openai.com/v1"
+	defaultModel   = "gpt-4o-mini"
+
+	// wrappedArrayProperty holds array responses, since the response format requires an object at the root. The
+	// wrapper is passed on as is, consumers of array responses skip it
+	wrappedArrayProperty = "items"
+)
+
+// Provider is an ai.Provider for any service exposing the OpenAI compatible chat completions API
+type Provider struct {
+	apiKey     string
+	baseUrl    string
+	model      string
+	httpClient *http.Client
+}
+
+// NewProvider creates a provider for the service at baseUrl, defaulting to OpenAI when baseUrl or model are empty
+func NewProvider(apiKey string, baseUrl string, model string) *Provider {
+	if baseUrl == "" {
+		baseUrl = defaultBaseUrl
+	}
+	if model == "" {
+		model = defaultModel
+	}
+	return &Provider{
+		apiKey:     apiKey,
+		baseUrl:    strings.TrimSuffix(baseUrl, "/"),
+		model:      model,
+		httpClient: &http.Client{Timeout: 2 * time.Minute},
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type jsonSchemaFormat struct {
+	Name   string     `json:"name"`
+	Schema *ai.Schema `json:"schema"`
+}
+
+type responseFormat struct {
+	Type       string            `json:"type"`
+	JsonSchema *jsonSchemaFormat `json:"json_schema,omitempty"`
+}
+
+type chatCompletionRequest struct {
+	Model          string         `json:"model"`
+	Messages       []chatMessage  `json:"messages"`
+	ResponseFormat responseFormat `json:"response_format"`
+	Stream         bool           `json:"stream"`
+	StreamOptions  streamOptions  `json:"stream_options"`
+}
+
+type streamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+type chatCompletionChunk struct {
+	Choices []struct {
+		Delta chatMessage `json:"delta"`
+	} `json:"choices"`
+
+	// Usage is only sent in the last chunk, before the end of the stream
+	Usage *struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+func (p *Provider) GenerateJsonStream(ctx context.Context, request ai.JsonRequest) shpanstream.Stream[string] {
+	if len(request.Messages) == 0 {
+		return shpanstream.NewErrorStream[string](fmt.Errorf("at least one message is required"))
+	}
+	schema := request.Schema
+	if schema != nil && schema.Type == ai.SchemaTypeArray {
+		schema = &ai.Schema{
+			Type:       ai.SchemaTypeObject,
+			Required:   []string{wrappedArrayProperty},
+			Properties: map[string]*ai.Schema{wrappedArrayProperty: schema},
+		}
+	}
+
+	format := responseFormat{Type: "json_object"}
+	if schema != nil {
+		format = responseFormat{
+			Type:       "json_schema",
+			JsonSchema: &jsonSchemaFormat{Name: "response", Schema: schema},
+		}
+	}
+	reqBody, err := json.Marshal(chatCompletionRequest{
+		Model:          p.model,
+		Messages:       toChatMessages(request.Messages),
+		ResponseFormat: format,
+		Stream:         true,
+		StreamOptions:  streamOptions{IncludeUsage: true},
+	})
+	if err != nil {
+		return shpanstream.NewErrorStream[string](err)
+	}
+
+	var respBody io.ReadCloser
+	var events *bufio.Scanner
+	return shpanstream.NewSimpleStream(
+		func(ctx context.Context) (*string, error) {
+			// Server sent events, each data line holds a chunk of the completion
+			for events.Scan() {
+				data, found := strings.CutPrefix(events.Text(), "data:")
+				if !found {
+					continue
+				}
+				data = strings.TrimSpace(data)
+				if data == "[DONE]" {
+					return nil, io.EOF
+				}
+				var chunk chatCompletionChunk
+				err := json.Unmarshal([]byte(data), &chunk)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse chat completion chunk: %v", err)
+				}
+				if chunk.Usage != nil {
+					request.ReportUsage(ai.Usage{
+						PromptTokens:   chunk.Usage.PromptTokens,
+						ResponseTokens: chunk.Usage.CompletionTokens,
+					})
+				}
+				if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+					return &chunk.Choices[0].Delta.Content, nil
+				}
+			}
+			if events.Err() != nil {
+				return nil, events.Err()
+			}
+			return nil, io.EOF
+		},
+		shpanstream.WithOpenFuncOption(func(ctx context.Context) error {
+			httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseUrl+"/chat/completions", bytes.NewReader(reqBody))
+			if err != nil {
+				return err
+			}
+			httpReq.Header.Set("Content-Type", "application/json")
+			httpReq.Header.Set("Accept", "text/event-stream")
+			if p.apiKey != "" {
+				httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+			}
+			httpResp, err := p.httpClient.Do(httpReq)
+			if err != nil {
+				return err
+			}
+			respBody = httpResp.Body
+			if httpResp.StatusCode != http.StatusOK {
+				errBody, _ := io.ReadAll(httpResp.Body)
+				return fmt.Errorf("chat completion failed with status %d: %s", httpResp.StatusCode, string(errBody))
+			}
+			events = bufio.NewScanner(httpResp.Body)
+			return nil
+		}),
+		shpanstream.WithCloseFuncOption(func() {
+			if respBody != nil {
+				_ = respBody.Close()
+			}
+		}),
+	)
+}
+
+func toChatMessages(messages []ai.Message) []chatMessage {
+	ret := make([]chatMessage, len(messages))
+	for i, m := range messages {
+		role := "user"
+		if m.Role == ai.RoleModel {
+			role = "assistant"
+		}
+		ret[i] = chatMessage{Role: role, Content: m.Text}
+	}
+	return ret
+}
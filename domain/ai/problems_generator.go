@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/google/generative-ai-go/genai"
-	"shpankids/domain/ai/gemini"
 	"shpankids/infra/shpanstream"
 	"shpankids/infra/util/functional"
 	"shpankids/openapi"
@@ -15,18 +13,12 @@ import (
 
 func GenerateProblems(
 	ctx context.Context,
-	forUserId string,
+	provider Provider,
+	learner LearnerProfile,
 	problemSet shpankids.FamilyProblemSetDto,
 	examples shpanstream.Stream[openapi.ApiProblemForEdit],
 	additionalRequestText string,
 ) shpanstream.Stream[openapi.ApiProblemForEdit] {
-	model, err := gemini.GetDefaultModel(ctx)
-	if err != nil {
-		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
-	}
-	model.ResponseMIMEType = "application/json"
-	model.ResponseSchema = apiProblemsForEditArrSchema()
-
 	strs, err := shpanstream.MapStreamWithError(
 		examples.Limit(10),
 		func(ctx context.Context, dto *openapi.ApiProblemForEdit) (*string, error) {
@@ -46,105 +38,150 @@ func GenerateProblems(
 	if additionalRequestText != "" {
 		additionalRequestText = fmt.Sprintf(". Additional request:%s", additionalRequestText)
 	}
-	session := model.StartChat()
-	session.History = []*genai.Content{
+	return generateProblems(ctx, provider, []Message{
 		{
-			Role: "user",
-			Parts: []genai.Part{
-				genai.Text(fmt.Sprintf(
-					"Generate a list of problems to challenge the family member %s, "+
-						"on the topic of %s %s. Make the outputs in JSON format.",
-					forUserId,
-					problemSet.Title,
-					problemSet.Description,
-				)),
-			},
+			Role: RoleUser,
+			Text: fmt.Sprintf(
+				"Generate a list of problems to challenge the family member %s, "+
+					"on the topic of %s %s. Make the outputs in JSON format.",
+				learner.DisplayName(),
+				problemSet.Title,
+				problemSet.Description,
+			),
 		},
 		{
-			Role: "model",
-			Parts: []genai.Part{
-				genai.Text(sampleFullJson),
-			},
+			Role: RoleModel,
+			Text: sampleFullJson,
 		},
-	}
+		{
+			Role: RoleUser,
+			Text: fmt.Sprintf(
+				"base on the examples provided, please suggest next problems for family member %s, "+
+					"on the same topic of %s. Make the outputs in JSON format. "+
+					"%s%s%s",
+				learner.DisplayName(),
+				problemSet.Title,
+				learner.describe(),
+				problemTypesInstructions,
+				additionalRequestText,
+			),
+		},
+	})
+}
 
-	resp, err := session.SendMessage(
-		ctx,
-		genai.Text(fmt.Sprintf(
-			"base on the examples provided, please suggest next problems for family member %s, "+
-				"on the same topic of %s. Make the outputs in JSON format. "+
-				"Each problem should have a title and a list of answers, "+
-				"from which only one is correct.%s",
-			forUserId,
-			problemSet.Title,
-			additionalRequestText,
-		)),
+// generateProblems sends the conversation to the provider, emitting each problem of the JSON array response as soon
+// as it is complete
+func generateProblems(
+	ctx context.Context,
+	provider Provider,
+	messages []Message,
+) shpanstream.Stream[openapi.ApiProblemForEdit] {
+	return shpanstream.JsonArrayElements[openapi.ApiProblemForEdit](
+		provider.GenerateJsonStream(ctx, JsonRequest{
+			Messages: messages,
+			Schema:   apiProblemsForEditArrSchema(),
+		}),
 	)
-
-	if err != nil {
-		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
-	}
-
-	strFullRespJson := ""
-	for _, part := range resp.Candidates[0].Content.Parts {
-		strFullRespJson += fmt.Sprintf("%s", part)
-	}
-	var parsedJsonProblems []openapi.ApiProblemForEdit
-	err = json.Unmarshal([]byte(strFullRespJson), &parsedJsonProblems)
-	if err != nil {
-		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
-	}
-	return shpanstream.Just(parsedJsonProblems...)
 }
 
-func apiProblemsForEditArrSchema() *genai.Schema {
-	return &genai.Schema{
-		Type:        genai.TypeArray,
+// problemTypesInstructions explains the model how each problem type is represented
+const problemTypesInstructions = "Each problem should have a title and a type. " +
+	"singleChoice problems have a list of answers, from which only one is correct. " +
+	"multipleSelect problems have a list of answers, from which one or more are correct. " +
+	"numeric problems have no answers, only a numericAnswer with the value and an allowed tolerance. " +
+	"shortText problems have no answers, only a list of acceptedAnswers with all the accepted variants. " +
+	"ordering problems have a list of answers listed in the correct order, none marked as correct. "
+
+func apiProblemsForEditArrSchema() *Schema {
+	return &Schema{
+		Type:        SchemaTypeArray,
 		Description: "List of next problems to challenge the family member with on the topic",
 		Items:       apiProblemForEditSchema(),
 	}
 }
 
-func apiProblemForEditSchema() *genai.Schema {
-	return &genai.Schema{
-		Type: genai.TypeObject,
+func apiProblemForEditSchema() *Schema {
+	return &Schema{
+		Type: SchemaTypeObject,
 		Required: []string{
+			"type",
 			"title",
 			"answers",
 		},
-		Properties: map[string]*genai.Schema{
+		Properties: map[string]*Schema{
+			"type": {
+				Type:        SchemaTypeString,
+				Description: "problem type, determines how the problem is answered",
+				Enum: []string{
+					string(openapi.SingleChoice),
+					string(openapi.MultipleSelect),
+					string(openapi.Numeric),
+					string(openapi.ShortText),
+					string(openapi.Ordering),
+				},
+				Nullable: false,
+			},
 			"title": {
-				Type:        genai.TypeString,
+				Type:        SchemaTypeString,
 				Description: "problem title and question",
 				Nullable:    false,
 			},
+			"numericAnswer": {
+				Type:        SchemaTypeObject,
+				Description: "the correct answer of numeric problems",
+				Nullable:    true,
+				Required: []string{
+					"value",
+				},
+				Properties: map[string]*Schema{
+					"value": {
+						Type:        SchemaTypeNumber,
+						Description: "the correct value",
+						Nullable:    false,
+					},
+					"tolerance": {
+						Type:        SchemaTypeNumber,
+						Description: "maximal allowed distance from the correct value",
+						Nullable:    true,
+					},
+				},
+			},
+			"acceptedAnswers": {
+				Type:        SchemaTypeArray,
+				Description: "all accepted answer variants of shortText problems",
+				Nullable:    true,
+				Items: &Schema{
+					Type: SchemaTypeString,
+				},
+			},
 			"description": {
-				Type:        genai.TypeString,
+				Type:        SchemaTypeString,
 				Description: "problem description",
 				Nullable:    true,
 			},
 			"answers": {
-				Type:     genai.TypeArray,
-				Nullable: false,
-				Items: &genai.Schema{
-					Type: genai.TypeObject,
+				Type:        SchemaTypeArray,
+				Description: "answers to choose from, or the items in the correct order for ordering problems, empty for other problem types",
+				Nullable:    false,
+				Items: &Schema{
+					Type: SchemaTypeObject,
 					Required: []string{
 						"title",
 						"isCorrect",
 					},
-					Properties: map[string]*genai.Schema{
+					Properties: map[string]*Schema{
 						"title": {
-							Type:        genai.TypeString,
+							Type:        SchemaTypeString,
 							Description: "answer title",
 							Nullable:    false,
 						},
 						"description": {
-							Type:        genai.TypeString,
+							Type:        SchemaTypeString,
 							Description: "answer description",
 							Nullable:    true,
 						},
 						"isCorrect": {
-							Type:        genai.TypeBoolean,
+							Type:        SchemaTypeBoolean,
 							Description: "is this answer correct",
 							Nullable:    false,
 						},
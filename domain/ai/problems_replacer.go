@@ -0,0 +1,62 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"shpankids/infra/shpanstream"
+	"shpankids/openapi"
+	"shpankids/shpankids"
+	"strings"
+)
+
+// maxAvoidedTitles bounds the prompt size for problem sets with many problems
+const maxAvoidedTitles = 50
+
+// RejectedProblem is a generated problem that could not be used, with the reason it was rejected
+type RejectedProblem struct {
+	Title  string
+	Reason string
+}
+
+// GenerateReplacementProblems asks the model for problems to replace the rejected ones, explaining why they were
+// rejected and which problems already exist
+func GenerateReplacementProblems(
+	ctx context.Context,
+	provider Provider,
+	learner LearnerProfile,
+	problemSet shpankids.FamilyProblemSetDto,
+	rejected []RejectedProblem,
+	existingTitles []string,
+) shpanstream.Stream[openapi.ApiProblemForEdit] {
+	if len(rejected) == 0 {
+		return shpanstream.EmptyStream[openapi.ApiProblemForEdit]()
+	}
+
+	rejectedLines := make([]string, len(rejected))
+	for i, r := range rejected {
+		rejectedLines[i] = fmt.Sprintf("- %q: %s", r.Title, r.Reason)
+	}
+	if len(existingTitles) > maxAvoidedTitles {
+		existingTitles = existingTitles[len(existingTitles)-maxAvoidedTitles:]
+	}
+
+	return generateProblems(ctx, provider, []Message{
+		{
+			Role: RoleUser,
+			Text: fmt.Sprintf(
+				"Generate a list of %d problems to challenge the family member %s, "+
+					"on the topic of %s %s. Make the outputs in JSON format. %s%s"+
+					"Previously generated problems were rejected for the following reasons:\n%s\n"+
+					"Avoid these mistakes, and do not repeat any of the existing problems: %s",
+				len(rejected),
+				learner.DisplayName(),
+				problemSet.Title,
+				problemSet.Description,
+				learner.describe(),
+				problemTypesInstructions,
+				strings.Join(rejectedLines, "\n"),
+				strings.Join(existingTitles, "; "),
+			),
+		},
+	})
+}
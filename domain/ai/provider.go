@@ -0,0 +1,69 @@
+package ai
+
+import (
+	"context"
+	"shpankids/infra/shpanstream"
+)
+
+// Provider is a generative model completing a conversation with a JSON response
+type Provider interface {
+	// GenerateJsonStream streams the text chunks of the model response to the last message of the request, as they
+	// are produced. The response matches the request schema, but providers requiring an object at the root may wrap
+	// array responses in an object
+	GenerateJsonStream(ctx context.Context, request JsonRequest) shpanstream.Stream[string]
+}
+
+type Role string
+
+const (
+	RoleUser  Role = "user"
+	RoleModel Role = "model"
+)
+
+type Message struct {
+	Role Role
+	Text string
+}
+
+// JsonRequest is a conversation sent to the model, the last message is a user message to be answered
+type JsonRequest struct {
+	Messages []Message
+	Schema   *Schema
+
+	// OnUsage is called with the tokens used once the response is complete, optional
+	OnUsage func(Usage)
+}
+
+// Usage is the number of tokens a request used, as reported by the provider
+type Usage struct {
+	PromptTokens   int
+	ResponseTokens int
+}
+
+// ReportUsage notifies the request of the tokens used, when it asked for it
+func (r JsonRequest) ReportUsage(usage Usage) {
+	if r.OnUsage != nil {
+		r.OnUsage(usage)
+	}
+}
+
+type SchemaType string
+
+const (
+	SchemaTypeObject  SchemaType = "object"
+	SchemaTypeArray   SchemaType = "array"
+	SchemaTypeString  SchemaType = "string"
+	SchemaTypeNumber  SchemaType = "number"
+	SchemaTypeBoolean SchemaType = "boolean"
+)
+
+// Schema is the provider independent subset of JSON schema describing the expected response
+type Schema struct {
+	Type        SchemaType         `json:"type"`
+	Description string             `json:"description,omitempty"`
+	Enum        []string           `json:"enum,omitempty"`
+	Nullable    bool               `json:"-"`
+	Required    []string           `json:"required,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+}
@@ -0,0 +1,111 @@
+package stub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"shpankids/domain/ai"
+	"shpankids/infra/shpanstream"
+	"shpankids/openapi"
+	"strconv"
+)
+
+const responseChunkSize = 16
+
+// charsPerToken is the rough text length of a token, for estimating the usage of the stub responses
+const charsPerToken = 4
+
+// Provider is an offline ai.Provider for local development, answering any request with arithmetic problems.
+// The problems are derived from the request messages, so the same request always gets the same response
+type Provider struct {
+}
+
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+func (p *Provider) GenerateJsonStream(_ context.Context, request ai.JsonRequest) shpanstream.Stream[string] {
+	h := fnv.New64a()
+	promptLength := 0
+	for _, m := range request.Messages {
+		_, _ = h.Write([]byte(m.Role))
+		_, _ = h.Write([]byte(m.Text))
+		promptLength += len(m.Text)
+	}
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	operand := func() int {
+		return r.Intn(20) + 1
+	}
+	problems := []openapi.ApiProblemForEdit{
+		additionProblem(operand(), operand()),
+		multiplicationProblem(operand(), operand()),
+		orderingProblem(operand(), operand()),
+	}
+	ret, err := json.Marshal(problems)
+	if err != nil {
+		return shpanstream.NewErrorStream[string](err)
+	}
+
+	usage := ai.Usage{
+		PromptTokens:   promptLength / charsPerToken,
+		ResponseTokens: len(ret) / charsPerToken,
+	}
+
+	// Responding in small chunks, like the streaming responses of actual models
+	var chunks []string
+	for len(ret) > 0 {
+		chunkSize := min(responseChunkSize, len(ret))
+		chunks = append(chunks, string(ret[:chunkSize]))
+		ret = ret[chunkSize:]
+	}
+	return shpanstream.ConcatenatedStream(
+		shpanstream.Just(chunks...),
+		shpanstream.DeferredStream(func(_ context.Context) shpanstream.Stream[string] {
+			request.ReportUsage(usage)
+			return shpanstream.EmptyStream[string]()
+		}),
+	)
+}
+
+func additionProblem(a int, b int) openapi.ApiProblemForEdit {
+	answers := make([]openapi.ApiProblemAnswerForEdit, 0, 4)
+	for _, offset := range []int{-1, 0, 1, 2} {
+		answers = append(answers, openapi.ApiProblemAnswerForEdit{
+			Title:     strconv.Itoa(a + b + offset),
+			IsCorrect: offset == 0,
+		})
+	}
+	return openapi.ApiProblemForEdit{
+		Type:    problemType(openapi.SingleChoice),
+		Title:   fmt.Sprintf("%d + %d = ?", a, b),
+		Answers: answers,
+	}
+}
+
+func multiplicationProblem(a int, b int) openapi.ApiProblemForEdit {
+	return openapi.ApiProblemForEdit{
+		Type:          problemType(openapi.Numeric),
+		Title:         fmt.Sprintf("%d × %d = ?", a, b),
+		Answers:       []openapi.ApiProblemAnswerForEdit{},
+		NumericAnswer: &openapi.ApiNumericAnswer{Value: float64(a * b)},
+	}
+}
+
+func orderingProblem(a int, b int) openapi.ApiProblemForEdit {
+	return openapi.ApiProblemForEdit{
+		Type:  problemType(openapi.Ordering),
+		Title: "Order the numbers from the smallest to the largest",
+		Answers: []openapi.ApiProblemAnswerForEdit{
+			{Title: strconv.Itoa(a)},
+			{Title: strconv.Itoa(a + b)},
+			{Title: strconv.Itoa(a + 2*b)},
+		},
+	}
+}
+
+func problemType(t openapi.ApiProblemType) *openapi.ApiProblemType {
+	return &t
+}
@@ -0,0 +1,81 @@
+package aiusage
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/database/kvstore"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"slices"
+	"strings"
+)
+
+type manager struct {
+	kvs                kvstore.RawJsonStore
+	userSessionManager shpankids.UserSessionManager
+	familyManager      shpankids.FamilyManager
+}
+
+func NewAiUsageManager(
+	kvs kvstore.RawJsonStore,
+	userSessionManager shpankids.UserSessionManager,
+	familyManager shpankids.FamilyManager,
+) shpankids.AiUsageManager {
+	return &manager{
+		kvs:                kvs,
+		userSessionManager: userSessionManager,
+		familyManager:      familyManager,
+	}
+}
+
+func (m *manager) GetAiUsageReport(
+	ctx context.Context,
+	familyId string,
+	from datekvs.Date,
+	to datekvs.Date,
+) (*shpankids.AiUsageReportDto, error) {
+	userId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f, err := m.familyManager.GetFamily(ctx, familyId)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(f.Members, func(fm shpankids.FamilyMemberDto) bool {
+		return *userId == fm.UserId && fm.Role == shpankids.RoleAdmin
+	}) {
+		return nil, util.ForbiddenError(fmt.Errorf("only admin can view the AI usage of family %s", f.Name))
+	}
+	repo, err := newRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return nil, err
+	}
+	records, err := repo.StreamRange(ctx, from, to.AddDay()).CollectFilterNil(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage := functional.MapSliceNoErr(
+		records,
+		func(r datekvs.DatedRecord[functional.Entry[string, dbAiUsage]]) shpankids.AiUsageDto {
+			return shpankids.AiUsageDto{
+				Date:           r.Date,
+				UserId:         r.Value.Key,
+				Calls:          r.Value.Value.Calls,
+				PromptTokens:   r.Value.Value.PromptTokens,
+				ResponseTokens: r.Value.Value.ResponseTokens,
+			}
+		},
+	)
+	slices.SortFunc(usage, func(a, b shpankids.AiUsageDto) int {
+		return cmp.Or(a.Date.Compare(b.Date.Time), strings.Compare(a.UserId, b.UserId))
+	})
+	return &shpankids.AiUsageReportDto{
+		FamilyDailyCallsLimit: f.AiFamilyDailyCallsLimit,
+		UserDailyCallsLimit:   f.AiUserDailyCallsLimit,
+		Usage:                 usage,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package aiusage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"shpankids/domain/ai"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/database/kvstore"
+	"shpankids/infra/shpanstream"
+	"shpankids/internal/infra/util"
+	"time"
+)
+
+// DailyLimits are the daily quotas of AI calls of a family, protecting the shared AI provider key from runaway costs.
+// Quotas reset at UTC midnight
+type DailyLimits struct {
+	FamilyCalls int
+	UserCalls   int
+}
+
+type dbAiUsage struct {
+	Calls          int `json:"calls"`
+	PromptTokens   int `json:"promptTokens"`
+	ResponseTokens int `json:"responseTokens"`
+}
+
+type repository datekvs.DateKvStore[dbAiUsage]
+
+// newRepository returns the AI usage of a family, kept by the UTC date and the user id
+func newRepository(ctx context.Context, kvs kvstore.RawJsonStore, familyId string) (repository, error) {
+	usageStore, err := kvs.CreateSpaceStore(ctx, []string{"aiUsage", familyId})
+	if err != nil {
+		return nil, err
+	}
+	return datekvs.NewDateKvsImpl[dbAiUsage](usageStore), nil
+}
+
+// CheckQuota fails with a 429 user facing error when either the user or the family already reached their daily quota,
+// allowing to refuse a request before doing any AI related work
+func CheckQuota(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	limits DailyLimits,
+) error {
+	repo, err := newRepository(ctx, kvs, familyId)
+	if err != nil {
+		return err
+	}
+	_, err = checkQuota(ctx, repo, userId, limits)
+	return err
+}
+
+// checkQuota returns today's usage of the user, failing when either the user or the family reached their daily quota
+func checkQuota(ctx context.Context, repo repository, userId string, limits DailyLimits) (*dbAiUsage, error) {
+	usages, err := repo.StreamAllForDate(ctx, today()).CollectFilterNil(ctx)
+	if err != nil {
+		return nil, err
+	}
+	familyCalls := 0
+	var userUsage dbAiUsage
+	for _, u := range usages {
+		familyCalls += u.Value.Calls
+		if u.Key == userId {
+			userUsage = u.Value
+		}
+	}
+	if familyCalls >= limits.FamilyCalls {
+		return nil, util.TooManyRequestsError(
+			fmt.Errorf("the family reached its daily limit of %d AI requests, try again tomorrow", limits.FamilyCalls),
+		)
+	}
+	if userUsage.Calls >= limits.UserCalls {
+		return nil, util.TooManyRequestsError(
+			fmt.Errorf("you reached your daily limit of %d AI requests, try again tomorrow", limits.UserCalls),
+		)
+	}
+	return &userUsage, nil
+}
+
+// reserveCall counts an AI call of the user, failing with a 429 user facing error when either the user or the family
+// already reached their daily quota
+func reserveCall(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	limits DailyLimits,
+) error {
+	return kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		repo, err := newRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		userUsage, err := checkQuota(ctx, repo, userId, limits)
+		if err != nil {
+			return err
+		}
+		userUsage.Calls++
+		return repo.Set(ctx, today(), userId, *userUsage)
+	})
+}
+
+func today() datekvs.Date {
+	return *datekvs.NewDateFromTime(time.Now().UTC())
+}
+
+func recordTokens(ctx context.Context, kvs kvstore.RawJsonStore, familyId string, userId string, usage ai.Usage) error {
+	today := today()
+	return kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		repo, err := newRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		userUsage, err := repo.Find(ctx, today, userId)
+		if err != nil {
+			return err
+		}
+		if userUsage == nil {
+			userUsage = &dbAiUsage{}
+		}
+		userUsage.PromptTokens += usage.PromptTokens
+		userUsage.ResponseTokens += usage.ResponseTokens
+		return repo.Set(ctx, today, userId, *userUsage)
+	})
+}
+
+type meteredProvider struct {
+	provider ai.Provider
+	kvs      kvstore.RawJsonStore
+	familyId string
+	userId   string
+	limits   DailyLimits
+}
+
+// NewMeteredProvider wraps provider, counting every call against the daily quotas of the user and the family and
+// recording the tokens used by its responses as AI usage of the user
+func NewMeteredProvider(
+	provider ai.Provider,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	limits DailyLimits,
+) ai.Provider {
+	return &meteredProvider{
+		provider: provider,
+		kvs:      kvs,
+		familyId: familyId,
+		userId:   userId,
+		limits:   limits,
+	}
+}
+
+func (p *meteredProvider) GenerateJsonStream(ctx context.Context, request ai.JsonRequest) shpanstream.Stream[string] {
+	onUsage := request.OnUsage
+	request.OnUsage = func(usage ai.Usage) {
+		if onUsage != nil {
+			onUsage(usage)
+		}
+
+		// The response was already produced, failing to record its usage should not fail it
+		err := recordTokens(ctx, p.kvs, p.familyId, p.userId, usage)
+		if err != nil {
+			slog.Warn(fmt.Sprintf("failed to record AI usage of user %s in family %s: %v", p.userId, p.familyId, err))
+		}
+	}
+
+	// A single generation can call the provider several times, e.g. to replace rejected problems, each call is counted
+	// when it is made
+	return shpanstream.DeferredStream(func(_ context.Context) shpanstream.Stream[string] {
+		err := reserveCall(ctx, p.kvs, p.familyId, p.userId, p.limits)
+		if err != nil {
+			return shpanstream.NewErrorStream[string](err)
+		}
+		return p.provider.GenerateJsonStream(ctx, request)
+	})
+}
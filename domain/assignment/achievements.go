@@ -0,0 +1,259 @@
+package assignment
+
+import (
+	"context"
+	"fmt"
+	"shpankids/infra/database/datekvs"
+	"shpankids/internal/infra/util"
+	"shpankids/openapi"
+	"shpankids/shpankids"
+	"slices"
+	"time"
+)
+
+type badgeKind int
+
+const (
+	badgeKindTasksStreak badgeKind = iota
+	badgeKindProblemsStreak
+	badgeKindProblemsSolved
+)
+
+type badgeDefinition struct {
+	id          string
+	title       string
+	description string
+	kind        badgeKind
+	target      int
+}
+
+var badgeDefinitions = []badgeDefinition{
+	{id: "tasks-streak-7", title: "Week Warrior", description: "All tasks done 7 days in a row", kind: badgeKindTasksStreak, target: 7},
+	{id: "tasks-streak-30", title: "Unstoppable", description: "All tasks done 30 days in a row", kind: badgeKindTasksStreak, target: 30},
+	{id: "problems-streak-7", title: "Daily Thinker", description: "Solved problems 7 days in a row", kind: badgeKindProblemsStreak, target: 7},
+	{id: "problems-solved-10", title: "Problem Solver", description: "10 problems solved correctly", kind: badgeKindProblemsSolved, target: 10},
+	{id: "problems-solved-100", title: "Problem Master", description: "100 problems solved correctly", kind: badgeKindProblemsSolved, target: 100},
+}
+
+// streakCalc tracks a streak while walking days in ascending order, remembering when each target was first reached
+type streakCalc struct {
+	current    int
+	best       int
+	lastDate   *datekvs.Date
+	achievedOn map[int]datekvs.Date
+}
+
+func newStreakCalc() *streakCalc {
+	return &streakCalc{achievedOn: map[int]datekvs.Date{}}
+}
+
+func (s *streakCalc) success(d datekvs.Date) {
+	s.current++
+	s.lastDate = &d
+	s.best = max(s.best, s.current)
+	if _, ok := s.achievedOn[s.current]; !ok {
+		s.achievedOn[s.current] = d
+	}
+}
+
+func (s *streakCalc) fail() {
+	s.current = 0
+}
+
+func (s *streakCalc) toDto() shpankids.StreakDto {
+	return shpankids.StreakDto{Current: s.current, Best: s.best}
+}
+
+func (m *managerImpl) GetAchievements(ctx context.Context, userId string) (*shpankids.AchievementsDto, error) {
+	callerId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s, err := m.sessionManager.Get(ctx, *callerId)
+	if err != nil {
+		return nil, err
+	}
+	f, err := m.familyManager.GetFamily(ctx, s.FamilyId)
+	if err != nil {
+		return nil, err
+	}
+	if *callerId != userId && !slices.ContainsFunc(f.Members, func(fm shpankids.FamilyMemberDto) bool {
+		return *callerId == fm.UserId && fm.Role == shpankids.RoleAdmin
+	}) {
+		return nil, util.ForbiddenError(fmt.Errorf("only admin can view achievements of other family members"))
+	}
+	if !slices.ContainsFunc(f.Members, func(fm shpankids.FamilyMemberDto) bool {
+		return fm.UserId == userId
+	}) {
+		return nil, util.NotFoundError(fmt.Errorf("user %s is not a member of family %s", userId, f.Name))
+	}
+
+	today := datekvs.TodayDate(s.Location)
+	tasksStreak, err := m.calcTasksStreak(ctx, s.FamilyId, userId, today, s.Location)
+	if err != nil {
+		return nil, err
+	}
+	problemsStreak, solvedDates, err := m.calcProblemsStreak(ctx, s.FamilyId, userId, today, s.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	badges := make([]shpankids.BadgeDto, 0, len(badgeDefinitions))
+	for _, bd := range badgeDefinitions {
+		b := shpankids.BadgeDto{
+			BadgeId:     bd.id,
+			Title:       bd.title,
+			Description: bd.description,
+			Target:      bd.target,
+		}
+		switch bd.kind {
+		case badgeKindTasksStreak:
+			b.Progress = tasksStreak.best
+			if d, ok := tasksStreak.achievedOn[bd.target]; ok {
+				b.AchievedOn = &d
+			}
+		case badgeKindProblemsStreak:
+			b.Progress = problemsStreak.best
+			if d, ok := problemsStreak.achievedOn[bd.target]; ok {
+				b.AchievedOn = &d
+			}
+		case badgeKindProblemsSolved:
+			b.Progress = len(solvedDates)
+			if len(solvedDates) >= bd.target {
+				b.AchievedOn = &solvedDates[bd.target-1]
+			}
+		}
+		b.Progress = min(b.Progress, bd.target)
+		badges = append(badges, b)
+	}
+
+	return &shpankids.AchievementsDto{
+		UserId:              userId,
+		TasksStreak:         tasksStreak.toDto(),
+		ProblemsStreak:      problemsStreak.toDto(),
+		ProblemsSolvedCount: len(solvedDates),
+		Badges:              badges,
+	}, nil
+}
+
+// calcTasksStreak walks the user's daily task stats, from the first task ever assigned to the user until today
+func (m *managerImpl) calcTasksStreak(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	today datekvs.Date,
+	loc *time.Location,
+) (*streakCalc, error) {
+	ret := newStreakCalc()
+	familyTasks, err := m.familyManager.ListFamilyTasks(ctx, familyId).CollectFilterNil(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var from *datekvs.Date
+	for _, ft := range familyTasks {
+		if from == nil || ft.Created.Before(from.Time) {
+			from = datekvs.NewDateFromTime(ft.Created.In(loc))
+		}
+	}
+	if from == nil {
+		return ret, nil
+	}
+
+	err = m.getUserTaskStatesForDateRange(ctx, *from, today, familyTasks, familyId, userId, nil).ConsumeWithErr(
+		ctx,
+		func(ts *shpankids.TaskStats) error {
+			forDate := *datekvs.NewDateFromTime(ts.ForDate)
+			if ts.DoneTasksCount >= ts.TotalTasksCount {
+				ret.success(forDate)
+			} else if !forDate.Equal(today.Time) {
+				// Today is not over yet, so it can't break the streak
+				ret.fail()
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// calcProblemsStreak returns the streak of days with solved problems, and the dates of all correct solutions.
+// A day counts towards the streak when a problem was answered correctly or the daily quota of a problem set was met
+func (m *managerImpl) calcProblemsStreak(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	today datekvs.Date,
+	loc *time.Location,
+) (*streakCalc, []datekvs.Date, error) {
+	problemSets, err := m.familyManager.ListProblemSetsForUser(ctx, familyId, userId).CollectFilterNil(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	solutionDays := map[datekvs.Date]bool{}
+	var correctSolutionDates []datekvs.Date
+	for _, ps := range problemSets {
+		progressByDate := map[datekvs.Date]*problemSetDayProgress{}
+		err = m.familyManager.ListUserProblemsSolutions(ctx, familyId, ps.ProblemSetId, userId).Consume(
+			ctx,
+			func(sol *openapi.ApiUserProblemSolution) {
+				if sol.InProgress {
+					return
+				}
+				solvedDate := *datekvs.NewDateFromTime(sol.SolvedDate.In(loc))
+				progress, ok := progressByDate[solvedDate]
+				if !ok {
+					progress = &problemSetDayProgress{required: ps.DailyQuota}
+					progressByDate[solvedDate] = progress
+				}
+				progress.answered++
+				if sol.Correct {
+					progress.correct++
+					correctSolutionDates = append(correctSolutionDates, solvedDate)
+				}
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		for d, progress := range progressByDate {
+			completed := progress.answered
+			if ps.QuotaType == shpankids.ProblemSetQuotaTypeCorrectAnswers {
+				completed = progress.correct
+			}
+			if progress.correct > 0 || completed >= progress.required {
+				solutionDays[d] = true
+			}
+		}
+	}
+	slices.SortFunc(correctSolutionDates, func(a, b datekvs.Date) int {
+		return a.Compare(b.Time)
+	})
+
+	ret := newStreakCalc()
+	for _, d := range sortedDates(solutionDays) {
+		if ret.lastDate != nil && !ret.lastDate.AddDay().Equal(d.Time) {
+			ret.fail()
+		}
+		ret.success(d)
+	}
+
+	// The streak is still ongoing if problems were solved today or yesterday
+	if ret.lastDate != nil && ret.lastDate.AddDay().Before(today.Time) {
+		ret.fail()
+	}
+	return ret, correctSolutionDates, nil
+}
+
+func sortedDates(dates map[datekvs.Date]bool) []datekvs.Date {
+	ret := make([]datekvs.Date, 0, len(dates))
+	for d := range dates {
+		ret = append(ret, d)
+	}
+	slices.SortFunc(ret, func(a, b datekvs.Date) int {
+		return a.Compare(b.Time)
+	})
+	return ret
+}
@@ -0,0 +1,139 @@
+package assignment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/shpanstream"
+	"shpankids/openapi"
+	"shpankids/shpankids"
+)
+
+// solutionsFamilyManager serves the problem sets and the solutions of a single user, other calls are not supported
+type solutionsFamilyManager struct {
+	shpankids.FamilyManager
+	problemSets []shpankids.FamilyProblemSetDto
+	solutions   map[string][]openapi.ApiUserProblemSolution
+}
+
+func (fm *solutionsFamilyManager) ListProblemSetsForUser(
+	_ context.Context,
+	_ string,
+	_ string,
+) shpanstream.Stream[shpankids.FamilyProblemSetDto] {
+	return shpanstream.Just(fm.problemSets...)
+}
+
+func (fm *solutionsFamilyManager) ListUserProblemsSolutions(
+	_ context.Context,
+	_ string,
+	problemSetId string,
+	_ string,
+) shpanstream.Stream[openapi.ApiUserProblemSolution] {
+	return shpanstream.Just(fm.solutions[problemSetId]...)
+}
+
+func TestCalcProblemsStreak(t *testing.T) {
+	today := datekvs.NewDate(2026, 10, 10)
+	solvedOn := func(day int, correct bool) openapi.ApiUserProblemSolution {
+		return openapi.ApiUserProblemSolution{
+			Correct:    correct,
+			SolvedDate: time.Date(2026, 10, day, 12, 0, 0, 0, time.UTC),
+		}
+	}
+	problemsQuota := shpankids.FamilyProblemSetDto{
+		ProblemSetId: "problems",
+		DailyQuota:   2,
+		QuotaType:    shpankids.ProblemSetQuotaTypeProblems,
+	}
+	correctAnswersQuota := shpankids.FamilyProblemSetDto{
+		ProblemSetId: "correct",
+		DailyQuota:   2,
+		QuotaType:    shpankids.ProblemSetQuotaTypeCorrectAnswers,
+	}
+
+	tests := []struct {
+		name        string
+		problemSet  shpankids.FamilyProblemSetDto
+		solutions   []openapi.ApiUserProblemSolution
+		wantCurrent int
+		wantBest    int
+	}{
+		{
+			name:       "no solutions",
+			problemSet: problemsQuota,
+		},
+		{
+			name:        "correct answer only",
+			problemSet:  problemsQuota,
+			solutions:   []openapi.ApiUserProblemSolution{solvedOn(9, true), solvedOn(10, true)},
+			wantCurrent: 2,
+			wantBest:    2,
+		},
+		{
+			name:        "daily quota met with no correct answer",
+			problemSet:  problemsQuota,
+			solutions:   []openapi.ApiUserProblemSolution{solvedOn(10, false), solvedOn(10, false)},
+			wantCurrent: 1,
+			wantBest:    1,
+		},
+		{
+			name:       "daily quota not met with no correct answer",
+			problemSet: problemsQuota,
+			solutions:  []openapi.ApiUserProblemSolution{solvedOn(10, false)},
+		},
+		{
+			name:       "wrong answers don't meet a correct answers quota",
+			problemSet: correctAnswersQuota,
+			solutions:  []openapi.ApiUserProblemSolution{solvedOn(10, false), solvedOn(10, false)},
+		},
+		{
+			name:       "in progress solutions are ignored",
+			problemSet: problemsQuota,
+			solutions: []openapi.ApiUserProblemSolution{{
+				InProgress: true,
+				SolvedDate: time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC),
+			}},
+		},
+		{
+			name:       "gap day",
+			problemSet: problemsQuota,
+			solutions: []openapi.ApiUserProblemSolution{
+				solvedOn(5, true),
+				solvedOn(6, true),
+				solvedOn(7, true),
+				solvedOn(9, true),
+				solvedOn(10, true),
+			},
+			wantCurrent: 2,
+			wantBest:    3,
+		},
+		{
+			name:        "solved yesterday keeps the streak",
+			problemSet:  problemsQuota,
+			solutions:   []openapi.ApiUserProblemSolution{solvedOn(8, true), solvedOn(9, true)},
+			wantCurrent: 2,
+			wantBest:    2,
+		},
+		{
+			name:       "nothing solved yesterday breaks the streak",
+			problemSet: problemsQuota,
+			solutions:  []openapi.ApiUserProblemSolution{solvedOn(7, true), solvedOn(8, true)},
+			wantBest:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &managerImpl{familyManager: &solutionsFamilyManager{
+				problemSets: []shpankids.FamilyProblemSetDto{tt.problemSet},
+				solutions:   map[string][]openapi.ApiUserProblemSolution{tt.problemSet.ProblemSetId: tt.solutions},
+			}}
+			streak, _, err := m.calcProblemsStreak(context.Background(), "f", "u", today, time.UTC)
+			require.NoError(t, err)
+			require.Equal(t, shpankids.StreakDto{Current: tt.wantCurrent, Best: tt.wantBest}, streak.toDto())
+		})
+	}
+}
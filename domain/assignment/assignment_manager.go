@@ -3,10 +3,14 @@ package assignment
 import (
 	"cloud.google.com/go/firestore"
 	"context"
+	"fmt"
+	"shpankids/domain/audit"
+	"shpankids/domain/points"
 	"shpankids/infra/database/datekvs"
 	"shpankids/infra/database/kvstore"
 	"shpankids/infra/shpanstream"
 	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
 	"shpankids/shpankids"
 	"slices"
 	"time"
@@ -89,12 +93,18 @@ func (m *managerImpl) GetTaskStats(
 
 	return shpanstream.ConcatenatedStream[shpankids.TaskStats](
 		functional.MapSliceNoErr(userIdsToFetch, func(userId string) shpanstream.Stream[shpankids.TaskStats] {
+			problemSets, err := m.familyManager.ListProblemSetsForUser(ctx, s.FamilyId, userId).CollectFilterNil(ctx)
+			if err != nil {
+				return shpanstream.NewErrorStream[shpankids.TaskStats](err)
+			}
 			return m.getUserTaskStatesForDateRange(
 				ctx,
 				fromDate,
 				toDate,
 				familyTasks,
+				s.FamilyId,
 				userId,
+				problemSets,
 			)
 		})...,
 	)
@@ -113,7 +123,7 @@ func (m *managerImpl) doListAssignments(
 
 	// Combine different types of assignments into a single stream
 	return shpanstream.ConcatenatedStream(
-		m.filterTaskAssignmentsForUser(ctx, forDate, familyTasks, userId),
+		m.filterTaskAssignmentsForUser(ctx, forDate, familyTasks, familyId, userId),
 		m.filterProblemAssignmentsForUser(ctx, forDate, familyId, userId),
 	)
 }
@@ -143,38 +153,33 @@ func (m *managerImpl) mapProblemSetToAssignment(
 	fps *shpankids.FamilyProblemSetDto,
 	forDate datekvs.Date,
 ) (*shpankids.Assignment, error) {
-	count, err := m.familyManager.ListProblemSetSolutionsForDate(
-		ctx,
-		familyId,
-		userId,
-		fps.ProblemSetId,
-		forDate,
-	).Count(ctx)
+	if fps.Status != shpankids.FamilyAssignmentStatusActive {
+		return nil, nil
+	}
+	dayProgress, err := m.problemSetProgress(ctx, familyId, userId, fps, forDate)
 	if err != nil {
 		return nil, err
 	}
+	progress := dayProgress.toAssignmentProgress()
 
 	status := shpankids.StatusOpen
-	// If there are any solutions for the problem set, the status is set to done
-	if count > 0 {
+	// The problem set is done once the daily quota is met
+	if progress.Completed >= progress.Required {
 		status = shpankids.StatusDone
 	} else {
-		// Checking if there are no available problems for the problem set we're also done...
-		f, err :=
-			m.familyManager.ListProblemsForProblemSet(
-				ctx,
-				familyId,
-				userId,
-				fps.ProblemSetId,
-				false,
-			).GetFirst(ctx)
+		// Checking if there are no available problems for the problem set (new or due for review) we're also done...
+		f, err := m.familyManager.GetNextProblem(ctx, familyId, userId, fps.ProblemSetId, forDate)
 
 		if err != nil {
 			return nil, err
 		}
 		if f == nil {
-			// returning nil to filter away this assignment, no problems available...
-			return nil, nil
+			if dayProgress.answered == 0 {
+				// returning nil to filter away this assignment, no problems available...
+				return nil, nil
+			}
+			// Ran out of problems after working on some today, nothing left to do
+			status = shpankids.StatusDone
 		}
 	}
 	return &shpankids.Assignment{
@@ -184,13 +189,71 @@ func (m *managerImpl) mapProblemSetToAssignment(
 		Title:       fps.Title,
 		Status:      status,
 		Description: fps.Description,
+		Progress:    progress,
 	}, nil
 }
 
+// problemSetDayProgress summarizes the solutions submitted for a problem set on a single date
+type problemSetDayProgress struct {
+	answered         int
+	correct          int
+	correctWithHints int
+	completed        int
+	required         int
+}
+
+func (p problemSetDayProgress) toAssignmentProgress() *shpankids.AssignmentProgressDto {
+	return &shpankids.AssignmentProgressDto{
+		Completed: p.completed,
+		Required:  p.required,
+	}
+}
+
+// problemSetProgress counts the solutions submitted on forDate against the daily quota of the problem set
+func (m *managerImpl) problemSetProgress(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	fps *shpankids.FamilyProblemSetDto,
+	forDate datekvs.Date,
+) (*problemSetDayProgress, error) {
+	ret := &problemSetDayProgress{required: fps.DailyQuota}
+	err := m.familyManager.ListProblemSetSolutionsForDate(
+		ctx,
+		familyId,
+		userId,
+		fps.ProblemSetId,
+		forDate,
+	).Consume(ctx, func(sol *shpankids.ProblemSolutionDto) {
+		// Wrong answers with attempts left are not final yet
+		if sol.InProgress {
+			return
+		}
+		ret.answered++
+		if sol.Correct {
+			ret.correct++
+			if sol.HintsUsed > 0 {
+				ret.correctWithHints++
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret.completed = ret.answered
+	if fps.QuotaType == shpankids.ProblemSetQuotaTypeCorrectAnswers {
+		ret.completed = ret.correct
+	}
+	ret.completed = min(ret.completed, ret.required)
+	return ret, nil
+}
+
 func (m *managerImpl) filterTaskAssignmentsForUser(
 	ctx context.Context,
 	forDate datekvs.Date,
 	familyTasks []shpankids.FamilyTaskDto,
+	familyId string,
 	userId string,
 ) shpanstream.Stream[shpankids.Assignment] {
 	tasks := functional.MapSliceWhileFilteringNoErr(familyTasks, func(ft shpankids.FamilyTaskDto) **shpankids.Assignment {
@@ -201,18 +264,22 @@ func (m *managerImpl) filterTaskAssignmentsForUser(
 		}
 
 		// Filtering away tasks that are not assigned to the user
-		if !slices.ContainsFunc(ft.MemberIds, func(memberId string) bool {
-			return memberId == userId
-		}) {
+		taskRevision := taskRevisionForDate(ft, forDate)
+		if !slices.Contains(taskRevision.MemberIds, userId) {
+			return nil
+		}
+
+		// Filtering away tasks that are not due on the forDate
+		if !isScheduledOn(taskRevision.Schedule, ft.Created, forDate) {
 			return nil
 		}
 		return functional.ValueToPointer(&shpankids.Assignment{
 			Id:          ft.TaskId,
 			ForDate:     forDate,
 			Type:        shpankids.AssignmentTypeTask,
-			Title:       ft.Title,
+			Title:       taskRevision.Title,
 			Status:      shpankids.StatusOpen,
-			Description: ft.Description,
+			Description: taskRevision.Description,
 		})
 	})
 
@@ -220,7 +287,7 @@ func (m *managerImpl) filterTaskAssignmentsForUser(
 		return t.Id
 	})
 
-	userTaskRepo, err := NewUserTaskStatusRepository(ctx, m.kvs, userId)
+	userTaskRepo, err := NewUserTaskStatusRepository(ctx, m.kvs, familyId, userId)
 	if err != nil {
 		return shpanstream.NewErrorStream[shpankids.Assignment](err)
 	}
@@ -246,7 +313,9 @@ func (m *managerImpl) getUserTaskStatesForDateRange(
 	from datekvs.Date,
 	to datekvs.Date,
 	familyTasks []shpankids.FamilyTaskDto,
+	familyId string,
 	userId string,
+	problemSets []shpankids.FamilyProblemSetDto,
 ) shpanstream.Stream[shpankids.TaskStats] {
 	relevantUserTasks := functional.FilterSlice(familyTasks, func(ft shpankids.FamilyTaskDto) bool {
 
@@ -259,16 +328,14 @@ func (m *managerImpl) getUserTaskStatesForDateRange(
 			return false
 		}
 
-		// Filtering away tasks that are not assigned to the user
-		if !slices.ContainsFunc(ft.MemberIds, func(memberId string) bool {
-			return memberId == userId
-		}) {
-			return false
-		}
-		return true
+		// Filtering away tasks that were never assigned to the user
+		return slices.Contains(ft.MemberIds, userId) ||
+			slices.ContainsFunc(ft.History, func(r shpankids.FamilyTaskRevisionDto) bool {
+				return slices.Contains(r.MemberIds, userId)
+			})
 	})
 
-	userTaskRepo, err := NewUserTaskStatusRepository(ctx, m.kvs, userId)
+	userTaskRepo, err := NewUserTaskStatusRepository(ctx, m.kvs, familyId, userId)
 	if err != nil {
 		return shpanstream.NewErrorStream[shpankids.TaskStats](err)
 	}
@@ -279,22 +346,45 @@ func (m *managerImpl) getUserTaskStatesForDateRange(
 			userAssignableTasksByTaskId := functional.SliceToMapNoErr(
 				functional.FilterSlice(relevantUserTasks, func(t shpankids.FamilyTaskDto) bool {
 					return t.Created.Before(dt.DateEndTime()) &&
-						(t.Status == shpankids.FamilyAssignmentStatusActive || t.StatusDate.After(dt.Time))
+						(t.Status == shpankids.FamilyAssignmentStatusActive || t.StatusDate.After(dt.Time)) &&
+						isAssignedOn(t, userId, *dt)
 				}),
 				func(t shpankids.FamilyTaskDto) string {
 					return t.TaskId
 				},
 			)
 
-			if len(userAssignableTasksByTaskId) == 0 {
+			var problemSetsProgress []shpankids.ProblemSetProgressDto
+			for _, ps := range problemSets {
+				if !ps.Created.Before(dt.DateEndTime()) ||
+					(ps.Status != shpankids.FamilyAssignmentStatusActive && !ps.StatusDate.After(dt.Time)) {
+					continue
+				}
+				progress, err := m.problemSetProgress(ctx, familyId, userId, &ps, *dt)
+				if err != nil {
+					return nil, err
+				}
+				problemSetsProgress = append(problemSetsProgress, shpankids.ProblemSetProgressDto{
+					ProblemSetId:          ps.ProblemSetId,
+					Title:                 ps.Title,
+					Completed:             progress.completed,
+					Required:              progress.required,
+					CorrectCount:          progress.correct,
+					CorrectWithHintsCount: progress.correctWithHints,
+				})
+			}
+
+			if len(userAssignableTasksByTaskId) == 0 && len(problemSetsProgress) == 0 {
 				return nil, nil
 			}
 
 			ret := &shpankids.TaskStats{
-				UserId:          userId,
-				ForDate:         dt.Time,
-				TotalTasksCount: len(userAssignableTasksByTaskId),
-				DoneTasksCount:  0,
+				UserId:            userId,
+				ForDate:           dt.Time,
+				TotalTasksCount:   len(userAssignableTasksByTaskId),
+				DoneTasksCount:    0,
+				ClaimedTasksCount: 0,
+				ProblemSets:       problemSetsProgress,
 			}
 
 			err := userTaskRepo.StreamAllForDate(ctx, *dt).Consume(
@@ -302,8 +392,12 @@ func (m *managerImpl) getUserTaskStatesForDateRange(
 				func(dr *functional.Entry[string, dbUserTaskStatus]) {
 
 					if _, found := userAssignableTasksByTaskId[dr.Key]; found {
-						if dr.Value.Status == shpankids.StatusDone {
+						switch dr.Value.Status {
+						case shpankids.StatusDone:
 							ret.DoneTasksCount++
+							ret.ClaimedTasksCount++
+						case shpankids.StatusPendingApproval:
+							ret.ClaimedTasksCount++
 						}
 					}
 				},
@@ -316,6 +410,59 @@ func (m *managerImpl) getUserTaskStatesForDateRange(
 
 }
 
+// taskRevisionForDate returns the version of the task that was in effect by the end of forDate
+func taskRevisionForDate(ft shpankids.FamilyTaskDto, forDate datekvs.Date) shpankids.FamilyTaskRevisionDto {
+	for _, r := range ft.History {
+		if !r.ValidUntil.Before(forDate.DateEndTime()) {
+			return r
+		}
+	}
+	return shpankids.FamilyTaskRevisionDto{
+		Title:       ft.Title,
+		Description: ft.Description,
+		MemberIds:   ft.MemberIds,
+		Schedule:    ft.Schedule,
+	}
+}
+
+// isAssignedOn checks whether the task was assigned to the user and due on forDate
+func isAssignedOn(ft shpankids.FamilyTaskDto, userId string, forDate datekvs.Date) bool {
+	taskRevision := taskRevisionForDate(ft, forDate)
+	return slices.Contains(taskRevision.MemberIds, userId) && isScheduledOn(taskRevision.Schedule, ft.Created, forDate)
+}
+
+// isScheduledOn checks whether a task with the given schedule is due on forDate, a nil schedule is due every day
+func isScheduledOn(s *shpankids.TaskScheduleDto, taskCreated time.Time, forDate datekvs.Date) bool {
+	if s == nil {
+		return true
+	}
+	if s.StartDate != nil && forDate.Before(s.StartDate.Time) {
+		return false
+	}
+	if s.EndDate != nil && forDate.After(s.EndDate.Time) {
+		return false
+	}
+	if slices.ContainsFunc(s.ExcludedDates, func(d datekvs.Date) bool {
+		return d.Equal(forDate.Time)
+	}) {
+		return false
+	}
+	if len(s.WeekDays) > 0 && !slices.Contains(s.WeekDays, forDate.Weekday()) {
+		return false
+	}
+	if s.EveryNDays > 1 {
+		startDate := datekvs.NewDateFromTime(taskCreated)
+		if s.StartDate != nil {
+			startDate = s.StartDate
+		}
+		daysSinceStart := int(forDate.Sub(startDate.Time).Hours() / 24)
+		if daysSinceStart < 0 || daysSinceStart%s.EveryNDays != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *managerImpl) UpdateTaskStatus(
 	ctx context.Context,
 	forDay time.Time,
@@ -323,20 +470,229 @@ func (m *managerImpl) UpdateTaskStatus(
 	status shpankids.AssignmentStatus,
 	comment string,
 ) error {
+	if status == shpankids.StatusPendingApproval {
+		return util.BadInputError(fmt.Errorf("status %s can't be set directly, mark the task as done instead", status))
+	}
 	userId, err := m.userSessionManager(ctx)
 	if err != nil {
 		return err
 	}
+	s, err := m.sessionManager.Get(ctx, *userId)
+	if err != nil {
+		return err
+	}
+	f, err := m.familyManager.GetFamily(ctx, s.FamilyId)
+	if err != nil {
+		return err
+	}
+	forDate := *datekvs.NewDateFromTime(forDay)
+	err = checkTaskStatusDate(f, forDate, datekvs.TodayDate(s.Location))
+	if err != nil {
+		return err
+	}
+	ft, err := m.getFamilyTask(ctx, s.FamilyId, taskId)
+	if err != nil {
+		return err
+	}
+	err = checkTaskAssignedOn(ft, *userId, forDate)
+	if err != nil {
+		return err
+	}
+
+	// Admins don't need anyone to approve their own tasks
+	requiresApproval := ft.RequiresApproval && !isFamilyAdmin(f, *userId)
 
-	tsr, err := NewUserTaskStatusRepository(ctx, m.kvs, *userId)
+	return m.setTaskStatus(
+		ctx,
+		s.FamilyId,
+		*userId,
+		*userId,
+		forDate,
+		ft,
+		status,
+		comment,
+		requiresApproval,
+	)
+}
+
+func (m *managerImpl) UpdateMemberTaskStatus(
+	ctx context.Context,
+	memberId string,
+	forDay time.Time,
+	taskId string,
+	status shpankids.AssignmentStatus,
+	comment string,
+) error {
+	if status == shpankids.StatusPendingApproval {
+		return util.BadInputError(fmt.Errorf("status %s can't be set by an admin", status))
+	}
+	adminId, s, err := m.getAdminSession(ctx, "update the task status of family members")
+	if err != nil {
+		return err
+	}
+	f, err := m.familyManager.GetFamily(ctx, s.FamilyId)
 	if err != nil {
 		return err
 	}
+	if !slices.ContainsFunc(f.Members, func(fm shpankids.FamilyMemberDto) bool {
+		return fm.UserId == memberId
+	}) {
+		return util.NotFoundError(fmt.Errorf("user %s is not a member of family %s", memberId, f.Name))
+	}
+
+	forDate := *datekvs.NewDateFromTime(forDay)
+	err = checkTaskStatusDate(f, forDate, datekvs.TodayDate(s.Location))
+	if err != nil {
+		return err
+	}
+
+	ft, err := m.getFamilyTask(ctx, s.FamilyId, taskId)
+	if err != nil {
+		return err
+	}
+	err = checkTaskAssignedOn(ft, memberId, forDate)
+	if err != nil {
+		return err
+	}
+
+	// The admin's decision is final, so no approval is required
+	return m.setTaskStatus(ctx, s.FamilyId, memberId, adminId, forDate, ft, status, comment, false)
+}
+
+// checkTaskStatusDate checks forDate is not in the future, and within the days of the family that can be updated
+func checkTaskStatusDate(f *shpankids.FamilyDto, forDate datekvs.Date, today datekvs.Date) error {
+	if forDate.After(today.Time) {
+		return util.BadInputError(fmt.Errorf("can't update task status for a future date %s", forDate))
+	}
+	if forDate.Before(today.AddDate(0, 0, -f.TaskBackEditDays)) {
+		return util.BadInputError(fmt.Errorf(
+			"can't update task status for %s, only the last %d days can be updated",
+			forDate,
+			f.TaskBackEditDays,
+		))
+	}
+	return nil
+}
+
+// checkTaskAssignedOn checks the task was active, assigned to the user and due on forDate, so its status can be set
+func checkTaskAssignedOn(ft *shpankids.FamilyTaskDto, userId string, forDate datekvs.Date) error {
+	if (ft.Status != shpankids.FamilyAssignmentStatusActive && ft.StatusDate.Before(forDate.Time)) ||
+		!isAssignedOn(*ft, userId, forDate) {
+		return util.BadInputError(fmt.Errorf("task %s is not assigned to %s on %s", ft.Title, userId, forDate))
+	}
+	return nil
+}
+
+func (m *managerImpl) getFamilyTask(ctx context.Context, familyId string, taskId string) (*shpankids.FamilyTaskDto, error) {
+	ft, err := m.familyManager.ListFamilyTasks(ctx, familyId).
+		Filter(func(ft *shpankids.FamilyTaskDto) bool {
+			return ft.TaskId == taskId
+		}).
+		GetFirst(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ft == nil {
+		return nil, util.NotFoundError(fmt.Errorf("task %s not found", taskId))
+	}
+	return ft, nil
+}
 
-	return tsr.Set(ctx, *datekvs.NewDateFromTime(forDay), taskId, dbUserTaskStatus{
-		Comment:    comment,
-		Status:     status,
-		StatusTime: time.Now(),
+// setTaskStatus keeps the task status, its pending approval and the points earned for it in sync
+func (m *managerImpl) setTaskStatus(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	updatedBy string,
+	forDate datekvs.Date,
+	ft *shpankids.FamilyTaskDto,
+	status shpankids.AssignmentStatus,
+	comment string,
+	requiresApproval bool,
+) error {
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		tsr, err := NewUserTaskStatusRepository(ctx, tx, familyId, userId)
+		if err != nil {
+			return err
+		}
+		existing, err := tsr.Find(ctx, forDate, ft.TaskId)
+		if err != nil {
+			return err
+		}
+		pendingApproval := status == shpankids.StatusDone && requiresApproval
+
+		// Marking an already approved task as done again keeps it approved
+		if pendingApproval && existing != nil && existing.Status == shpankids.StatusDone {
+			pendingApproval = false
+		}
+		if pendingApproval {
+			status = shpankids.StatusPendingApproval
+		}
+
+		newStatus := dbUserTaskStatus{
+			Comment:    comment,
+			Status:     status,
+			StatusTime: time.Now(),
+			UpdatedBy:  updatedBy,
+		}
+		err = tsr.Set(ctx, forDate, ft.TaskId, newStatus)
+		if err != nil {
+			return err
+		}
+		err = audit.Record(
+			ctx,
+			tx,
+			m.userSessionManager,
+			familyId,
+			shpankids.AuditActionTaskStatusUpdate,
+			taskStatusAuditTarget(userId, ft.TaskId, forDate),
+			existing,
+			newStatus,
+		)
+		if err != nil {
+			return err
+		}
+		err = updatePendingTaskApproval(ctx, tx, familyId, userId, forDate, ft.TaskId, comment, pendingApproval)
+		if err != nil {
+			return err
+		}
+		return updateTaskPoints(ctx, tx, familyId, userId, forDate, ft, status == shpankids.StatusDone)
 	})
+}
 
+// taskStatusAuditTarget identifies the status of a task of a user on a given date in the audit log
+func taskStatusAuditTarget(userId string, taskId string, forDate datekvs.Date) string {
+	return fmt.Sprintf("%s/%s/%s", userId, taskId, forDate)
+}
+
+// updateTaskPoints awards the task points when the task is done, and takes them back if it is no longer done
+func updateTaskPoints(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	forDate datekvs.Date,
+	ft *shpankids.FamilyTaskDto,
+	done bool,
+) error {
+	ledgerRepo, err := points.NewLedgerRepository(ctx, kvs, familyId, userId)
+	if err != nil {
+		return err
+	}
+	entryKey := points.TaskEntryKey(ft.TaskId)
+	if done && ft.Points > 0 {
+		return ledgerRepo.Set(ctx, forDate, entryKey, points.DbPointsEntry{
+			Points:  ft.Points,
+			Reason:  fmt.Sprintf("Done %s", ft.Title),
+			Created: time.Now(),
+		})
+	}
+	existing, err := ledgerRepo.Find(ctx, forDate, entryKey)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return nil
+	}
+	return ledgerRepo.Unset(ctx, forDate, entryKey)
 }
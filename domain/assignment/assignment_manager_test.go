@@ -0,0 +1,147 @@
+package assignment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"shpankids/infra/database/datekvs"
+	"shpankids/shpankids"
+)
+
+func TestIsScheduledOn(t *testing.T) {
+	// Created in the evening of Monday 2026-10-05, in a time zone east of UTC
+	taskCreated := time.Date(2026, 10, 5, 22, 30, 0, 0, time.FixedZone("UTC+3", 3*60*60))
+	startDate := datekvs.NewDate(2026, 10, 7)
+	endDate := datekvs.NewDate(2026, 10, 9)
+
+	tests := []struct {
+		name     string
+		schedule *shpankids.TaskScheduleDto
+		forDate  datekvs.Date
+		want     bool
+	}{
+		{
+			name:    "no schedule",
+			forDate: datekvs.NewDate(2026, 10, 5),
+			want:    true,
+		},
+		{
+			name:     "weekly on a scheduled day",
+			schedule: &shpankids.TaskScheduleDto{WeekDays: []time.Weekday{time.Monday, time.Wednesday}},
+			forDate:  datekvs.NewDate(2026, 10, 7),
+			want:     true,
+		},
+		{
+			name:     "weekly on another day",
+			schedule: &shpankids.TaskScheduleDto{WeekDays: []time.Weekday{time.Monday, time.Wednesday}},
+			forDate:  datekvs.NewDate(2026, 10, 8),
+			want:     false,
+		},
+		{
+			name:     "weekly on the next week",
+			schedule: &shpankids.TaskScheduleDto{WeekDays: []time.Weekday{time.Monday}},
+			forDate:  datekvs.NewDate(2026, 10, 12),
+			want:     true,
+		},
+		{
+			name:     "interval on the creation date",
+			schedule: &shpankids.TaskScheduleDto{EveryNDays: 3},
+			forDate:  datekvs.NewDate(2026, 10, 5),
+			want:     true,
+		},
+		{
+			name:     "interval counted from the creation date",
+			schedule: &shpankids.TaskScheduleDto{EveryNDays: 3},
+			forDate:  datekvs.NewDate(2026, 10, 8),
+			want:     true,
+		},
+		{
+			name:     "interval between repeats",
+			schedule: &shpankids.TaskScheduleDto{EveryNDays: 3},
+			forDate:  datekvs.NewDate(2026, 10, 7),
+			want:     false,
+		},
+		{
+			name:     "interval before the creation date",
+			schedule: &shpankids.TaskScheduleDto{EveryNDays: 3},
+			forDate:  datekvs.NewDate(2026, 10, 2),
+			want:     false,
+		},
+		{
+			name:     "interval counted from the start date",
+			schedule: &shpankids.TaskScheduleDto{EveryNDays: 2, StartDate: &startDate},
+			forDate:  datekvs.NewDate(2026, 10, 9),
+			want:     true,
+		},
+		{
+			name:     "interval between repeats from the start date",
+			schedule: &shpankids.TaskScheduleDto{EveryNDays: 2, StartDate: &startDate},
+			forDate:  datekvs.NewDate(2026, 10, 8),
+			want:     false,
+		},
+		{
+			name:     "every day interval",
+			schedule: &shpankids.TaskScheduleDto{EveryNDays: 1},
+			forDate:  datekvs.NewDate(2026, 10, 6),
+			want:     true,
+		},
+		{
+			name:     "day before the start date",
+			schedule: &shpankids.TaskScheduleDto{StartDate: &startDate},
+			forDate:  datekvs.NewDate(2026, 10, 6),
+			want:     false,
+		},
+		{
+			name:     "on the start date",
+			schedule: &shpankids.TaskScheduleDto{StartDate: &startDate},
+			forDate:  datekvs.NewDate(2026, 10, 7),
+			want:     true,
+		},
+		{
+			name:     "on the end date",
+			schedule: &shpankids.TaskScheduleDto{EndDate: &endDate},
+			forDate:  datekvs.NewDate(2026, 10, 9),
+			want:     true,
+		},
+		{
+			name:     "day after the end date",
+			schedule: &shpankids.TaskScheduleDto{EndDate: &endDate},
+			forDate:  datekvs.NewDate(2026, 10, 10),
+			want:     false,
+		},
+		{
+			name: "excluded date",
+			schedule: &shpankids.TaskScheduleDto{
+				ExcludedDates: []datekvs.Date{datekvs.NewDate(2026, 10, 8)},
+			},
+			forDate: datekvs.NewDate(2026, 10, 8),
+			want:    false,
+		},
+		{
+			name: "weekly within the start and end dates",
+			schedule: &shpankids.TaskScheduleDto{
+				WeekDays:  []time.Weekday{time.Thursday},
+				StartDate: &startDate,
+				EndDate:   &endDate,
+			},
+			forDate: datekvs.NewDate(2026, 10, 8),
+			want:    true,
+		},
+		{
+			name: "weekly after the end date",
+			schedule: &shpankids.TaskScheduleDto{
+				WeekDays:  []time.Weekday{time.Monday},
+				StartDate: &startDate,
+				EndDate:   &endDate,
+			},
+			forDate: datekvs.NewDate(2026, 10, 12),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isScheduledOn(tt.schedule, taskCreated, tt.forDate))
+		})
+	}
+}
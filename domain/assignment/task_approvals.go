@@ -0,0 +1,185 @@
+package assignment
+
+import (
+	"context"
+	"fmt"
+	"shpankids/domain/audit"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/database/kvstore"
+	"shpankids/infra/shpanstream"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"slices"
+	"time"
+)
+
+func isFamilyAdmin(f *shpankids.FamilyDto, userId string) bool {
+	return slices.ContainsFunc(f.Members, func(fm shpankids.FamilyMemberDto) bool {
+		return userId == fm.UserId && fm.Role == shpankids.RoleAdmin
+	})
+}
+
+// getAdminSession returns the caller and its session, making sure the caller is an admin of the session family
+func (m *managerImpl) getAdminSession(ctx context.Context, action string) (string, *shpankids.Session, error) {
+	userId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	s, err := m.sessionManager.Get(ctx, *userId)
+	if err != nil {
+		return "", nil, err
+	}
+	f, err := m.familyManager.GetFamily(ctx, s.FamilyId)
+	if err != nil {
+		return "", nil, err
+	}
+	if !isFamilyAdmin(f, *userId) {
+		return "", nil, util.ForbiddenError(fmt.Errorf("only admin can %s for family %s", action, f.Name))
+	}
+	return *userId, s, nil
+}
+
+// updatePendingTaskApproval adds the done mark to the family approvals queue, or removes it when it is no longer
+// pending
+func updatePendingTaskApproval(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	forDate datekvs.Date,
+	taskId string,
+	comment string,
+	pending bool,
+) error {
+	repo, err := newPendingTaskApprovalsRepository(ctx, kvs, familyId)
+	if err != nil {
+		return err
+	}
+	key := pendingTaskApprovalKey(userId, forDate, taskId)
+	if pending {
+		return repo.Set(ctx, key, dbPendingTaskApproval{
+			UserId:  userId,
+			TaskId:  taskId,
+			ForDate: forDate,
+			Comment: comment,
+			Claimed: time.Now(),
+		})
+	}
+	existing, err := repo.Find(ctx, key)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return nil
+	}
+	return repo.Unset(ctx, key)
+}
+
+func (m *managerImpl) ListPendingTaskApprovals(ctx context.Context) shpanstream.Stream[shpankids.TaskApprovalDto] {
+	_, s, err := m.getAdminSession(ctx, "view pending task approvals")
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.TaskApprovalDto](err)
+	}
+	familyTasks, err := m.familyManager.ListFamilyTasks(ctx, s.FamilyId).CollectFilterNil(ctx)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.TaskApprovalDto](err)
+	}
+	repo, err := newPendingTaskApprovalsRepository(ctx, m.kvs, s.FamilyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.TaskApprovalDto](err)
+	}
+	return shpanstream.MapStream(
+		repo.Stream(ctx),
+		func(e *functional.Entry[string, dbPendingTaskApproval]) *shpankids.TaskApprovalDto {
+			ret := &shpankids.TaskApprovalDto{
+				ApprovalId: e.Key,
+				UserId:     e.Value.UserId,
+				TaskId:     e.Value.TaskId,
+				ForDate:    e.Value.ForDate,
+				Comment:    e.Value.Comment,
+				Claimed:    e.Value.Claimed,
+			}
+			ft := functional.FindFirst(familyTasks, func(ft shpankids.FamilyTaskDto) bool {
+				return ft.TaskId == e.Value.TaskId
+			})
+			if ft != nil {
+				ret.TaskTitle = taskRevisionForDate(*ft, e.Value.ForDate).Title
+			}
+			return ret
+		},
+	)
+}
+
+func (m *managerImpl) DecideTaskApproval(ctx context.Context, approvalId string, approve bool, comment string) error {
+	adminId, s, err := m.getAdminSession(ctx, "decide on task approvals")
+	if err != nil {
+		return err
+	}
+	familyTasks, err := m.familyManager.ListFamilyTasks(ctx, s.FamilyId).CollectFilterNil(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		repo, err := newPendingTaskApprovalsRepository(ctx, tx, s.FamilyId)
+		if err != nil {
+			return err
+		}
+		pa, err := repo.Find(ctx, approvalId)
+		if err != nil {
+			return err
+		}
+		if pa == nil {
+			return util.NotFoundError(fmt.Errorf("task approval %s not found", approvalId))
+		}
+		tsr, err := NewUserTaskStatusRepository(ctx, tx, s.FamilyId, pa.UserId)
+		if err != nil {
+			return err
+		}
+		ts, err := tsr.Get(ctx, pa.ForDate, pa.TaskId)
+		if err != nil {
+			return err
+		}
+
+		before := ts
+
+		// Rejected tasks are reopened, so the member can complete them and ask again
+		ts.Status = shpankids.StatusOpen
+		if approve {
+			ts.Status = shpankids.StatusDone
+		}
+		ts.ReviewedBy = adminId
+		ts.ReviewComment = comment
+		ts.ReviewTime = time.Now()
+		err = tsr.Set(ctx, pa.ForDate, pa.TaskId, ts)
+		if err != nil {
+			return err
+		}
+		err = repo.Unset(ctx, approvalId)
+		if err != nil {
+			return err
+		}
+		err = audit.Record(
+			ctx,
+			tx,
+			m.userSessionManager,
+			s.FamilyId,
+			shpankids.AuditActionTaskApprovalDecide,
+			taskStatusAuditTarget(pa.UserId, pa.TaskId, pa.ForDate),
+			before,
+			ts,
+		)
+		if err != nil {
+			return err
+		}
+
+		ft := functional.FindFirst(familyTasks, func(ft shpankids.FamilyTaskDto) bool {
+			return ft.TaskId == pa.TaskId
+		})
+		if ft == nil {
+			return nil
+		}
+		return updateTaskPoints(ctx, tx, s.FamilyId, pa.UserId, pa.ForDate, ft, approve)
+	})
+}
@@ -0,0 +1,43 @@
+package assignment
+
+import (
+	"context"
+	"fmt"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/database/kvstore"
+	"time"
+)
+
+type dbPendingTaskApproval struct {
+	UserId  string       `json:"userId"`
+	TaskId  string       `json:"taskId"`
+	ForDate datekvs.Date `json:"forDate"`
+	Comment string       `json:"comment,omitempty"`
+	Claimed time.Time    `json:"claimed"`
+}
+
+type pendingTaskApprovalsRepository kvstore.JsonKvStore[string, dbPendingTaskApproval]
+
+// newPendingTaskApprovalsRepository holds the done marks waiting for an admin approval in a family, an entry is
+// removed once the admin decides or the member changes the task status
+func newPendingTaskApprovalsRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+) (pendingTaskApprovalsRepository, error) {
+	familyStore, err := kvs.CreateSpaceStore(ctx, []string{"families", familyId})
+	if err != nil {
+		return nil, err
+	}
+	return kvstore.NewJsonKvStoreImpl[string, dbPendingTaskApproval](
+		familyStore,
+		"pendingTaskApprovals",
+		kvstore.StringKeyToString,
+		kvstore.StringToKey,
+	), nil
+}
+
+// pendingTaskApprovalKey identifies the done mark of a user on a task for a given date
+func pendingTaskApprovalKey(userId string, forDate datekvs.Date, taskId string) string {
+	return fmt.Sprintf("%s_%s_%s", userId, forDate.String(), taskId)
+}
@@ -12,12 +12,27 @@ type dbUserTaskStatus struct {
 	Comment    string                     `json:"comment"`
 	Status     shpankids.AssignmentStatus `json:"status"`
 	StatusTime time.Time                  `json:"statusTime"`
+
+	// UpdatedBy is the user who set the status, an admin when updating on behalf of the member
+	UpdatedBy string `json:"updatedBy,omitempty"`
+
+	// Review fields are set once an admin approves or rejects a task that requires approval
+	ReviewedBy    string    `json:"reviewedBy,omitempty"`
+	ReviewComment string    `json:"reviewComment,omitempty"`
+	ReviewTime    time.Time `json:"reviewTime,omitempty"`
 }
 
 type UserTaskStatusRepository datekvs.DateKvStore[dbUserTaskStatus]
 
-func NewUserTaskStatusRepository(ctx context.Context, kvs kvstore.RawJsonStore, userId string) (UserTaskStatusRepository, error) {
-	byUserKvs, err := kvs.CreateSpaceStore(ctx, []string{"users", userId})
+// NewUserTaskStatusRepository keeps the task statuses of a user in a family, a user may be a member of several
+// families
+func NewUserTaskStatusRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+) (UserTaskStatusRepository, error) {
+	byUserKvs, err := kvs.CreateSpaceStore(ctx, []string{"families", familyId, "users", userId})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/database/kvstore"
+	"shpankids/infra/shpanstream"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"slices"
+	"strings"
+)
+
+type manager struct {
+	kvs                kvstore.RawJsonStore
+	userSessionManager shpankids.UserSessionManager
+	familyManager      shpankids.FamilyManager
+	sessionManager     shpankids.SessionManager
+}
+
+func NewAuditManager(
+	kvs kvstore.RawJsonStore,
+	userSessionManager shpankids.UserSessionManager,
+	familyManager shpankids.FamilyManager,
+	sessionManager shpankids.SessionManager,
+) shpankids.AuditManager {
+	return &manager{
+		kvs:                kvs,
+		userSessionManager: userSessionManager,
+		familyManager:      familyManager,
+		sessionManager:     sessionManager,
+	}
+}
+
+func (m *manager) ListAuditLog(
+	ctx context.Context,
+	familyId string,
+	from datekvs.Date,
+	to datekvs.Date,
+	filter shpankids.AuditFilterDto,
+) shpanstream.Stream[shpankids.AuditEntryDto] {
+	userId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.AuditEntryDto](err)
+	}
+	f, err := m.familyManager.GetFamily(ctx, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.AuditEntryDto](err)
+	}
+	if !slices.ContainsFunc(f.Members, func(fm shpankids.FamilyMemberDto) bool {
+		return *userId == fm.UserId && fm.Role == shpankids.RoleAdmin
+	}) {
+		return shpanstream.NewErrorStream[shpankids.AuditEntryDto](
+			util.ForbiddenError(fmt.Errorf("only admin can view the audit log of family %s", f.Name)),
+		)
+	}
+	s, err := m.sessionManager.Get(ctx, *userId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.AuditEntryDto](err)
+	}
+	repo, err := newRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.AuditEntryDto](err)
+	}
+
+	// Entries are kept by their UTC date, so the range is widened by a day on each side and then the entries are
+	// filtered by their date in the user's time zone
+	utcDates := datekvs.NewDateRangeStream(
+		*datekvs.NewDateFromTime(from.AddDate(0, 0, -1)),
+		*datekvs.NewDateFromTime(to.AddDate(0, 0, 1)),
+	)
+	return shpanstream.FlatMapStream(utcDates, func(d *datekvs.Date) shpanstream.Stream[shpankids.AuditEntryDto] {
+		entries, err := repo.StreamAllForDate(ctx, *d).CollectFilterNil(ctx)
+		if err != nil {
+			return shpanstream.NewErrorStream[shpankids.AuditEntryDto](err)
+		}
+
+		// Keys start with the entry time, sorting them keeps the log chronological regardless of the store
+		slices.SortFunc(entries, func(a, b functional.Entry[string, dbAuditEntry]) int {
+			return strings.Compare(a.Key, b.Key)
+		})
+		return shpanstream.MapStreamWhileFiltering(
+			shpanstream.Just(entries...),
+			func(e *functional.Entry[string, dbAuditEntry]) *shpankids.AuditEntryDto {
+				entryDate := datekvs.NewDateFromTime(e.Value.Time.In(s.Location))
+				if entryDate.Before(from.Time) || entryDate.After(to.Time) {
+					return nil
+				}
+				if (filter.ActorId != "" && filter.ActorId != e.Value.ActorId) ||
+					(filter.Action != "" && filter.Action != e.Value.Action) ||
+					(filter.TargetId != "" && filter.TargetId != e.Value.TargetId) {
+					return nil
+				}
+				return &shpankids.AuditEntryDto{
+					EntryId:  e.Key,
+					Time:     e.Value.Time,
+					ActorId:  e.Value.ActorId,
+					Action:   e.Value.Action,
+					TargetId: e.Value.TargetId,
+					Before:   compactJson(e.Value.Before),
+					After:    compactJson(e.Value.After),
+				}
+			},
+		)
+	})
+}
+
+func compactJson(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/database/kvstore"
+	"shpankids/shpankids"
+	"time"
+)
+
+type dbAuditEntry struct {
+	Time     time.Time             `json:"time"`
+	ActorId  string                `json:"actorId"`
+	Action   shpankids.AuditAction `json:"action"`
+	TargetId string                `json:"targetId"`
+	Before   json.RawMessage       `json:"before,omitempty"`
+	After    json.RawMessage       `json:"after,omitempty"`
+}
+
+type repository datekvs.DateKvStore[dbAuditEntry]
+
+// newRepository returns the audit log of a family, entries are kept by their UTC date
+func newRepository(ctx context.Context, kvs kvstore.RawJsonStore, familyId string) (repository, error) {
+	auditStore, err := kvs.CreateSpaceStore(ctx, []string{"audit", familyId})
+	if err != nil {
+		return nil, err
+	}
+	return datekvs.NewDateKvsImpl[dbAuditEntry](auditStore), nil
+}
+
+// Record appends an entry to the family audit log, the actor is the logged-in user. before and after are the
+// changed record before and after the change, nil when the record was created or removed.
+// When called inside a transaction, kvs should be the transaction store, so the entry is only kept if the change is.
+func Record(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	userSessionManager shpankids.UserSessionManager,
+	familyId string,
+	action shpankids.AuditAction,
+	targetId string,
+	before any,
+	after any,
+) error {
+	actorId, err := userSessionManager(ctx)
+	if err != nil {
+		return err
+	}
+	beforeJson, err := marshalIfNotNil(before)
+	if err != nil {
+		return err
+	}
+	afterJson, err := marshalIfNotNil(after)
+	if err != nil {
+		return err
+	}
+	repo, err := newRepository(ctx, kvs, familyId)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+
+	// Keys start with the time, so entries of the same day are kept in chronological order
+	return repo.Set(
+		ctx,
+		*datekvs.NewDateFromTime(now),
+		fmt.Sprintf("%019d-%s", now.UnixNano(), uuid.NewString()),
+		dbAuditEntry{
+			Time:     now,
+			ActorId:  *actorId,
+			Action:   action,
+			TargetId: targetId,
+			Before:   beforeJson,
+			After:    afterJson,
+		},
+	)
+}
+
+func marshalIfNotNil(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	ret, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Typed nil pointers are marshaled to null
+	if string(ret) == "null" {
+		return nil, nil
+	}
+	return ret, nil
+}
@@ -0,0 +1,61 @@
+package family
+
+import (
+	"context"
+	"shpankids/infra/database/kvstore"
+	"shpankids/shpankids"
+	"time"
+)
+
+const invitationsSpaceStoreUri = "invitations"
+
+type dbFamilyInvitation struct {
+	FamilyId  string         `json:"familyId"`
+	Email     string         `json:"email"`
+	Role      shpankids.Role `json:"role"`
+	FirstName string         `json:"firstName,omitempty"`
+	LastName  string         `json:"lastName,omitempty"`
+	InvitedBy string         `json:"invitedBy"`
+	Created   time.Time      `json:"created"`
+}
+
+type familyInvitationsRepository kvstore.JsonKvStore[string, dbFamilyInvitation]
+
+// newFamilyInvitationsRepository holds the pending invitations of a family, keyed by the invited email
+func newFamilyInvitationsRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+) (familyInvitationsRepository, error) {
+	familyStore, err := kvs.CreateSpaceStore(ctx, []string{familiesSpaceStoreUri, familyId})
+	if err != nil {
+		return nil, err
+	}
+
+	return kvstore.NewJsonKvStoreImpl[string, dbFamilyInvitation](
+		familyStore,
+		invitationsSpaceStoreUri,
+		kvstore.StringKeyToString,
+		kvstore.StringToKey,
+	), nil
+}
+
+// newUserInvitationsRepository holds the pending invitations of an email, keyed by family id, so they can be
+// found on login
+func newUserInvitationsRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	email string,
+) (familyInvitationsRepository, error) {
+	userStore, err := kvs.CreateSpaceStore(ctx, []string{invitationsSpaceStoreUri, email})
+	if err != nil {
+		return nil, err
+	}
+
+	return kvstore.NewJsonKvStoreImpl[string, dbFamilyInvitation](
+		userStore,
+		familiesSpaceStoreUri,
+		kvstore.StringKeyToString,
+		kvstore.StringToKey,
+	), nil
+}
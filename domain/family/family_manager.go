@@ -1,10 +1,14 @@
 package family
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"github.com/google/uuid"
 	"shpankids/domain/ai"
+	"shpankids/domain/aiusage"
+	"shpankids/domain/audit"
+	"shpankids/domain/points"
 	"shpankids/domain/problemset"
 	"shpankids/infra/database/datekvs"
 	"shpankids/infra/database/kvstore"
@@ -15,6 +19,7 @@ import (
 	"shpankids/openapi"
 	"shpankids/shpankids"
 	"slices"
+	"strings"
 	"time"
 )
 
@@ -22,16 +27,22 @@ type Manager struct {
 	familyRepository   repository
 	userSessionManager shpankids.UserSessionManager
 	kvs                kvstore.RawJsonStore
+	aiProvider         ai.Provider
+	userManager        shpankids.UserManager
 }
 
 func NewFamilyManager(
 	kvs kvstore.RawJsonStore,
 	userSessionManager shpankids.UserSessionManager,
+	aiProvider ai.Provider,
+	userManager shpankids.UserManager,
 ) *Manager {
 	return &Manager{
 		familyRepository:   newFamilyRepository(kvs),
 		userSessionManager: userSessionManager,
 		kvs:                kvs,
+		aiProvider:         aiProvider,
+		userManager:        userManager,
 	}
 }
 
@@ -58,8 +69,26 @@ func (m *Manager) GetFamily(ctx context.Context, familyId string) (*shpankids.Fa
 
 }
 
+// ListFamiliesForUser streams the families the user is a member of
+func (m *Manager) ListFamiliesForUser(ctx context.Context, userId string) shpanstream.Stream[shpankids.FamilyDto] {
+	familyIds, err := userFamilyIds(ctx, m.kvs, userId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.FamilyDto](err)
+	}
+	return shpanstream.MapStreamWhileFilteringWithError(
+		shpanstream.Just(familyIds...),
+		func(ctx context.Context, familyId *string) (*shpankids.FamilyDto, error) {
+			dbFam, err := m.familyRepository.Find(ctx, *familyId)
+			if err != nil || dbFam == nil || !isDbFamilyMember(dbFam, userId) {
+				return nil, err
+			}
+			return mapFamilyDto(dbFam), nil
+		},
+	)
+}
+
 func mapFamilyDto(fam *dbFamily) *shpankids.FamilyDto {
-	return &shpankids.FamilyDto{
+	ret := &shpankids.FamilyDto{
 		Id:         fam.Id,
 		Name:       fam.Name,
 		OwnerEmail: fam.CreatedBy,
@@ -70,7 +99,55 @@ func mapFamilyDto(fam *dbFamily) *shpankids.FamilyDto {
 				Role:   member.Role,
 			}
 		}),
+		TaskBackEditDays:        defaultTaskBackEditDays,
+		AiFamilyDailyCallsLimit: defaultAiFamilyDailyCallsLimit,
+		AiUserDailyCallsLimit:   defaultAiUserDailyCallsLimit,
+	}
+	if fam.TaskBackEditDays != nil {
+		ret.TaskBackEditDays = *fam.TaskBackEditDays
+	}
+	if fam.AiFamilyDailyCallsLimit != nil {
+		ret.AiFamilyDailyCallsLimit = *fam.AiFamilyDailyCallsLimit
+	}
+	if fam.AiUserDailyCallsLimit != nil {
+		ret.AiUserDailyCallsLimit = *fam.AiUserDailyCallsLimit
+	}
+	return ret
+}
+
+func (m *Manager) UpdateFamilySettings(ctx context.Context, familyId string, settings shpankids.FamilySettingsDto) error {
+	if settings.TaskBackEditDays < 0 {
+		return util.BadInputError(fmt.Errorf("task back edit days must not be negative"))
+	}
+	if settings.AiFamilyDailyCallsLimit < 0 || settings.AiUserDailyCallsLimit < 0 {
+		return util.BadInputError(fmt.Errorf("AI daily calls limits must not be negative"))
+	}
+	dbFam, err := m.getFamilyAsAdmin(ctx, familyId, "update family settings")
+	if err != nil {
+		return err
+	}
+	before := dbFam
+	dbFam.TaskBackEditDays = &settings.TaskBackEditDays
+	dbFam.AiFamilyDailyCallsLimit = &settings.AiFamilyDailyCallsLimit
+	dbFam.AiUserDailyCallsLimit = &settings.AiUserDailyCallsLimit
+	err = m.familyRepository.Set(ctx, familyId, dbFam)
+	if err != nil {
+		return err
 	}
+	return m.recordAudit(ctx, m.kvs, familyId, shpankids.AuditActionFamilyUpdateSettings, familyId, before, dbFam)
+}
+
+// recordAudit records a change made by the logged-in user in the family audit log
+func (m *Manager) recordAudit(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	action shpankids.AuditAction,
+	targetId string,
+	before any,
+	after any,
+) error {
+	return audit.Record(ctx, kvs, m.userSessionManager, familyId, action, targetId, before, after)
 }
 
 func (m *Manager) CreateFamilyTask(ctx context.Context, familyId string, familyTask shpankids.FamilyTaskDto) error {
@@ -83,6 +160,13 @@ func (m *Manager) CreateFamilyTask(ctx context.Context, familyId string, familyT
 	if len(familyTask.MemberIds) == 0 {
 		return util.BadInputError(fmt.Errorf("at least one member is required for a task"))
 	}
+	if familyTask.Points < 0 {
+		return util.BadInputError(fmt.Errorf("task points must not be negative"))
+	}
+	err := validateTaskSchedule(familyTask.Schedule)
+	if err != nil {
+		return err
+	}
 
 	// Get the user email from the context
 	uId, err := m.userSessionManager(ctx)
@@ -103,267 +187,1267 @@ func (m *Manager) CreateFamilyTask(ctx context.Context, familyId string, familyT
 		return util.ForbiddenError(fmt.Errorf("only admin can create family tasks for family %s", f.Name))
 	}
 
-	// Check if all task members are part of the family
+	err = validateTaskMembers(f, familyTask.MemberIds)
+	if err != nil {
+		return err
+	}
+
+	repo, err := newFamilyTaskRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return err
+	}
+	// Create the family task in repo
+
+	familyTask.Created = time.Now()
+	dbTask := dbFamilyTask{
+		Title:            familyTask.Title,
+		Description:      familyTask.Description,
+		MemberIds:        familyTask.MemberIds,
+		Status:           shpankids.FamilyAssignmentStatusActive,
+		Created:          familyTask.Created,
+		StatusDate:       familyTask.Created,
+		Points:           familyTask.Points,
+		Schedule:         mapTaskScheduleDtoToDb(familyTask.Schedule),
+		RequiresApproval: familyTask.RequiresApproval,
+	}
+	err = repo.Set(ctx, familyTask.TaskId, dbTask)
+	if err != nil {
+		return err
+	}
+	return m.recordAudit(ctx, m.kvs, familyId, shpankids.AuditActionTaskCreate, familyTask.TaskId, nil, dbTask)
+}
+
+// validateTaskMembers checks that all task members are part of the family
+func validateTaskMembers(f *shpankids.FamilyDto, memberIds []string) error {
 	famMembersSet := functional.SliceToSetExtractKeyNoErr(f.Members, func(member shpankids.FamilyMemberDto) string {
 		return member.UserId
 	})
 
-	for _, memberId := range familyTask.MemberIds {
+	for _, memberId := range memberIds {
 		if _, ok := famMembersSet[memberId]; !ok {
 			return util.BadInputError(fmt.Errorf("member %s is not part of the family %s", memberId, f.Name))
 		}
 	}
+	return nil
+}
+
+func (m *Manager) UpdateFamilyTask(ctx context.Context, familyId string, familyTask shpankids.FamilyTaskDto) error {
+	if familyTask.TaskId == "" {
+		return util.BadInputError(fmt.Errorf("task id is required"))
+	}
+	if familyTask.Title == "" {
+		return util.BadInputError(fmt.Errorf("title is required"))
+	}
+	if len(familyTask.MemberIds) == 0 {
+		return util.BadInputError(fmt.Errorf("at least one member is required for a task"))
+	}
+	if familyTask.Points < 0 {
+		return util.BadInputError(fmt.Errorf("task points must not be negative"))
+	}
+	err := validateTaskSchedule(familyTask.Schedule)
+	if err != nil {
+		return err
+	}
+
+	// Get the user email from the context
+	uId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err := m.GetFamily(ctx, familyId)
+	if err != nil {
+		return err
+	}
+
+	// Check if the user is and admin of the family
+	isAdmin := slices.ContainsFunc(f.Members, func(member shpankids.FamilyMemberDto) bool {
+		return member.UserId == *uId && member.Role == shpankids.RoleAdmin
+	})
+	if !isAdmin {
+		return util.ForbiddenError(fmt.Errorf("only admin can update family tasks for family %s", f.Name))
+	}
+
+	err = validateTaskMembers(f, familyTask.MemberIds)
+	if err != nil {
+		return err
+	}
 
 	repo, err := newFamilyTaskRepository(ctx, m.kvs, familyId)
 	if err != nil {
 		return err
 	}
-	// Create the family task in repo
+	ft, err := repo.Get(ctx, familyTask.TaskId)
+	if err != nil {
+		return err
+	}
+	if ft.Status != shpankids.FamilyAssignmentStatusActive {
+		return util.BadInputError(fmt.Errorf("task %s was deleted and can't be updated", ft.Title))
+	}
 
-	familyTask.Created = time.Now()
-	return repo.Set(ctx, familyTask.TaskId, dbFamilyTask{
-		Title:       familyTask.Title,
-		Description: familyTask.Description,
-		MemberIds:   familyTask.MemberIds,
-		Status:      shpankids.FamilyAssignmentStatusActive,
-		Created:     familyTask.Created,
-		StatusDate:  familyTask.Created,
+	before := ft
+
+	// Keeping the previous version of the task, so past dates keep reflecting what was assigned back then
+	ft.History = append(ft.History, dbFamilyTaskRevision{
+		Title:       ft.Title,
+		Description: ft.Description,
+		MemberIds:   ft.MemberIds,
+		Schedule:    ft.Schedule,
+		ValidUntil:  time.Now(),
 	})
+	ft.Title = familyTask.Title
+	ft.Description = familyTask.Description
+	ft.MemberIds = familyTask.MemberIds
+	ft.Points = familyTask.Points
+	ft.RequiresApproval = familyTask.RequiresApproval
+	ft.Schedule = mapTaskScheduleDtoToDb(familyTask.Schedule)
+	err = repo.Set(ctx, familyTask.TaskId, ft)
+	if err != nil {
+		return err
+	}
+	return m.recordAudit(ctx, m.kvs, familyId, shpankids.AuditActionTaskUpdate, familyTask.TaskId, before, ft)
 }
+
 func (m *Manager) CreateProblemSet(ctx context.Context, familyId string, forUserId string, familyProblemSet shpankids.CreateProblemSetDto) error {
-	psRepo, err := newProblemSetsRepository(ctx, m.kvs, familyId, forUserId)
+	err := validateProblemSetSettings(familyProblemSet)
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		return m.createProblemSet(ctx, tx, familyId, forUserId, familyProblemSet)
+	})
+}
+
+// createProblemSet stores a new problem set of the user through tx, failing when its id is already used
+func (m *Manager) createProblemSet(
+	ctx context.Context,
+	tx kvstore.RawJsonStore,
+	familyId string,
+	forUserId string,
+	familyProblemSet shpankids.CreateProblemSetDto,
+) error {
+	psRepo, err := newProblemSetsRepository(ctx, tx, familyId, forUserId)
+	if err != nil {
+		return err
+	}
+	existing, err := psRepo.Find(ctx, familyProblemSet.ProblemSetId)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return util.DuplicateInputError(fmt.Errorf("problem set %s already exists", familyProblemSet.ProblemSetId))
+	}
+
+	// Template copies have the template id, so the id of a template can't be used by a member problem set
+	tRepo, err := newProblemSetTemplatesRepository(ctx, tx, familyId)
+	if err != nil {
+		return err
+	}
+	template, err := tRepo.Find(ctx, familyProblemSet.ProblemSetId)
 	if err != nil {
 		return err
 	}
+	if template != nil {
+		return util.DuplicateInputError(fmt.Errorf(
+			"problem set template %s already exists, problem sets can't have the same id",
+			familyProblemSet.ProblemSetId,
+		))
+	}
+
 	createTime := time.Now()
-	// Create the family task in repo
-	return psRepo.Set(
+	dbPs := problemset.DbProblemSet{
+		Title:       familyProblemSet.Title,
+		Description: familyProblemSet.Description,
+		Created:     createTime,
+		Status:      shpankids.FamilyAssignmentStatusActive,
+		StatusDate:  createTime,
+		Points:      familyProblemSet.Points,
+		DailyQuota:  familyProblemSet.DailyQuota,
+		QuotaType:   familyProblemSet.QuotaType,
+		MaxAttempts: familyProblemSet.MaxAttempts,
+	}
+	err = psRepo.Set(ctx, familyProblemSet.ProblemSetId, dbPs)
+	if err != nil {
+		return err
+	}
+	return m.recordAudit(
 		ctx,
-		familyProblemSet.ProblemSetId,
-		problemset.DbProblemSet{
-			Title:       familyProblemSet.Title,
-			Description: familyProblemSet.Description,
-			Created:     createTime,
-			Status:      shpankids.FamilyAssignmentStatusActive,
-			StatusDate:  createTime,
-		})
+		tx,
+		familyId,
+		shpankids.AuditActionProblemSetCreate,
+		problemSetAuditTarget(forUserId, familyProblemSet.ProblemSetId),
+		nil,
+		dbPs,
+	)
+}
+
+func validateProblemSetSettings(familyProblemSet shpankids.CreateProblemSetDto) error {
+	if familyProblemSet.Points < 0 {
+		return util.BadInputError(fmt.Errorf("problem set points must not be negative"))
+	}
+	if familyProblemSet.DailyQuota < 0 {
+		return util.BadInputError(fmt.Errorf("problem set daily quota must not be negative"))
+	}
+	if familyProblemSet.MaxAttempts < 0 {
+		return util.BadInputError(fmt.Errorf("problem set max attempts must not be negative"))
+	}
+	switch familyProblemSet.QuotaType {
+	case "", shpankids.ProblemSetQuotaTypeProblems, shpankids.ProblemSetQuotaTypeCorrectAnswers:
+	default:
+		return util.BadInputError(fmt.Errorf("invalid problem set quota type: %s", familyProblemSet.QuotaType))
+	}
+	return nil
+}
+
+// problemSetAuditTarget identifies a problem set in the audit log, problem sets are kept per user
+func problemSetAuditTarget(userId string, problemSetId string) string {
+	return fmt.Sprintf("%s/%s", userId, problemSetId)
+}
+
+func (m *Manager) CreateProblemsInSet(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	problemSetId string,
+	familyProblem []shpankids.CreateProblemDto,
+) error {
+	// Get the user email from the context
+	uId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err := m.GetFamily(ctx, familyId)
+	if err != nil {
+		return err
+	}
+
+	// Check if the user is and admin of the family
+	isAdmin := slices.ContainsFunc(f.Members, func(member shpankids.FamilyMemberDto) bool {
+		return member.UserId == *uId && member.Role == shpankids.RoleAdmin
+	})
+	if !isAdmin {
+		return util.ForbiddenError(fmt.Errorf("only admin can create family tasks for family %s", f.Name))
+	}
+	// Check if all task members are part of the family
+	famMembersSet := functional.SliceToSetExtractKeyNoErr(f.Members, func(member shpankids.FamilyMemberDto) string {
+		return member.UserId
+	})
+
+	if _, ok := famMembersSet[forUserId]; !ok {
+		return util.BadInputError(fmt.Errorf("user %s is not part of the family %s", forUserId, f.Name))
+	}
+	err = m.validateNotTemplateCopy(ctx, familyId, forUserId, problemSetId)
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		return m.createProblemsInSet(ctx, tx, familyId, forUserId, problemSetId, familyProblem)
+	})
+}
+
+// createProblemsInSet stores new problems through tx, placing them after the existing problems of the set
+func (m *Manager) createProblemsInSet(
+	ctx context.Context,
+	tx kvstore.RawJsonStore,
+	familyId string,
+	forUserId string,
+	problemSetId string,
+	familyProblem []shpankids.CreateProblemDto,
+) error {
+	repo, err := newFamilyProblemsRepository(ctx, tx, familyId, forUserId, problemSetId)
+	if err != nil {
+		return err
+	}
+
+	// New problems are placed after all the existing ones
+	lastOrder := 0
+	err = repo.StreamIncludingArchived(ctx).Consume(ctx, func(e *functional.Entry[string, problemset.DbProblem]) {
+		lastOrder = max(lastOrder, e.Value.Order)
+	})
+	if err != nil {
+		return err
+	}
+
+	createdTime := time.Now()
+	createdProblems := make(map[string]problemset.DbProblem, len(familyProblem))
+	for idx, p := range familyProblem {
+		err = validateCreateProblem(p)
+		if err != nil {
+			return err
+		}
+
+		// Create the family task in repo
+		problemId := uuid.NewString()
+		createdProblems[problemId] = toDbProblem(p, createdTime, lastOrder+idx+1)
+		err = repo.Set(ctx, problemId, createdProblems[problemId])
+		if err != nil {
+			return err
+		}
+	}
+	return m.recordAudit(
+		ctx,
+		tx,
+		familyId,
+		shpankids.AuditActionProblemsCreate,
+		problemSetAuditTarget(forUserId, problemSetId),
+		nil,
+		createdProblems,
+	)
+}
+
+func (m *Manager) SubmitProblemAnswer(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	problemId string,
+	forDate datekvs.Date,
+	submission shpankids.ProblemAnswerSubmissionDto,
+) (*shpankids.ProblemAnswerResultDto, error) {
+	ps, err := m.getActiveProblemSet(ctx, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	dbP, err := m.findProblemIncludingArchived(ctx, familyId, userId, problemSetId, problemId)
+	if err != nil {
+		return nil, err
+	}
+
+	correct, err := gradeProblemAnswer(problemId, *dbP, submission)
+	if err != nil {
+		return nil, err
+	}
+
+	// Storing the solution, archiving the problem and awarding the points must happen together. The transaction may
+	// be run more than once, so the solution is only kept from the run that is committed
+	var dbPs *problemset.DbProblemSolution
+	remainingAttempts := 0
+	err = m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		txPs, txRemainingAttempts, err := m.storeProblemAnswer(
+			ctx,
+			tx,
+			familyId,
+			userId,
+			ps,
+			problemId,
+			dbP,
+			forDate,
+			submission,
+			correct,
+		)
+		if err != nil {
+			return err
+		}
+		dbPs, remainingAttempts = txPs, txRemainingAttempts
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &shpankids.ProblemAnswerResultDto{
+		Correct: dbPs.Correct,
+		Problem: *mapFamilyProblemDbToDto(
+			&functional.Entry[string, problemset.DbProblem]{Key: problemId, Value: *dbP},
+		),
+		RemainingAttempts: remainingAttempts,
+	}, nil
+
+}
+
+// storeProblemAnswer adds the answer as another attempt of the problem solution for forDate, archiving the problem and
+// awarding its points once the solution is final. It returns the stored solution and the remaining attempts, and
+// changes nothing but kvs, so it can be run again when the transaction is retried
+func (m *Manager) storeProblemAnswer(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	ps *shpankids.FamilyProblemSetDto,
+	problemId string,
+	dbP *problemset.DbProblem,
+	forDate datekvs.Date,
+	submission shpankids.ProblemAnswerSubmissionDto,
+	correct bool,
+) (*problemset.DbProblemSolution, int, error) {
+	problemSetId := ps.ProblemSetId
+	dbPs := problemset.DbProblemSolution{
+		SelectedAnswerIds: submission.AnswerIds,
+		Value:             submission.Value,
+		Correct:           correct,
+	}
+	if problemTypeOrDefault(dbP.Type) == shpankids.ProblemTypeSingleChoice {
+		dbPs.SelectedAnswerId = submission.AnswerIds[0]
+		dbPs.SelectedAnswerIds = nil
+	}
+
+	hintsUsed, err := m.countRevealedHints(ctx, kvs, familyId, userId, problemSetId, problemId)
+	if err != nil {
+		return nil, 0, err
+	}
+	dbPs.HintsUsed = hintsUsed
+	solRepo, err := newFamilyProblemsSolutionsRepository(ctx, kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Adding the attempt to the previous ones, only wrong answers with attempts left can be answered again
+	prevPs, err := solRepo.Find(ctx, forDate, problemId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if prevPs != nil {
+		if !prevPs.InProgress {
+			return nil, 0, util.BadInputError(fmt.Errorf("problem %s was already answered", problemId))
+		}
+		dbPs.Attempts = prevPs.Attempts
+	} else {
+		err = checkProblemCanBeAnswered(ctx, kvs, familyId, userId, problemSetId, problemId, forDate)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	dbPs.Attempts = append(dbPs.Attempts, problemset.DbProblemAttempt{
+		SelectedAnswerIds: submission.AnswerIds,
+		Value:             submission.Value,
+		Correct:           dbPs.Correct,
+		HintsUsed:         dbPs.HintsUsed,
+		Time:              time.Now(),
+	})
+	remainingAttempts := 0
+	if !dbPs.Correct {
+		remainingAttempts = max(ps.MaxAttempts-len(dbPs.Attempts), 0)
+	}
+	dbPs.InProgress = remainingAttempts > 0
+
+	err = solRepo.Set(ctx, forDate, problemId, dbPs)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = m.recordAudit(
+		ctx,
+		kvs,
+		familyId,
+		shpankids.AuditActionProblemAnswerSubmit,
+		fmt.Sprintf("%s/%s", problemSetAuditTarget(userId, problemSetId), problemId),
+		nil,
+		dbPs,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The problem is presented again until it is answered correctly or runs out of attempts
+	if dbPs.InProgress {
+		return &dbPs, remainingAttempts, nil
+	}
+
+	// The hints were used for this answer, the next review of the problem starts without them
+	hintsRepo, err := newFamilyProblemHintRevealsRepository(ctx, kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if dbPs.HintsUsed > 0 {
+		err = hintsRepo.Unset(ctx, problemId)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	pRepo, err := newFamilyProblemsRepository(ctx, kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Once solved, the problem is only presented again when it is due for review
+	activeP, err := pRepo.Find(ctx, problemId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if activeP != nil {
+		err = pRepo.Archive(ctx, problemId)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	earnedPoints := pointsForSolution(ps.Points, dbPs.HintsUsed)
+	if !dbPs.Correct || earnedPoints == 0 {
+		return &dbPs, remainingAttempts, nil
+	}
+	ledgerRepo, err := points.NewLedgerRepository(ctx, kvs, familyId, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	reason := fmt.Sprintf("Solved %s (%s)", dbP.Title, ps.Title)
+	if dbPs.HintsUsed > 0 {
+		reason = fmt.Sprintf("%s with %d hints", reason, dbPs.HintsUsed)
+	}
+	err = ledgerRepo.Set(ctx, forDate, points.ProblemEntryKey(problemSetId, problemId), points.DbPointsEntry{
+		Points:  earnedPoints,
+		Reason:  reason,
+		Created: time.Now(),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return &dbPs, remainingAttempts, nil
+}
+
+// checkProblemCanBeAnswered checks the problem is either new or due for review on forDate, solved problems are
+// archived and can only be answered again once they are due
+func checkProblemCanBeAnswered(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	problemSetId string,
+	problemId string,
+	forDate datekvs.Date,
+) error {
+	pRepo, err := newFamilyProblemsRepository(ctx, kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return err
+	}
+	activeP, err := pRepo.Find(ctx, problemId)
+	if err != nil || activeP != nil {
+		return err
+	}
+	archivedP, err := pRepo.FindIncludingArchived(ctx, problemId)
+	if err != nil {
+		return err
+	}
+	if archivedP != nil && archivedP.AdminArchived {
+		return util.BadInputError(fmt.Errorf("problem %s was archived", problemId))
+	}
+	solRepo, err := newFamilyProblemsSolutionsRepository(ctx, kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return err
+	}
+	history, err := solRepo.StreamAllEntriesForKey(ctx, problemId).CollectFilterNil(ctx)
+	if err != nil {
+		return err
+	}
+	next := problemset.NextReviewDate(history)
+	if next == nil || next.After(forDate.Time) {
+		return util.BadInputError(fmt.Errorf("problem %s is not due for review on %s", problemId, forDate))
+	}
+	return nil
+}
+
+// GetNextProblem returns the problem the user should solve next in the problem set. Problems due for review come
+// first, starting with the most overdue one, then problems that were never solved. nil when there is nothing to solve
+func (m *Manager) GetNextProblem(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	forDate datekvs.Date,
+) (*shpankids.FamilyProblemDto, error) {
+	_, err := m.getActiveProblemSet(ctx, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	inProgress, err := m.findInProgressProblem(ctx, familyId, userId, problemSetId, forDate)
+	if err != nil {
+		return nil, err
+	}
+	if inProgress != nil {
+		return inProgress, nil
+	}
+	due, err := m.findDueReviewProblem(ctx, familyId, userId, problemSetId, forDate)
+	if err != nil {
+		return nil, err
+	}
+	if due != nil {
+		return due, nil
+	}
+
+	// New problems are presented by their order in the set
+	problems, err := m.ListProblemsForProblemSet(ctx, familyId, userId, problemSetId, false).CollectFilterNil(ctx)
+	if err != nil || len(problems) == 0 {
+		return nil, err
+	}
+	return &problems[0], nil
+}
+
+// findInProgressProblem returns a problem answered wrong on forDate that still has attempts left
+func (m *Manager) findInProgressProblem(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	forDate datekvs.Date,
+) (*shpankids.FamilyProblemDto, error) {
+	solRepo, err := newFamilyProblemsSolutionsRepository(ctx, m.kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	pRepo, err := newFamilyProblemsRepository(ctx, m.kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	return shpanstream.MapStreamWhileFilteringWithError(
+		solRepo.StreamAllForDate(ctx, forDate).
+			Filter(func(e *functional.Entry[string, problemset.DbProblemSolution]) bool {
+				return e.Value.InProgress
+			}),
+		func(ctx context.Context, e *functional.Entry[string, problemset.DbProblemSolution]) (*shpankids.FamilyProblemDto, error) {
+			// Problems deleted or archived by an admin while in progress are skipped
+			dbP, err := pRepo.FindIncludingArchived(ctx, e.Key)
+			if err != nil || dbP == nil || dbP.AdminArchived {
+				return nil, err
+			}
+			return mapFamilyProblemDbToDto(&functional.Entry[string, problemset.DbProblem]{Key: e.Key, Value: *dbP}), nil
+		},
+	).GetFirst(ctx)
+}
+
+func (m *Manager) findDueReviewProblem(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	forDate datekvs.Date,
+) (*shpankids.FamilyProblemDto, error) {
+	solRepo, err := newFamilyProblemsSolutionsRepository(ctx, m.kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	historyByProblemId := map[string][]datekvs.DatedRecord[problemset.DbProblemSolution]{}
+	err = solRepo.Stream(ctx).Consume(ctx, func(r *datekvs.DatedRecord[functional.Entry[string, problemset.DbProblemSolution]]) {
+		historyByProblemId[r.Value.Key] = append(
+			historyByProblemId[r.Value.Key],
+			datekvs.DatedRecord[problemset.DbProblemSolution]{Date: r.Date, Value: r.Value.Value},
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type dueProblem struct {
+		problemId string
+		dueDate   time.Time
+	}
+	var dueProblems []dueProblem
+	for problemId, history := range historyByProblemId {
+		next := problemset.NextReviewDate(history)
+		if next == nil || next.After(forDate.Time) {
+			continue
+		}
+		dueProblems = append(dueProblems, dueProblem{problemId: problemId, dueDate: next.Time})
+	}
+	slices.SortFunc(dueProblems, func(a, b dueProblem) int {
+		return cmp.Or(a.dueDate.Compare(b.dueDate), cmp.Compare(a.problemId, b.problemId))
+	})
+
+	pRepo, err := newFamilyProblemsRepository(ctx, m.kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skipping problems that were deleted or archived by an admin since they were solved
+	for _, due := range dueProblems {
+		dbP, err := pRepo.FindIncludingArchived(ctx, due.problemId)
+		if err != nil {
+			return nil, err
+		}
+		if dbP != nil && !dbP.AdminArchived {
+			return mapFamilyProblemDbToDto(&functional.Entry[string, problemset.DbProblem]{Key: due.problemId, Value: *dbP}), nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *Manager) GetProblem(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	problemId string,
+) (*shpankids.FamilyProblemDto, error) {
+	pRepo, err := newFamilyProblemsRepository(ctx, m.kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	// Find the problems in repo
+	dbP, err := pRepo.GetIncludingArchived(ctx, problemId)
+	if err != nil {
+		return nil, err
+	}
+	return mapFamilyProblemDbToDto(&functional.Entry[string, problemset.DbProblem]{Key: problemId, Value: dbP}), nil
+}
+
+func (m *Manager) ListFamilyTasks(ctx context.Context, familyId string) shpanstream.Stream[shpankids.FamilyTaskDto] {
+	repo, err := newFamilyTaskRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.FamilyTaskDto](err)
+	}
+	// Find the family tasks in repo
+	return shpanstream.MapStream(repo.Stream(ctx), mapFamilyTaskDbToDto)
+}
+
+func mapFamilyTaskDbToDto(e *functional.Entry[string, dbFamilyTask]) *shpankids.FamilyTaskDto {
+	return &shpankids.FamilyTaskDto{
+		TaskId:           e.Key,
+		Title:            e.Value.Title,
+		Description:      e.Value.Description,
+		MemberIds:        e.Value.MemberIds,
+		Status:           e.Value.Status,
+		StatusDate:       e.Value.StatusDate,
+		Created:          e.Value.Created,
+		Points:           e.Value.Points,
+		Schedule:         mapTaskScheduleDbToDto(e.Value.Schedule),
+		RequiresApproval: e.Value.RequiresApproval,
+		History: functional.MapSliceNoErr(e.Value.History, func(r dbFamilyTaskRevision) shpankids.FamilyTaskRevisionDto {
+			return shpankids.FamilyTaskRevisionDto{
+				Title:       r.Title,
+				Description: r.Description,
+				MemberIds:   r.MemberIds,
+				Schedule:    mapTaskScheduleDbToDto(r.Schedule),
+				ValidUntil:  r.ValidUntil,
+			}
+		}),
+	}
+}
+
+func mapTaskScheduleDbToDto(s *dbTaskSchedule) *shpankids.TaskScheduleDto {
+	if s == nil {
+		return nil
+	}
+	return &shpankids.TaskScheduleDto{
+		WeekDays:      s.WeekDays,
+		EveryNDays:    s.EveryNDays,
+		StartDate:     s.StartDate,
+		EndDate:       s.EndDate,
+		ExcludedDates: s.ExcludedDates,
+	}
+}
+
+func mapTaskScheduleDtoToDb(s *shpankids.TaskScheduleDto) *dbTaskSchedule {
+	if s == nil {
+		return nil
+	}
+	return &dbTaskSchedule{
+		WeekDays:      s.WeekDays,
+		EveryNDays:    s.EveryNDays,
+		StartDate:     s.StartDate,
+		EndDate:       s.EndDate,
+		ExcludedDates: s.ExcludedDates,
+	}
+}
+
+func validateTaskSchedule(s *shpankids.TaskScheduleDto) error {
+	if s == nil {
+		return nil
+	}
+	if s.EveryNDays < 0 {
+		return util.BadInputError(fmt.Errorf("every n days must not be negative"))
+	}
+	for _, wd := range s.WeekDays {
+		if wd < time.Sunday || wd > time.Saturday {
+			return util.BadInputError(fmt.Errorf("invalid week day %d", wd))
+		}
+	}
+	if s.StartDate != nil && s.EndDate != nil && s.EndDate.Before(s.StartDate.Time) {
+		return util.BadInputError(fmt.Errorf("schedule end date %s is before start date %s", s.EndDate, s.StartDate))
+	}
+	return nil
+}
+
+func (m *Manager) CreateFamily(
+	ctx context.Context,
+	familyId string,
+	familyName string,
+	memberUserIds []string,
+	adminUserIds []string,
+) error {
+	// Get the user email from the context
+	loggedInUserEmail, err := m.userSessionManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	famMembersByUserId := map[string]dbFamilyMember{}
+	famMembersByUserId[*loggedInUserEmail] = dbFamilyMember{
+		UserId: *loggedInUserEmail,
+		Role:   shpankids.RoleAdmin,
+	}
+	for _, memberId := range memberUserIds {
+		famMembersByUserId[memberId] = dbFamilyMember{
+			UserId: memberId,
+			Role:   shpankids.RoleMember,
+		}
+	}
+	for _, adminId := range adminUserIds {
+		famMembersByUserId[adminId] = dbFamilyMember{
+			UserId: adminId,
+			Role:   shpankids.RoleAdmin,
+		}
+	}
+
+	dbFam := dbFamily{
+		Id:        familyId,
+		Name:      familyName,
+		CreatedBy: *loggedInUserEmail,
+		CreatedAt: time.Now(),
+		Members:   functional.MapValues(famMembersByUserId),
+	}
+
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		err := newFamilyRepository(tx).Set(ctx, familyId, dbFam)
+		if err != nil {
+			return err
+		}
+		for _, member := range dbFam.Members {
+			err = setUserFamilyMembership(ctx, tx, member.UserId, familyId, true)
+			if err != nil {
+				return err
+			}
+		}
+		return m.recordAudit(ctx, tx, familyId, shpankids.AuditActionFamilyCreate, familyId, nil, dbFam)
+	})
+}
+
+func (m *Manager) AddFamilyMember(ctx context.Context, familyId string, userId string, role shpankids.Role) error {
+	if userId == "" {
+		return util.BadInputError(fmt.Errorf("user id is required"))
+	}
+	err := validateRole(role)
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		dbFam, err := m.getFamilyAsAdminFrom(ctx, tx, familyId, "add family members")
+		if err != nil {
+			return err
+		}
+		if slices.ContainsFunc(dbFam.Members, func(member dbFamilyMember) bool {
+			return member.UserId == userId
+		}) {
+			return util.DuplicateInputError(fmt.Errorf("user %s is already a member of family %s", userId, dbFam.Name))
+		}
+
+		member := dbFamilyMember{
+			UserId: userId,
+			Role:   role,
+		}
+		dbFam.Members = append(dbFam.Members, member)
+		err = newFamilyRepository(tx).Set(ctx, familyId, dbFam)
+		if err != nil {
+			return err
+		}
+		err = setUserFamilyMembership(ctx, tx, userId, familyId, true)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(ctx, tx, familyId, shpankids.AuditActionMemberAdd, userId, nil, member)
+	})
+}
+
+func (m *Manager) UpdateFamilyMemberRole(ctx context.Context, familyId string, userId string, role shpankids.Role) error {
+	err := validateRole(role)
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		dbFam, err := m.getFamilyAsAdminFrom(ctx, tx, familyId, "change family member roles")
+		if err != nil {
+			return err
+		}
+		idx, err := findFamilyMemberIdx(&dbFam, userId)
+		if err != nil {
+			return err
+		}
+		if dbFam.Members[idx].Role == role {
+			return nil
+		}
+		if role != shpankids.RoleAdmin {
+			err = validateNotLastAdmin(&dbFam, userId)
+			if err != nil {
+				return err
+			}
+		}
+		before := dbFam.Members[idx]
+		dbFam.Members[idx].Role = role
+		err = newFamilyRepository(tx).Set(ctx, familyId, dbFam)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(
+			ctx,
+			tx,
+			familyId,
+			shpankids.AuditActionMemberUpdateRole,
+			userId,
+			before,
+			dbFam.Members[idx],
+		)
+	})
+}
+
+// RemoveFamilyMember removes the user from the family, unassigns the user from all family tasks and problem set
+// templates and archives (soft deletes) the problem sets kept for the user in the family
+func (m *Manager) RemoveFamilyMember(ctx context.Context, familyId string, userId string) error {
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		dbFam, err := m.getFamilyAsAdminFrom(ctx, tx, familyId, "remove family members")
+		if err != nil {
+			return err
+		}
+		idx, err := findFamilyMemberIdx(&dbFam, userId)
+		if err != nil {
+			return err
+		}
+		err = validateNotLastAdmin(&dbFam, userId)
+		if err != nil {
+			return err
+		}
+		removedMember := dbFam.Members[idx]
+		dbFam.Members = slices.Delete(dbFam.Members, idx, idx+1)
+
+		err = newFamilyRepository(tx).Set(ctx, familyId, dbFam)
+		if err != nil {
+			return err
+		}
+		err = setUserFamilyMembership(ctx, tx, userId, familyId, false)
+		if err != nil {
+			return err
+		}
+		err = m.recordAudit(ctx, tx, familyId, shpankids.AuditActionMemberRemove, userId, removedMember, nil)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		taskRepo, err := newFamilyTaskRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		tasks, err := taskRepo.List(ctx)
+		if err != nil {
+			return err
+		}
+		for taskId, ft := range tasks {
+			if ft.Status != shpankids.FamilyAssignmentStatusActive || !slices.Contains(ft.MemberIds, userId) {
+				continue
+			}
+
+			// Keeping the revision, so the removed member past stats are still available
+			ft.History = append(ft.History, dbFamilyTaskRevision{
+				Title:       ft.Title,
+				Description: ft.Description,
+				MemberIds:   ft.MemberIds,
+				Schedule:    ft.Schedule,
+				ValidUntil:  now,
+			})
+			ft.MemberIds = functional.FilterSlice(ft.MemberIds, func(memberId string) bool {
+				return memberId != userId
+			})
+			if len(ft.MemberIds) == 0 {
+				ft.Status = shpankids.FamilyAssignmentStatusDeleted
+				ft.StatusDate = now
+			}
+			err = taskRepo.Set(ctx, taskId, ft)
+			if err != nil {
+				return err
+			}
+		}
+
+		psRepo, err := newProblemSetsRepository(ctx, tx, familyId, userId)
+		if err != nil {
+			return err
+		}
+		problemSets, err := psRepo.List(ctx)
+		if err != nil {
+			return err
+		}
+		for psId, ps := range problemSets {
+			if ps.Status != shpankids.FamilyAssignmentStatusActive {
+				continue
+			}
+			ps.Status = shpankids.FamilyAssignmentStatusDeleted
+			ps.StatusDate = now
+			err = psRepo.Set(ctx, psId, ps)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The member copies of templates were deleted above, only the template members are left to update
+		tRepo, err := newProblemSetTemplatesRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		templates, err := tRepo.List(ctx)
+		if err != nil {
+			return err
+		}
+		for templateId, t := range templates {
+			if !slices.Contains(t.MemberIds, userId) {
+				continue
+			}
+			t.MemberIds = functional.FilterSlice(t.MemberIds, func(memberId string) bool {
+				return memberId != userId
+			})
+			err = tRepo.Set(ctx, templateId, t)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
-func (m *Manager) CreateProblemsInSet(
+// getFamilyAsAdmin returns the family, making sure the logged-in user is an admin of it
+func (m *Manager) getFamilyAsAdmin(ctx context.Context, familyId string, action string) (dbFamily, error) {
+	return m.getFamilyAsAdminFrom(ctx, m.kvs, familyId, action)
+}
+
+// getFamilyAsAdminFrom reads the family from kvs, which should be the transaction store when the family is changed
+// in a transaction, so concurrent changes to the family are not lost
+func (m *Manager) getFamilyAsAdminFrom(
 	ctx context.Context,
+	kvs kvstore.RawJsonStore,
 	familyId string,
-	forUserId string,
-	problemSetId string,
-	familyProblem []shpankids.CreateProblemDto,
-) error {
-	// Get the user email from the context
+	action string,
+) (dbFamily, error) {
 	uId, err := m.userSessionManager(ctx)
 	if err != nil {
-		return err
+		return dbFamily{}, err
 	}
 
-	f, err := m.GetFamily(ctx, familyId)
+	dbFam, err := newFamilyRepository(kvs).Get(ctx, familyId)
 	if err != nil {
-		return err
+		return dbFamily{}, err
 	}
 
-	// Check if the user is and admin of the family
-	isAdmin := slices.ContainsFunc(f.Members, func(member shpankids.FamilyMemberDto) bool {
+	isAdmin := slices.ContainsFunc(dbFam.Members, func(member dbFamilyMember) bool {
 		return member.UserId == *uId && member.Role == shpankids.RoleAdmin
 	})
 	if !isAdmin {
-		return util.ForbiddenError(fmt.Errorf("only admin can create family tasks for family %s", f.Name))
+		return dbFamily{}, util.ForbiddenError(fmt.Errorf("only admin can %s for family %s", action, dbFam.Name))
 	}
-	// Check if all task members are part of the family
-	famMembersSet := functional.SliceToSetExtractKeyNoErr(f.Members, func(member shpankids.FamilyMemberDto) string {
-		return member.UserId
+	return dbFam, nil
+}
+
+func findFamilyMemberIdx(dbFam *dbFamily, userId string) (int, error) {
+	idx := slices.IndexFunc(dbFam.Members, func(member dbFamilyMember) bool {
+		return member.UserId == userId
+	})
+	if idx < 0 {
+		return -1, util.NotFoundError(fmt.Errorf("user %s is not a member of family %s", userId, dbFam.Name))
+	}
+	return idx, nil
+}
+
+// validateNotLastAdmin makes sure the family is left with at least one admin when userId stops being one
+func validateNotLastAdmin(dbFam *dbFamily, userId string) error {
+	otherAdminExists := slices.ContainsFunc(dbFam.Members, func(member dbFamilyMember) bool {
+		return member.UserId != userId && member.Role == shpankids.RoleAdmin
 	})
+	if !otherAdminExists {
+		return util.BadInputError(fmt.Errorf("family %s must have at least one admin", dbFam.Name))
+	}
+	return nil
+}
 
-	if _, ok := famMembersSet[forUserId]; !ok {
-		return util.BadInputError(fmt.Errorf("user %s is not part of the family %s", forUserId, f.Name))
+func validateRole(role shpankids.Role) error {
+	switch role {
+	case shpankids.RoleAdmin, shpankids.RoleMember:
+		return nil
+	default:
+		return util.BadInputError(fmt.Errorf("invalid role %s", role))
+	}
+}
+
+func (m *Manager) InviteFamilyMember(ctx context.Context, familyId string, invitation shpankids.FamilyInvitationDto) error {
+	invitation.Email = normalizeInvitationEmail(invitation.Email)
+	if invitation.Email == "" {
+		return util.BadInputError(fmt.Errorf("email is required"))
+	}
+	err := validateRole(invitation.Role)
+	if err != nil {
+		return err
 	}
 
-	repo, err := newFamilyProblemsRepository(ctx, m.kvs, familyId, forUserId, problemSetId)
+	// Get the user email from the context
+	uId, err := m.userSessionManager(ctx)
 	if err != nil {
 		return err
 	}
-	createdTime := time.Now()
-	for _, p := range familyProblem {
-		if p.Title == "" {
-			return util.BadInputError(fmt.Errorf("title is required"))
-		}
 
-		if functional.CountSliceNoErr(p.Answers, func(a shpankids.CreateProblemAnswerDto) bool {
-			return a.Correct
-		}) != 1 {
-			return util.BadInputError(fmt.Errorf("one and only one correct answer is required for problem %s", p.Title))
-		}
+	dbFam, err := m.getFamilyAsAdmin(ctx, familyId, "invite family members")
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(dbFam.Members, func(member dbFamilyMember) bool {
+		return normalizeInvitationEmail(member.UserId) == invitation.Email
+	}) {
+		return util.DuplicateInputError(fmt.Errorf("user %s is already a member of family %s", invitation.Email, dbFam.Name))
+	}
 
-		for _, a := range p.Answers {
-			if a.Title == "" {
-				return util.BadInputError(fmt.Errorf("alternative title is required"))
-			}
-		}
+	dbInv := dbFamilyInvitation{
+		FamilyId:  familyId,
+		Email:     invitation.Email,
+		Role:      invitation.Role,
+		FirstName: invitation.FirstName,
+		LastName:  invitation.LastName,
+		InvitedBy: *uId,
+		Created:   time.Now(),
+	}
 
-		dbAnswers := make(map[string]problemset.DbProblemAnswer, len(p.Answers))
-		for idx, a := range p.Answers {
-			dbAnswers[fmt.Sprintf("%d", idx)] = problemset.DbProblemAnswer{
-				Title:       a.Title,
-				Description: a.Description,
-				Correct:     a.Correct,
-			}
+	// Invitation is kept both on the family and on the invited email, so it can be found on login
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		famInvRepo, err := newFamilyInvitationsRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
 		}
-
-		// Create the family task in repo
-		err = repo.Set(ctx, uuid.NewString(), problemset.DbProblem{
-			Title:       p.Title,
-			Description: p.Description,
-			Created:     createdTime,
-			Hints:       p.Hints,
-			Explanation: p.Explanation,
-			Answers:     dbAnswers,
-		})
+		err = famInvRepo.Set(ctx, invitation.Email, dbInv)
 		if err != nil {
 			return err
 		}
-	}
-	return nil
+		userInvRepo, err := newUserInvitationsRepository(ctx, tx, invitation.Email)
+		if err != nil {
+			return err
+		}
+		err = userInvRepo.Set(ctx, familyId, dbInv)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(ctx, tx, familyId, shpankids.AuditActionInvitationCreate, invitation.Email, nil, dbInv)
+	})
 }
 
-func (m *Manager) SubmitProblemAnswer(
-	ctx context.Context,
-	familyId string,
-	userId string,
-	problemSetId string,
-	problemId string,
-	forDate datekvs.Date,
-	answerId string,
-) (bool, string, *shpankids.FamilyProblemDto, error) {
-
-	pRepo, err := newFamilyProblemsRepository(ctx, m.kvs, familyId, userId, problemSetId)
+func (m *Manager) RevokeFamilyInvitation(ctx context.Context, familyId string, email string) error {
+	email = normalizeInvitationEmail(email)
+	dbFam, err := m.getFamilyAsAdmin(ctx, familyId, "revoke family invitations")
 	if err != nil {
-		return false, "", nil, err
+		return err
 	}
-	// Find the problems in repo
-	dbP, err := pRepo.Get(ctx, problemId)
+	famInvRepo, err := newFamilyInvitationsRepository(ctx, m.kvs, familyId)
 	if err != nil {
-		return false, "", nil, err
-	}
-
-	correctAnswerId := functional.FindKeyInMap(dbP.Answers, func(a *problemset.DbProblemAnswer) bool {
-		return a.Correct
-	})
-	if correctAnswerId == nil {
-		return false, "", nil, fmt.Errorf("no correct answer found for problem %s", problemId)
+		return err
 	}
-
-	solRepo, err := newFamilyProblemsSolutionsRepository(ctx, m.kvs, familyId, userId, problemSetId)
+	inv, err := famInvRepo.Find(ctx, email)
 	if err != nil {
-		return false, "", nil, err
+		return err
 	}
-	dbPs := problemset.DbProblemSolution{
-		SelectedAnswerId: answerId,
-		Correct:          answerId == *correctAnswerId,
+	if inv == nil {
+		return util.NotFoundError(fmt.Errorf("no pending invitation for %s to family %s", email, dbFam.Name))
 	}
-	err = solRepo.Set(ctx, forDate, problemId, dbPs)
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		err := unsetInvitation(ctx, tx, familyId, email)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(ctx, tx, familyId, shpankids.AuditActionInvitationRevoke, email, inv, nil)
+	})
+}
+
+func (m *Manager) ListFamilyInvitations(ctx context.Context, familyId string) shpanstream.Stream[shpankids.FamilyInvitationDto] {
+	_, err := m.getFamilyAsAdmin(ctx, familyId, "list family invitations")
 	if err != nil {
-		return false, "", nil, err
+		return shpanstream.NewErrorStream[shpankids.FamilyInvitationDto](err)
 	}
-	// todo:amit:tx?
-	err = pRepo.Archive(ctx, problemId)
+	famInvRepo, err := newFamilyInvitationsRepository(ctx, m.kvs, familyId)
 	if err != nil {
-		return false, "", nil, err
+		return shpanstream.NewErrorStream[shpankids.FamilyInvitationDto](err)
 	}
-
-	return dbPs.Correct, *correctAnswerId, mapFamilyProblemDbToDto(
-		&functional.Entry[string, problemset.DbProblem]{Key: problemId, Value: dbP},
-	), nil
-
+	return shpanstream.MapStream(famInvRepo.Stream(ctx), mapFamilyInvitationDbToDto)
 }
 
-func (m *Manager) GetProblem(
-	ctx context.Context,
-	familyId string,
-	userId string,
-	problemSetId string,
-	problemId string,
-) (*shpankids.FamilyProblemDto, error) {
-	pRepo, err := newFamilyProblemsRepository(ctx, m.kvs, familyId, userId, problemSetId)
-	if err != nil {
-		return nil, err
-	}
-	// Find the problems in repo
-	dbP, err := pRepo.GetIncludingArchived(ctx, problemId)
+func (m *Manager) ListUserInvitations(ctx context.Context, email string) shpanstream.Stream[shpankids.FamilyInvitationDto] {
+	userInvRepo, err := newUserInvitationsRepository(ctx, m.kvs, normalizeInvitationEmail(email))
 	if err != nil {
-		return nil, err
+		return shpanstream.NewErrorStream[shpankids.FamilyInvitationDto](err)
 	}
-	return mapFamilyProblemDbToDto(&functional.Entry[string, problemset.DbProblem]{Key: problemId, Value: dbP}), nil
+	return shpanstream.MapStream(userInvRepo.Stream(ctx), mapFamilyInvitationDbToDto)
 }
 
-func (m *Manager) ListFamilyTasks(ctx context.Context, familyId string) shpanstream.Stream[shpankids.FamilyTaskDto] {
-	repo, err := newFamilyTaskRepository(ctx, m.kvs, familyId)
+// AcceptFamilyInvitation adds the logged-in user to the family using the role from the pending invitation
+func (m *Manager) AcceptFamilyInvitation(ctx context.Context, familyId string) error {
+	// Get the user email from the context
+	uId, err := m.userSessionManager(ctx)
 	if err != nil {
-		return shpanstream.NewErrorStream[shpankids.FamilyTaskDto](err)
+		return err
 	}
-	// Find the family tasks in repo
-	return shpanstream.MapStream(repo.Stream(ctx), mapFamilyTaskDbToDto)
+
+	email := normalizeInvitationEmail(*uId)
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		userInvRepo, err := newUserInvitationsRepository(ctx, tx, email)
+		if err != nil {
+			return err
+		}
+		inv, err := userInvRepo.Find(ctx, familyId)
+		if err != nil {
+			return err
+		}
+		if inv == nil {
+			return util.NotFoundError(fmt.Errorf("no pending invitation for %s to family %s", *uId, familyId))
+		}
+
+		famRepo := newFamilyRepository(tx)
+		dbFam, err := famRepo.Get(ctx, familyId)
+		if err != nil {
+			return err
+		}
+		var member *dbFamilyMember
+		if !slices.ContainsFunc(dbFam.Members, func(member dbFamilyMember) bool {
+			return member.UserId == *uId
+		}) {
+			member = &dbFamilyMember{
+				UserId: *uId,
+				Role:   inv.Role,
+			}
+			dbFam.Members = append(dbFam.Members, *member)
+			err = famRepo.Set(ctx, familyId, dbFam)
+			if err != nil {
+				return err
+			}
+			err = setUserFamilyMembership(ctx, tx, *uId, familyId, true)
+			if err != nil {
+				return err
+			}
+		}
+		err = unsetInvitation(ctx, tx, familyId, email)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(ctx, tx, familyId, shpankids.AuditActionInvitationAccept, *uId, inv, member)
+	})
 }
 
-func mapFamilyTaskDbToDto(e *functional.Entry[string, dbFamilyTask]) *shpankids.FamilyTaskDto {
-	return &shpankids.FamilyTaskDto{
-		TaskId:      e.Key,
-		Title:       e.Value.Title,
-		Description: e.Value.Description,
-		MemberIds:   e.Value.MemberIds,
-		Status:      e.Value.Status,
-		StatusDate:  e.Value.StatusDate,
-		Created:     e.Value.Created,
-	}
+// normalizeInvitationEmail keys the invitations by the trimmed lower-cased email, so the invitation is found on login
+// regardless of how the admin typed the email
+func normalizeInvitationEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
 }
 
-func (m *Manager) CreateFamily(
-	ctx context.Context,
-	familyId string,
-	familyName string,
-	memberUserIds []string,
-	adminUserIds []string,
-) error {
-	// Get the user email from the context
-	loggedInUserEmail, err := m.userSessionManager(ctx)
+func unsetInvitation(ctx context.Context, kvs kvstore.RawJsonStore, familyId string, email string) error {
+	famInvRepo, err := newFamilyInvitationsRepository(ctx, kvs, familyId)
 	if err != nil {
 		return err
 	}
-
-	famMembersByUserId := map[string]dbFamilyMember{}
-	famMembersByUserId[*loggedInUserEmail] = dbFamilyMember{
-		UserId: *loggedInUserEmail,
-		Role:   shpankids.RoleAdmin,
-	}
-	for _, memberId := range memberUserIds {
-		famMembersByUserId[memberId] = dbFamilyMember{
-			UserId: memberId,
-			Role:   shpankids.RoleMember,
-		}
+	err = famInvRepo.Unset(ctx, email)
+	if err != nil {
+		return err
 	}
-	for _, adminId := range adminUserIds {
-		famMembersByUserId[adminId] = dbFamilyMember{
-			UserId: adminId,
-			Role:   shpankids.RoleAdmin,
-		}
+	userInvRepo, err := newUserInvitationsRepository(ctx, kvs, email)
+	if err != nil {
+		return err
 	}
+	return userInvRepo.Unset(ctx, familyId)
+}
 
-	// Create the family in repo
-	return m.familyRepository.Set(
-		ctx,
-		familyId,
-		dbFamily{
-			Id:        familyId,
-			Name:      familyName,
-			CreatedBy: *loggedInUserEmail,
-			CreatedAt: time.Now(),
-			Members:   functional.MapValues(famMembersByUserId),
-		},
-	)
+func mapFamilyInvitationDbToDto(e *functional.Entry[string, dbFamilyInvitation]) *shpankids.FamilyInvitationDto {
+	return &shpankids.FamilyInvitationDto{
+		FamilyId:  e.Value.FamilyId,
+		Email:     e.Value.Email,
+		Role:      e.Value.Role,
+		FirstName: e.Value.FirstName,
+		LastName:  e.Value.LastName,
+		InvitedBy: e.Value.InvitedBy,
+		Created:   e.Value.Created,
+	}
 }
 
 func (m *Manager) DeleteFamilyTask(ctx context.Context, familyId string, familyTaskId string) error {
@@ -393,9 +1477,14 @@ func (m *Manager) DeleteFamilyTask(ctx context.Context, familyId string, familyT
 	if err != nil {
 		return err
 	}
+	before := ft
 	ft.Status = shpankids.FamilyAssignmentStatusDeleted
 	ft.StatusDate = time.Now()
-	return repo.Set(ctx, familyTaskId, ft)
+	err = repo.Set(ctx, familyTaskId, ft)
+	if err != nil {
+		return err
+	}
+	return m.recordAudit(ctx, m.kvs, familyId, shpankids.AuditActionTaskDelete, familyTaskId, before, ft)
 }
 
 func (m *Manager) ListProblemSetsForUser(
@@ -425,31 +1514,92 @@ func (m *Manager) ListProblemsForProblemSet(
 		return shpanstream.NewErrorStream[shpankids.FamilyProblemDto](err)
 	}
 
-	var s shpanstream.Stream[functional.Entry[string, problemset.DbProblem]]
 	// Find the problems in repo
+	s := shpanstream.MapStream(repo.Stream(ctx), mapFamilyProblemDbToDto)
 	if includeArchived {
-		s = repo.StreamIncludingArchived(ctx)
-
-	} else {
-		s = repo.Stream(ctx)
+		s = shpanstream.ConcatenatedStream(
+			s,
+			shpanstream.MapStream(repo.StreamArchived(ctx), func(e *functional.Entry[string, problemset.DbProblem]) *shpankids.FamilyProblemDto {
+				ret := mapFamilyProblemDbToDto(e)
+				ret.Archived = true
+				return ret
+			}),
+		)
 	}
-	return shpanstream.MapStream(s, mapFamilyProblemDbToDto)
 
+	return sortedByProblemOrder(ctx, s)
+}
+
+// sortedByProblemOrder presents the problems by their order in the set
+func sortedByProblemOrder(
+	ctx context.Context,
+	s shpanstream.Stream[shpankids.FamilyProblemDto],
+) shpanstream.Stream[shpankids.FamilyProblemDto] {
+	problems, err := s.CollectFilterNil(ctx)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.FamilyProblemDto](err)
+	}
+	slices.SortFunc(problems, func(a, b shpankids.FamilyProblemDto) int {
+		return cmp.Or(
+			cmp.Compare(a.Order, b.Order),
+			a.Created.Compare(b.Created),
+			cmp.Compare(a.ProblemId, b.ProblemId),
+		)
+	})
+	return shpanstream.Just(problems...)
 }
 
 func mapFamilyProblemDbToDto(e *functional.Entry[string, problemset.DbProblem]) *shpankids.FamilyProblemDto {
-	return &shpankids.FamilyProblemDto{
-		ProblemId:   e.Key,
-		Title:       e.Value.Title,
-		Description: e.Value.Description,
-		Created:     e.Value.Created,
-		Hints:       e.Value.Hints,
-		Explanation: e.Value.Explanation,
-		Answers:     functional.MapToSliceNoErr(e.Value.Answers, mapFamilyProblemAlternativeDbToDto),
+	ret := &shpankids.FamilyProblemDto{
+		ProblemId:       e.Key,
+		Type:            problemTypeOrDefault(e.Value.Type),
+		Title:           e.Value.Title,
+		Description:     e.Value.Description,
+		Created:         e.Value.Created,
+		Hints:           e.Value.Hints,
+		Explanation:     e.Value.Explanation,
+		Answers:         functional.MapToSliceNoErr(e.Value.Answers, mapFamilyProblemAlternativeDbToDto),
+		AcceptedAnswers: e.Value.AcceptedAnswers,
+		Order:           e.Value.Order,
+	}
+	if e.Value.NumericAnswer != nil {
+		ret.NumericAnswer = &shpankids.NumericAnswerDto{
+			Value:     e.Value.NumericAnswer.Value,
+			Tolerance: e.Value.NumericAnswer.Tolerance,
+		}
+	}
+	if ret.Type == shpankids.ProblemTypeOrdering {
+		// Ordering problem items are kept in the correct order
+		slices.SortFunc(ret.Answers, func(a, b shpankids.ProblemAnswerDto) int {
+			return e.Value.Answers[a.Id].Order - e.Value.Answers[b.Id].Order
+		})
+	}
+	return ret
+}
+
+func toDbNumericAnswer(a *shpankids.NumericAnswerDto) *problemset.DbNumericAnswer {
+	if a == nil {
+		return nil
+	}
+	return &problemset.DbNumericAnswer{
+		Value:     a.Value,
+		Tolerance: a.Tolerance,
 	}
 }
 
 func mapFamilyProblemSetDbToDto(e *functional.Entry[string, problemset.DbProblemSet]) *shpankids.FamilyProblemSetDto {
+	dailyQuota := e.Value.DailyQuota
+	if dailyQuota == 0 {
+		dailyQuota = shpankids.DefaultProblemSetDailyQuota
+	}
+	quotaType := e.Value.QuotaType
+	if quotaType == "" {
+		quotaType = shpankids.ProblemSetQuotaTypeProblems
+	}
+	maxAttempts := e.Value.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = shpankids.DefaultProblemSetMaxAttempts
+	}
 	return &shpankids.FamilyProblemSetDto{
 		ProblemSetId: e.Key,
 		Title:        e.Value.Title,
@@ -457,6 +1607,11 @@ func mapFamilyProblemSetDbToDto(e *functional.Entry[string, problemset.DbProblem
 		Created:      e.Value.Created,
 		Status:       e.Value.Status,
 		StatusDate:   e.Value.StatusDate,
+		Points:       e.Value.Points,
+		DailyQuota:   dailyQuota,
+		QuotaType:    quotaType,
+		MaxAttempts:  maxAttempts,
+		TemplateId:   e.Value.TemplateId,
 	}
 }
 
@@ -478,6 +1633,23 @@ func (m *Manager) getProblemSet(
 
 }
 
+// getActiveProblemSet returns the problem set, failing when it was archived or deleted so it can't be solved
+func (m *Manager) getActiveProblemSet(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+) (*shpankids.FamilyProblemSetDto, error) {
+	ps, err := m.getProblemSet(ctx, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	if ps.Status != shpankids.FamilyAssignmentStatusActive {
+		return nil, util.BadInputError(fmt.Errorf("problem set %s is %s and can't be solved", ps.Title, ps.Status))
+	}
+	return ps, nil
+}
+
 func (m *Manager) RefineProblems(
 	ctx context.Context,
 	familyId string,
@@ -486,16 +1658,35 @@ func (m *Manager) RefineProblems(
 	origProblems shpanstream.Stream[openapi.ApiProblemForEdit],
 	refineInstructions string,
 ) shpanstream.Stream[openapi.ApiProblemForEdit] {
+	provider, err := m.meteredAiProvider(ctx, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
+	}
 	ps, err := m.getProblemSet(ctx, familyId, userId, problemSetId)
 	if err != nil {
 		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
 	}
-	return ai.RefineProblems(
-		ctx,
-		userId,
+	existing, err := m.ListProblemsForProblemSet(ctx, familyId, userId, problemSetId, true).CollectFilterNil(ctx)
+	if err != nil {
+		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
+	}
+	learner, err := m.learnerProfile(ctx, familyId, userId, problemSetId)
+	if err != nil {
+		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
+	}
+	return m.validatedGeneratedProblems(
+		provider,
+		*learner,
 		*ps,
-		origProblems,
-		refineInstructions,
+		existing,
+		ai.RefineProblems(
+			ctx,
+			provider,
+			*learner,
+			*ps,
+			origProblems,
+			refineInstructions,
+		),
 	)
 
 }
@@ -507,22 +1698,82 @@ func (m *Manager) GenerateNewProblems(
 	problemSetId string,
 	additionalRequestText string,
 ) shpanstream.Stream[openapi.ApiProblemForEdit] {
+	provider, err := m.meteredAiProvider(ctx, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
+	}
 	ps, err := m.getProblemSet(ctx, familyId, userId, problemSetId)
 	if err != nil {
 		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
 	}
+	existing, err := m.ListProblemsForProblemSet(ctx, familyId, userId, problemSetId, true).CollectFilterNil(ctx)
+	if err != nil {
+		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
+	}
+	learner, err := m.learnerProfile(ctx, familyId, userId, problemSetId)
+	if err != nil {
+		return shpanstream.NewErrorStream[openapi.ApiProblemForEdit](err)
+	}
 
-	return ai.GenerateProblems(
-		ctx,
-		userId,
+	return m.validatedGeneratedProblems(
+		provider,
+		*learner,
 		*ps,
-		shpanstream.MapStream(
-			m.ListProblemsForProblemSet(ctx, familyId, userId, problemSetId, true),
-			api.ToApiProblemForEdit,
+		existing,
+		ai.GenerateProblems(
+			ctx,
+			provider,
+			*learner,
+			*ps,
+			shpanstream.MapStream(shpanstream.Just(existing...), api.ToApiProblemForEdit),
+			additionalRequestText,
 		),
-		additionalRequestText,
 	)
 }
+
+// meteredAiProvider returns the provider counting its calls against the daily quotas of the logged-in user and the
+// family, failing before any AI related work starts when either of them already reached its quota
+func (m *Manager) meteredAiProvider(ctx context.Context, familyId string) (ai.Provider, error) {
+	callerId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f, err := m.GetFamily(ctx, familyId)
+	if err != nil {
+		return nil, err
+	}
+	limits := aiusage.DailyLimits{
+		FamilyCalls: f.AiFamilyDailyCallsLimit,
+		UserCalls:   f.AiUserDailyCallsLimit,
+	}
+	err = aiusage.CheckQuota(ctx, m.kvs, familyId, *callerId, limits)
+	if err != nil {
+		return nil, err
+	}
+	return aiusage.NewMeteredProvider(m.aiProvider, m.kvs, familyId, *callerId, limits), nil
+}
+
+// validatedGeneratedProblems repairs or rejects the generated problems, asking the model to replace rejected ones
+func (m *Manager) validatedGeneratedProblems(
+	provider ai.Provider,
+	learner ai.LearnerProfile,
+	ps shpankids.FamilyProblemSetDto,
+	existing []shpankids.FamilyProblemDto,
+	generated shpanstream.Stream[openapi.ApiProblemForEdit],
+) shpanstream.Stream[openapi.ApiProblemForEdit] {
+	return newGeneratedProblemsValidator(existing).validatedStream(
+		generated,
+		maxReplacementRounds,
+		func(
+			ctx context.Context,
+			rejected []ai.RejectedProblem,
+			existingTitles []string,
+		) shpanstream.Stream[openapi.ApiProblemForEdit] {
+			return ai.GenerateReplacementProblems(ctx, provider, learner, ps, rejected, existingTitles)
+		},
+	)
+}
+
 func mapFamilyProblemAlternativeDbToDto(problemId string, a problemset.DbProblemAnswer) shpankids.ProblemAnswerDto {
 	return shpankids.ProblemAnswerDto{
 		Id:          problemId,
@@ -579,19 +1830,33 @@ func (m *Manager) ListUserProblemsSolutions(
 			}
 		})
 
-	return shpanstream.MapStream(
+	return shpanstream.MapStreamWhileFiltering(
 		sr.Stream(ctx),
 		func(
 			e *datekvs.DatedRecord[functional.Entry[string, problemset.DbProblemSolution]],
 		) *openapi.ApiUserProblemSolution {
-			return &openapi.ApiUserProblemSolution{
+			// Solutions of deleted problems are not listed
+			if _, ok := problemMap[e.Value.Key]; !ok {
+				return nil
+			}
+			ret := &openapi.ApiUserProblemSolution{
 				ProblemId:            e.Value.Key,
 				CorrectAnswerId:      problemMap[e.Value.Key].CorrectAnswerId,
 				ProblemTitle:         problemMap[e.Value.Key].Title,
 				SolvedDate:           e.Date.Time,
 				UserProvidedAnswerId: e.Value.Value.SelectedAnswerId,
 				Correct:              e.Value.Value.Correct,
+				HintsUsed:            e.Value.Value.HintsUsed,
+				AttemptsCount:        max(len(e.Value.Value.Attempts), 1),
+				InProgress:           e.Value.Value.InProgress,
+			}
+			if len(e.Value.Value.SelectedAnswerIds) > 0 {
+				ret.UserProvidedAnswerIds = &e.Value.Value.SelectedAnswerIds
+			}
+			if e.Value.Value.Value != "" {
+				ret.UserProvidedValue = &e.Value.Value.Value
 			}
+			return ret
 		},
 	)
 
@@ -612,9 +1877,14 @@ func (m *Manager) ListProblemSetSolutionsForDate(
 		sr.StreamAllForDate(ctx, forDate),
 		func(e *functional.Entry[string, problemset.DbProblemSolution]) *shpankids.ProblemSolutionDto {
 			return &shpankids.ProblemSolutionDto{
-				ProblemId:        e.Key,
-				SelectedAnswerId: e.Value.SelectedAnswerId,
-				Correct:          e.Value.Correct,
+				ProblemId:         e.Key,
+				SelectedAnswerId:  e.Value.SelectedAnswerId,
+				SelectedAnswerIds: e.Value.SelectedAnswerIds,
+				Value:             e.Value.Value,
+				Correct:           e.Value.Correct,
+				HintsUsed:         e.Value.HintsUsed,
+				AttemptsCount:     max(len(e.Value.Attempts), 1),
+				InProgress:        e.Value.InProgress,
 			}
 		},
 	)
@@ -11,6 +11,8 @@ const problemSetsRepoUri = "problemSets"
 type familyProblemsRepository problemset.ProblemsRepository
 type familyProblemSetsRepository problemset.ProblemSetsRepository
 type familyProblemSolutionsRepository problemset.ProblemSolutionsRepository
+type familyProblemHintRevealsRepository problemset.ProblemHintRevealsRepository
+type familyProblemSetTemplatesRepository problemset.ProblemSetTemplatesRepository
 
 func newFamilyProblemsSolutionsRepository(
 	ctx context.Context,
@@ -27,6 +29,21 @@ func newFamilyProblemsSolutionsRepository(
 	return problemset.NewProblemsSolutionsRepository(ctx, rootFamilyStore, problemSetId)
 }
 
+func newFamilyProblemHintRevealsRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	problemSetId string,
+) (familyProblemHintRevealsRepository, error) {
+	rootFamilyStore, err := createFamilyUserRootRepo(ctx, kvs, familyId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return problemset.NewProblemHintRevealsRepository(ctx, rootFamilyStore, problemSetId)
+}
+
 func newFamilyProblemsRepository(
 	ctx context.Context,
 	kvs kvstore.RawJsonStore,
@@ -71,3 +88,41 @@ func createFamilyUserRootRepo(
 	})
 	return familyUserRootRepo, err
 }
+
+func newProblemSetTemplatesRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+) (familyProblemSetTemplatesRepository, error) {
+	familyTemplatesRootRepo, err := createFamilyTemplatesRootRepo(ctx, kvs, familyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return problemset.NewProblemSetTemplatesRepository(familyTemplatesRootRepo)
+}
+
+func newTemplateProblemsRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	templateId string,
+) (familyProblemsRepository, error) {
+	familyTemplatesRootRepo, err := createFamilyTemplatesRootRepo(ctx, kvs, familyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return problemset.NewProblemSetProblemsRepository(ctx, familyTemplatesRootRepo, templateId)
+}
+
+func createFamilyTemplatesRootRepo(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+) (kvstore.RawJsonStore, error) {
+	return kvs.CreateSpaceStore(ctx, []string{
+		"problemSetTemplates",
+		familyId,
+	})
+}
@@ -1,13 +1,25 @@
 package family
 
 import (
+	"context"
 	"shpankids/infra/database/kvstore"
+	"shpankids/infra/shpanstream"
+	"shpankids/infra/util/functional"
 	"shpankids/shpankids"
+	"slices"
 	"time"
 )
 
 const familiesSpaceStoreUri = "families"
 
+const defaultTaskBackEditDays = 7
+
+// Default daily quotas of AI calls, protecting the shared AI provider key from runaway costs
+const (
+	defaultAiFamilyDailyCallsLimit = 50
+	defaultAiUserDailyCallsLimit   = 20
+)
+
 type repository kvstore.JsonKvStore[string, dbFamily]
 
 type dbFamilyMember struct {
@@ -21,6 +33,13 @@ type dbFamily struct {
 	CreatedBy string           `json:"createdBy"`
 	CreatedAt time.Time        `json:"createdAt"`
 	Members   []dbFamilyMember `json:"members"`
+
+	// TaskBackEditDays is nil until the family admin changes it, defaultTaskBackEditDays applies until then
+	TaskBackEditDays *int `json:"taskBackEditDays,omitempty"`
+
+	// The AI quotas are nil until the family admin changes them, the default quotas apply until then
+	AiFamilyDailyCallsLimit *int `json:"aiFamilyDailyCallsLimit,omitempty"`
+	AiUserDailyCallsLimit   *int `json:"aiUserDailyCallsLimit,omitempty"`
 }
 
 func newFamilyRepository(store kvstore.RawJsonStore) repository {
@@ -31,3 +50,66 @@ func newFamilyRepository(store kvstore.RawJsonStore) repository {
 		kvstore.StringToKey,
 	)
 }
+
+const userFamiliesSpaceStoreUri = "userFamilies"
+
+// dbUserFamilies indexes the families a user is a member of, so they can be listed without scanning all families
+type dbUserFamilies struct {
+	FamilyIds []string `json:"familyIds"`
+}
+
+type userFamiliesRepository kvstore.JsonKvStore[string, dbUserFamilies]
+
+func newUserFamiliesRepository(store kvstore.RawJsonStore) userFamiliesRepository {
+	return kvstore.NewJsonKvStoreImpl[string, dbUserFamilies](
+		store,
+		userFamiliesSpaceStoreUri,
+		kvstore.StringKeyToString,
+		kvstore.StringToKey,
+	)
+}
+
+// userFamilyIds returns the ids of the families of the user from the index. Users that were not indexed yet, since
+// their families were created before the index existed, are found by scanning all families, and are indexed on their
+// next membership change
+func userFamilyIds(ctx context.Context, kvs kvstore.RawJsonStore, userId string) ([]string, error) {
+	ufRepo := newUserFamiliesRepository(kvs)
+	indexed, err := ufRepo.Find(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if indexed != nil {
+		return indexed.FamilyIds, nil
+	}
+	return shpanstream.MapStreamWhileFiltering(
+		newFamilyRepository(kvs).Stream(ctx),
+		func(e *functional.Entry[string, dbFamily]) *string {
+			if !isDbFamilyMember(&e.Value, userId) {
+				return nil
+			}
+			return &e.Key
+		},
+	).CollectFilterNil(ctx)
+}
+
+// setUserFamilyMembership adds or removes the family from the families index of the user, kvs should be the
+// transaction store the membership is changed in
+func setUserFamilyMembership(ctx context.Context, kvs kvstore.RawJsonStore, userId string, familyId string, member bool) error {
+	familyIds, err := userFamilyIds(ctx, kvs, userId)
+	if err != nil {
+		return err
+	}
+	familyIds = slices.DeleteFunc(slices.Clone(familyIds), func(id string) bool {
+		return id == familyId
+	})
+	if member {
+		familyIds = append(familyIds, familyId)
+	}
+	return newUserFamiliesRepository(kvs).Set(ctx, userId, dbUserFamilies{FamilyIds: familyIds})
+}
+
+func isDbFamilyMember(dbFam *dbFamily, userId string) bool {
+	return slices.ContainsFunc(dbFam.Members, func(member dbFamilyMember) bool {
+		return member.UserId == userId
+	})
+}
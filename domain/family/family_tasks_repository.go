@@ -2,18 +2,40 @@ package family
 
 import (
 	"context"
+	"shpankids/infra/database/datekvs"
 	"shpankids/infra/database/kvstore"
 	"shpankids/shpankids"
 	"time"
 )
 
 type dbFamilyTask struct {
-	Title       string                           `json:"title"`
-	Description string                           `json:"description"`
-	MemberIds   []string                         `json:"memberIds"`
-	Created     time.Time                        `json:"created"`
-	Status      shpankids.FamilyAssignmentStatus `json:"status"`
-	StatusDate  time.Time                        `json:"statusDate"`
+	Title            string                           `json:"title"`
+	Description      string                           `json:"description"`
+	MemberIds        []string                         `json:"memberIds"`
+	Created          time.Time                        `json:"created"`
+	Status           shpankids.FamilyAssignmentStatus `json:"status"`
+	StatusDate       time.Time                        `json:"statusDate"`
+	Points           int                              `json:"points,omitempty"`
+	Schedule         *dbTaskSchedule                  `json:"schedule,omitempty"`
+	History          []dbFamilyTaskRevision           `json:"history,omitempty"`
+	RequiresApproval bool                             `json:"requiresApproval,omitempty"`
+}
+
+type dbTaskSchedule struct {
+	WeekDays      []time.Weekday `json:"weekDays,omitempty"`
+	EveryNDays    int            `json:"everyNDays,omitempty"`
+	StartDate     *datekvs.Date  `json:"startDate,omitempty"`
+	EndDate       *datekvs.Date  `json:"endDate,omitempty"`
+	ExcludedDates []datekvs.Date `json:"excludedDates,omitempty"`
+}
+
+// dbFamilyTaskRevision keeps a previous version of a task, so stats for past dates are calculated correctly
+type dbFamilyTaskRevision struct {
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	MemberIds   []string        `json:"memberIds"`
+	Schedule    *dbTaskSchedule `json:"schedule,omitempty"`
+	ValidUntil  time.Time       `json:"validUntil"`
 }
 
 type familyTaskRepository kvstore.JsonKvStore[string, dbFamilyTask]
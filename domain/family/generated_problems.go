@@ -0,0 +1,164 @@
+package family
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"shpankids/domain/ai"
+	"shpankids/infra/shpanstream"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/api"
+	"shpankids/openapi"
+	"shpankids/shpankids"
+	"strings"
+)
+
+// maxReplacementRounds bounds the times the model is asked to replace the generated problems that were rejected
+const maxReplacementRounds = 2
+
+type replaceProblemsFunc func(
+	ctx context.Context,
+	rejected []ai.RejectedProblem,
+	existingTitles []string,
+) shpanstream.Stream[openapi.ApiProblemForEdit]
+
+// generatedProblemsValidator repairs generated problems where possible, and rejects the ones that are still invalid
+// or duplicate an existing problem of the set or a problem generated before them
+type generatedProblemsValidator struct {
+	seenProblems map[string]bool
+	titles       []string
+	rejected     []ai.RejectedProblem
+}
+
+func newGeneratedProblemsValidator(existing []shpankids.FamilyProblemDto) *generatedProblemsValidator {
+	v := &generatedProblemsValidator{seenProblems: map[string]bool{}}
+	for _, p := range existing {
+		v.seenProblems[problemIdentity(p.Title, p.Description)] = true
+		v.titles = append(v.titles, p.Title)
+	}
+	return v
+}
+
+// problemIdentity tells problems apart by their title and description, since problems may share a generic title
+func problemIdentity(title string, description string) string {
+	return normalizeShortText(title) + "\n" + normalizeShortText(description)
+}
+
+func (v *generatedProblemsValidator) validate(p *openapi.ApiProblemForEdit) *openapi.ApiProblemForEdit {
+	dto := repairGeneratedProblem(api.ToCreateFamilyProblemDto(*p))
+	err := validateCreateProblem(dto)
+	if err != nil {
+		v.rejected = append(v.rejected, ai.RejectedProblem{Title: p.Title, Reason: err.Error()})
+		return nil
+	}
+	identity := problemIdentity(dto.Title, dto.Description)
+	if v.seenProblems[identity] {
+		v.rejected = append(v.rejected, ai.RejectedProblem{Title: p.Title, Reason: "the problem already exists"})
+		return nil
+	}
+	v.seenProblems[identity] = true
+	v.titles = append(v.titles, dto.Title)
+
+	ret := api.ToApiProblemForEditFromCreateDto(dto)
+	return &ret
+}
+
+// takeRejected returns the problems rejected since the previous call
+func (v *generatedProblemsValidator) takeRejected() []ai.RejectedProblem {
+	ret := v.rejected
+	v.rejected = nil
+	return ret
+}
+
+// validatedStream emits the valid generated problems, then asks for replacements of the rejected ones
+func (v *generatedProblemsValidator) validatedStream(
+	generated shpanstream.Stream[openapi.ApiProblemForEdit],
+	roundsLeft int,
+	replace replaceProblemsFunc,
+) shpanstream.Stream[openapi.ApiProblemForEdit] {
+	return shpanstream.ConcatenatedStream(
+		shpanstream.MapStreamWhileFiltering(generated, v.validate),
+		shpanstream.DeferredStream(func(ctx context.Context) shpanstream.Stream[openapi.ApiProblemForEdit] {
+			rejected := v.takeRejected()
+			if len(rejected) == 0 {
+				return shpanstream.EmptyStream[openapi.ApiProblemForEdit]()
+			}
+			if roundsLeft == 0 {
+				slog.Warn(fmt.Sprintf("%d generated problems were rejected and not replaced", len(rejected)))
+				return shpanstream.EmptyStream[openapi.ApiProblemForEdit]()
+			}
+			slog.Info(fmt.Sprintf("replacing %d rejected generated problems", len(rejected)))
+			return v.validatedStream(replace(ctx, rejected, v.titles), roundsLeft-1, replace)
+		}),
+	)
+}
+
+// repairGeneratedProblem fixes the mistakes of generated problems that have a single sensible fix, the problem is
+// then validated the same as problems created by CreateProblemsInSet
+func repairGeneratedProblem(p shpankids.CreateProblemDto) shpankids.CreateProblemDto {
+	p.Title = strings.TrimSpace(p.Title)
+	p.Description = strings.TrimSpace(p.Description)
+	if p.Type == "" {
+		if p.NumericAnswer != nil {
+			p.Type = shpankids.ProblemTypeNumeric
+		} else if len(p.AcceptedAnswers) > 0 {
+			p.Type = shpankids.ProblemTypeShortText
+		}
+	}
+
+	switch problemTypeOrDefault(p.Type) {
+	case shpankids.ProblemTypeNumeric, shpankids.ProblemTypeShortText:
+		// The answer is typed, not chosen from the answers
+		p.Answers = nil
+	case shpankids.ProblemTypeOrdering:
+		// The items are listed in the correct order, none of them is correct on its own
+		p.Answers = functional.MapSliceNoErr(p.Answers, func(a shpankids.CreateProblemAnswerDto) shpankids.CreateProblemAnswerDto {
+			a.Title = strings.TrimSpace(a.Title)
+			a.Correct = false
+			return a
+		})
+	default:
+		p.Answers = mergeDuplicateAnswers(p.Answers)
+	}
+
+	if p.NumericAnswer != nil {
+		p.NumericAnswer = &shpankids.NumericAnswerDto{
+			Value:     p.NumericAnswer.Value,
+			Tolerance: math.Abs(p.NumericAnswer.Tolerance),
+		}
+	}
+	p.AcceptedAnswers = distinctNonBlank(p.AcceptedAnswers)
+	p.Hints = distinctNonBlank(p.Hints)
+	return p
+}
+
+// mergeDuplicateAnswers keeps the first of answers with the same title, correct if any of them is correct
+func mergeDuplicateAnswers(answers []shpankids.CreateProblemAnswerDto) []shpankids.CreateProblemAnswerDto {
+	var ret []shpankids.CreateProblemAnswerDto
+	idxByTitle := map[string]int{}
+	for _, a := range answers {
+		a.Title = strings.TrimSpace(a.Title)
+		if idx, ok := idxByTitle[normalizeShortText(a.Title)]; ok {
+			ret[idx].Correct = ret[idx].Correct || a.Correct
+			continue
+		}
+		idxByTitle[normalizeShortText(a.Title)] = len(ret)
+		ret = append(ret, a)
+	}
+	return ret
+}
+
+func distinctNonBlank(values []string) []string {
+	var ret []string
+	seen := map[string]bool{}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[normalizeShortText(v)] {
+			continue
+		}
+		seen[normalizeShortText(v)] = true
+		ret = append(ret, v)
+	}
+	return ret
+}
@@ -0,0 +1,218 @@
+package family
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"shpankids/domain/ai"
+	"shpankids/infra/shpanstream"
+	"shpankids/openapi"
+	"shpankids/shpankids"
+)
+
+func TestRepairGeneratedProblem(t *testing.T) {
+	tests := []struct {
+		name    string
+		problem shpankids.CreateProblemDto
+		want    shpankids.CreateProblemDto
+	}{
+		{
+			name: "trims the title and description",
+			problem: shpankids.CreateProblemDto{
+				Type:        shpankids.ProblemTypeSingleChoice,
+				Title:       " 2+2 ",
+				Description: " add ",
+				Answers:     []shpankids.CreateProblemAnswerDto{{Title: " 4 ", Correct: true}, {Title: "3"}},
+			},
+			want: shpankids.CreateProblemDto{
+				Type:        shpankids.ProblemTypeSingleChoice,
+				Title:       "2+2",
+				Description: "add",
+				Answers:     []shpankids.CreateProblemAnswerDto{{Title: "4", Correct: true}, {Title: "3"}},
+			},
+		},
+		{
+			name: "merges duplicate answers",
+			problem: shpankids.CreateProblemDto{
+				Title:   "2+2",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "Four"}, {Title: "3"}, {Title: "four ", Correct: true}},
+			},
+			want: shpankids.CreateProblemDto{
+				Title:   "2+2",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "Four", Correct: true}, {Title: "3"}},
+			},
+		},
+		{
+			name: "infers numeric problems and drops their answers",
+			problem: shpankids.CreateProblemDto{
+				Title:         "pi",
+				NumericAnswer: &shpankids.NumericAnswerDto{Value: 3.14, Tolerance: -0.01},
+				Answers:       []shpankids.CreateProblemAnswerDto{{Title: "3.14", Correct: true}},
+			},
+			want: shpankids.CreateProblemDto{
+				Type:          shpankids.ProblemTypeNumeric,
+				Title:         "pi",
+				NumericAnswer: &shpankids.NumericAnswerDto{Value: 3.14, Tolerance: 0.01},
+			},
+		},
+		{
+			name: "infers short text problems and dedupes the accepted answers",
+			problem: shpankids.CreateProblemDto{
+				Title:           "capital",
+				AcceptedAnswers: []string{"Paris", " paris", "", "PARIS, France"},
+				Answers:         []shpankids.CreateProblemAnswerDto{{Title: "Paris", Correct: true}},
+			},
+			want: shpankids.CreateProblemDto{
+				Type:            shpankids.ProblemTypeShortText,
+				Title:           "capital",
+				AcceptedAnswers: []string{"Paris", "PARIS, France"},
+			},
+		},
+		{
+			name: "ordering items are not correct on their own",
+			problem: shpankids.CreateProblemDto{
+				Type:    shpankids.ProblemTypeOrdering,
+				Title:   "sort",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: " 1", Correct: true}, {Title: "2"}},
+			},
+			want: shpankids.CreateProblemDto{
+				Type:    shpankids.ProblemTypeOrdering,
+				Title:   "sort",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "1"}, {Title: "2"}},
+			},
+		},
+		{
+			name: "dedupes the hints",
+			problem: shpankids.CreateProblemDto{
+				Type:            shpankids.ProblemTypeShortText,
+				Title:           "capital",
+				AcceptedAnswers: []string{"Paris"},
+				Hints:           []string{"France", " ", "france "},
+			},
+			want: shpankids.CreateProblemDto{
+				Type:            shpankids.ProblemTypeShortText,
+				Title:           "capital",
+				AcceptedAnswers: []string{"Paris"},
+				Hints:           []string{"France"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, repairGeneratedProblem(tt.problem))
+		})
+	}
+}
+
+func TestGeneratedProblemsValidator(t *testing.T) {
+	numericProblem := func(title string) openapi.ApiProblemForEdit {
+		return openapi.ApiProblemForEdit{
+			Title:         title,
+			Answers:       []openapi.ApiProblemAnswerForEdit{},
+			NumericAnswer: &openapi.ApiNumericAnswer{Value: 1},
+		}
+	}
+	invalidProblem := openapi.ApiProblemForEdit{Title: "no answers", Answers: []openapi.ApiProblemAnswerForEdit{}}
+	existing := []shpankids.FamilyProblemDto{{Title: "1+1"}}
+
+	tests := []struct {
+		name         string
+		generated    []openapi.ApiProblemForEdit
+		replacements [][]openapi.ApiProblemForEdit
+		wantTitles   []string
+		wantRejected [][]ai.RejectedProblem
+	}{
+		{
+			name:       "valid problems",
+			generated:  []openapi.ApiProblemForEdit{numericProblem("2+2"), numericProblem("3+3")},
+			wantTitles: []string{"2+2", "3+3"},
+		},
+		{
+			name:      "replaces an existing problem",
+			generated: []openapi.ApiProblemForEdit{numericProblem(" 1+1"), numericProblem("2+2")},
+			replacements: [][]openapi.ApiProblemForEdit{
+				{numericProblem("3+3")},
+			},
+			wantTitles: []string{"2+2", "3+3"},
+			wantRejected: [][]ai.RejectedProblem{
+				{{Title: " 1+1", Reason: "the problem already exists"}},
+			},
+		},
+		{
+			name:      "replaces a problem generated twice",
+			generated: []openapi.ApiProblemForEdit{numericProblem("2+2"), numericProblem("2+2")},
+			replacements: [][]openapi.ApiProblemForEdit{
+				{numericProblem("3+3")},
+			},
+			wantTitles: []string{"2+2", "3+3"},
+			wantRejected: [][]ai.RejectedProblem{
+				{{Title: "2+2", Reason: "the problem already exists"}},
+			},
+		},
+		{
+			name:      "replaces an invalid problem",
+			generated: []openapi.ApiProblemForEdit{invalidProblem},
+			replacements: [][]openapi.ApiProblemForEdit{
+				{numericProblem("2+2")},
+			},
+			wantTitles: []string{"2+2"},
+			wantRejected: [][]ai.RejectedProblem{
+				{{Title: "no answers", Reason: "one and only one correct answer is required for problem no answers"}},
+			},
+		},
+		{
+			name:      "replaces rejected replacements",
+			generated: []openapi.ApiProblemForEdit{invalidProblem},
+			replacements: [][]openapi.ApiProblemForEdit{
+				{numericProblem("1+1")},
+				{numericProblem("2+2")},
+			},
+			wantTitles: []string{"2+2"},
+			wantRejected: [][]ai.RejectedProblem{
+				{{Title: "no answers", Reason: "one and only one correct answer is required for problem no answers"}},
+				{{Title: "1+1", Reason: "the problem already exists"}},
+			},
+		},
+		{
+			name:      "gives up after the replacement rounds",
+			generated: []openapi.ApiProblemForEdit{invalidProblem},
+			replacements: [][]openapi.ApiProblemForEdit{
+				{invalidProblem},
+				{invalidProblem},
+				{numericProblem("2+2")},
+			},
+			wantRejected: [][]ai.RejectedProblem{
+				{{Title: "no answers", Reason: "one and only one correct answer is required for problem no answers"}},
+				{{Title: "no answers", Reason: "one and only one correct answer is required for problem no answers"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rejectedRounds [][]ai.RejectedProblem
+			replace := func(
+				_ context.Context,
+				rejected []ai.RejectedProblem,
+				_ []string,
+			) shpanstream.Stream[openapi.ApiProblemForEdit] {
+				round := len(rejectedRounds)
+				rejectedRounds = append(rejectedRounds, rejected)
+				return shpanstream.Just(tt.replacements[round]...)
+			}
+
+			validated, err := newGeneratedProblemsValidator(existing).validatedStream(
+				shpanstream.Just(tt.generated...),
+				maxReplacementRounds,
+				replace,
+			).CollectFilterNil(context.Background())
+			require.NoError(t, err)
+			var titles []string
+			for _, p := range validated {
+				titles = append(titles, p.Title)
+			}
+			require.Equal(t, tt.wantTitles, titles)
+			require.Equal(t, tt.wantRejected, rejectedRounds)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package family
+
+import (
+	"cmp"
+	"context"
+	"shpankids/domain/ai"
+	"shpankids/infra/util/functional"
+	"shpankids/openapi"
+	"shpankids/shpankids"
+	"slices"
+	"time"
+)
+
+// recentSolutionsDays is how far back solutions count for the performance of the member
+const recentSolutionsDays = 30
+
+// maxMissedProblems bounds the missed problems described to the model
+const maxMissedProblems = 10
+
+// learnerProfile describes the member's age and recent performance, so generated problems adapt to the member
+func (m *Manager) learnerProfile(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+) (*ai.LearnerProfile, error) {
+	ret := &ai.LearnerProfile{
+		UserId:                 userId,
+		CurrentAccuracyPercent: -1,
+	}
+	u, err := m.userManager.FindUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if u != nil {
+		ret.FirstName = u.FirstName
+		ret.Age = ageAt(u.BirthDate, time.Now())
+	}
+
+	problemSets, err := m.ListProblemSetsForUser(ctx, familyId, userId).CollectFilterNil(ctx)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().AddDate(0, 0, -recentSolutionsDays)
+	for _, ps := range problemSets {
+		if ps.Status == shpankids.FamilyAssignmentStatusDeleted {
+			continue
+		}
+		solutions, err := m.ListUserProblemsSolutions(ctx, familyId, ps.ProblemSetId, userId).
+			Filter(func(s *openapi.ApiUserProblemSolution) bool {
+				return !s.InProgress && s.SolvedDate.After(since)
+			}).
+			CollectFilterNil(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(solutions) == 0 {
+			continue
+		}
+		performance := ai.ProblemSetPerformance{
+			ProblemSetTitle: ps.Title,
+			SolvedCount:     len(solutions),
+			CorrectCount: functional.CountSliceNoErr(solutions, func(s openapi.ApiUserProblemSolution) bool {
+				return s.Correct
+			}),
+		}
+		ret.RecentPerformance = append(ret.RecentPerformance, performance)
+
+		if ps.ProblemSetId == problemSetId {
+			ret.CurrentAccuracyPercent = performance.AccuracyPercent()
+			ret.MissedProblems = recentlyMissedProblems(solutions)
+		}
+	}
+	return ret, nil
+}
+
+func recentlyMissedProblems(solutions []openapi.ApiUserProblemSolution) []string {
+	slices.SortFunc(solutions, func(a, b openapi.ApiUserProblemSolution) int {
+		return cmp.Compare(b.SolvedDate.UnixNano(), a.SolvedDate.UnixNano())
+	})
+	var ret []string
+	for _, s := range solutions {
+		if !s.Correct && !slices.Contains(ret, s.ProblemTitle) {
+			ret = append(ret, s.ProblemTitle)
+		}
+		if len(ret) == maxMissedProblems {
+			break
+		}
+	}
+	return ret
+}
+
+// ageAt returns the age in full years, 0 when the birthdate is unknown
+func ageAt(birthDate time.Time, now time.Time) int {
+	if birthDate.IsZero() || birthDate.After(now) {
+		return 0
+	}
+	age := now.Year() - birthDate.Year()
+	if now.Month() < birthDate.Month() || (now.Month() == birthDate.Month() && now.Day() < birthDate.Day()) {
+		age--
+	}
+	return age
+}
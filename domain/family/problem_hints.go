@@ -0,0 +1,136 @@
+package family
+
+import (
+	"context"
+	"fmt"
+	"shpankids/domain/problemset"
+	"shpankids/infra/database/kvstore"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"time"
+)
+
+func (m *Manager) RevealNextProblemHint(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	problemId string,
+) (*shpankids.ProblemHintsDto, error) {
+	dbP, err := m.findProblemIncludingArchived(ctx, familyId, userId, problemSetId, problemId)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret *shpankids.ProblemHintsDto
+	err = m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		hintsRepo, err := newFamilyProblemHintRevealsRepository(ctx, tx, familyId, userId, problemSetId)
+		if err != nil {
+			return err
+		}
+		reveal, err := hintsRepo.Find(ctx, problemId)
+		if err != nil {
+			return err
+		}
+		if reveal == nil {
+			reveal = &problemset.DbProblemHintReveal{}
+		}
+		if reveal.RevealedCount >= len(dbP.Hints) {
+			return util.BadInputError(fmt.Errorf("no more hints available for problem %s", problemId))
+		}
+		before := *reveal
+		reveal.RevealedCount++
+		reveal.LastRevealed = time.Now()
+		err = hintsRepo.Set(ctx, problemId, *reveal)
+		if err != nil {
+			return err
+		}
+		ret = &shpankids.ProblemHintsDto{
+			RevealedHints: dbP.Hints[:reveal.RevealedCount],
+			HintsCount:    len(dbP.Hints),
+		}
+		return m.recordAudit(
+			ctx,
+			tx,
+			familyId,
+			shpankids.AuditActionProblemHintReveal,
+			fmt.Sprintf("%s/%s", problemSetAuditTarget(userId, problemSetId), problemId),
+			before,
+			*reveal,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (m *Manager) ListRevealedProblemHints(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	problemId string,
+) (*shpankids.ProblemHintsDto, error) {
+	dbP, err := m.findProblemIncludingArchived(ctx, familyId, userId, problemSetId, problemId)
+	if err != nil {
+		return nil, err
+	}
+	revealedCount, err := m.countRevealedHints(ctx, m.kvs, familyId, userId, problemSetId, problemId)
+	if err != nil {
+		return nil, err
+	}
+	return &shpankids.ProblemHintsDto{
+		RevealedHints: dbP.Hints[:min(revealedCount, len(dbP.Hints))],
+		HintsCount:    len(dbP.Hints),
+	}, nil
+}
+
+func (m *Manager) countRevealedHints(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+	problemSetId string,
+	problemId string,
+) (int, error) {
+	hintsRepo, err := newFamilyProblemHintRevealsRepository(ctx, kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return 0, err
+	}
+	reveal, err := hintsRepo.Find(ctx, problemId)
+	if err != nil {
+		return 0, err
+	}
+	if reveal == nil {
+		return 0, nil
+	}
+	return reveal.RevealedCount, nil
+}
+
+// findProblemIncludingArchived finds a problem of the set, problems presented again for review are already archived
+func (m *Manager) findProblemIncludingArchived(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	problemId string,
+) (*problemset.DbProblem, error) {
+	pRepo, err := newFamilyProblemsRepository(ctx, m.kvs, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	dbP, err := pRepo.FindIncludingArchived(ctx, problemId)
+	if err != nil {
+		return nil, err
+	}
+	if dbP == nil {
+		return nil, util.NotFoundError(fmt.Errorf("problem %s not found", problemId))
+	}
+	return dbP, nil
+}
+
+// pointsForSolution splits the problem set points by the number of hints used, a problem solved alone earns all of them
+func pointsForSolution(points int, hintsUsed int) int {
+	return points / (hintsUsed + 1)
+}
@@ -0,0 +1,477 @@
+package family
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"shpankids/infra/database/kvstore"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// problemSetDocumentVersion is the version of the JSON document written on export, bumped on incompatible changes
+const problemSetDocumentVersion = 1
+
+type problemSetDocument struct {
+	Version    int                         `json:"version"`
+	ProblemSet problemSetDocumentSettings  `json:"problemSet"`
+	Problems   []problemSetDocumentProblem `json:"problems"`
+}
+
+type problemSetDocumentSettings struct {
+	Title       string                        `json:"title"`
+	Description string                        `json:"description,omitempty"`
+	Points      int                           `json:"points,omitempty"`
+	DailyQuota  int                           `json:"dailyQuota,omitempty"`
+	QuotaType   shpankids.ProblemSetQuotaType `json:"quotaType,omitempty"`
+	MaxAttempts int                           `json:"maxAttempts,omitempty"`
+}
+
+type problemSetDocumentProblem struct {
+	Type            shpankids.ProblemType      `json:"type,omitempty"`
+	Title           string                     `json:"title"`
+	Description     string                     `json:"description,omitempty"`
+	Answers         []problemSetDocumentAnswer `json:"answers,omitempty"`
+	NumericAnswer   *problemSetDocumentNumeric `json:"numericAnswer,omitempty"`
+	AcceptedAnswers []string                   `json:"acceptedAnswers,omitempty"`
+	Hints           []string                   `json:"hints,omitempty"`
+	Explanation     string                     `json:"explanation,omitempty"`
+}
+
+type problemSetDocumentNumeric struct {
+	Value     float64 `json:"value"`
+	Tolerance float64 `json:"tolerance,omitempty"`
+}
+
+type problemSetDocumentAnswer struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Correct     bool   `json:"correct,omitempty"`
+}
+
+// CSV columns, multiple values in a cell are separated by csvValuesSeparator. Answers of ordering problems are
+// listed in the correct order, correct answers of choice problems are listed by their 1 based index
+const (
+	csvColumnType            = "type"
+	csvColumnTitle           = "title"
+	csvColumnDescription     = "description"
+	csvColumnAnswers         = "answers"
+	csvColumnCorrectAnswers  = "correctAnswers"
+	csvColumnNumericAnswer   = "numericAnswer"
+	csvColumnTolerance       = "tolerance"
+	csvColumnAcceptedAnswers = "acceptedAnswers"
+	csvColumnHints           = "hints"
+	csvColumnExplanation     = "explanation"
+
+	csvValuesSeparator = "|"
+)
+
+var problemsCsvColumns = []string{
+	csvColumnType,
+	csvColumnTitle,
+	csvColumnDescription,
+	csvColumnAnswers,
+	csvColumnCorrectAnswers,
+	csvColumnNumericAnswer,
+	csvColumnTolerance,
+	csvColumnAcceptedAnswers,
+	csvColumnHints,
+	csvColumnExplanation,
+}
+
+// importedProblem is a problem read from an imported file, along with the row it was read from
+type importedProblem struct {
+	row     int
+	problem shpankids.CreateProblemDto
+}
+
+func (m *Manager) ExportProblemSet(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	problemSetId string,
+	format shpankids.ProblemSetFileFormat,
+) ([]byte, error) {
+	// Exported files hold the correct answers
+	err := m.validateProblemSetAdmin(ctx, familyId, userId)
+	if err != nil {
+		return nil, err
+	}
+	ps, err := m.getProblemSet(ctx, familyId, userId, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	problems, err := m.ListProblemsForProblemSet(ctx, familyId, userId, problemSetId, true).CollectFilterNil(ctx)
+	if err != nil {
+		return nil, err
+	}
+	docProblems := functional.MapSliceNoErr(problems, toProblemSetDocumentProblem)
+
+	switch format {
+	case shpankids.ProblemSetFileFormatJson:
+		return json.MarshalIndent(problemSetDocument{
+			Version: problemSetDocumentVersion,
+			ProblemSet: problemSetDocumentSettings{
+				Title:       ps.Title,
+				Description: ps.Description,
+				Points:      ps.Points,
+				DailyQuota:  ps.DailyQuota,
+				QuotaType:   ps.QuotaType,
+				MaxAttempts: ps.MaxAttempts,
+			},
+			Problems: docProblems,
+		}, "", "  ")
+	case shpankids.ProblemSetFileFormatCsv:
+		return writeProblemsCsv(docProblems)
+	default:
+		return nil, util.BadInputError(fmt.Errorf("unsupported problem set file format: %s", format))
+	}
+}
+
+func toProblemSetDocumentProblem(p shpankids.FamilyProblemDto) problemSetDocumentProblem {
+	answers := slices.Clone(p.Answers)
+	if p.Type != shpankids.ProblemTypeOrdering {
+		// Choice answer ids are their original index
+		slices.SortFunc(answers, func(a, b shpankids.ProblemAnswerDto) int {
+			aIdx, aErr := strconv.Atoi(a.Id)
+			bIdx, bErr := strconv.Atoi(b.Id)
+			if aErr != nil || bErr != nil {
+				return cmp.Compare(a.Id, b.Id)
+			}
+			return cmp.Compare(aIdx, bIdx)
+		})
+	}
+	return problemSetDocumentProblem{
+		Type:        p.Type,
+		Title:       p.Title,
+		Description: p.Description,
+		Answers: functional.MapSliceNoErr(answers, func(a shpankids.ProblemAnswerDto) problemSetDocumentAnswer {
+			return problemSetDocumentAnswer{
+				Title:       a.Title,
+				Description: a.Description,
+				Correct:     a.Correct,
+			}
+		}),
+		NumericAnswer:   toProblemSetDocumentNumeric(p.NumericAnswer),
+		AcceptedAnswers: p.AcceptedAnswers,
+		Hints:           p.Hints,
+		Explanation:     p.Explanation,
+	}
+}
+
+func toProblemSetDocumentNumeric(n *shpankids.NumericAnswerDto) *problemSetDocumentNumeric {
+	if n == nil {
+		return nil
+	}
+	return &problemSetDocumentNumeric{Value: n.Value, Tolerance: n.Tolerance}
+}
+
+func fromProblemSetDocumentNumeric(n *problemSetDocumentNumeric) *shpankids.NumericAnswerDto {
+	if n == nil {
+		return nil
+	}
+	return &shpankids.NumericAnswerDto{Value: n.Value, Tolerance: n.Tolerance}
+}
+
+func writeProblemsCsv(problems []problemSetDocumentProblem) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	err := w.Write(problemsCsvColumns)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range problems {
+		var correctAnswers []string
+		for idx, a := range p.Answers {
+			if a.Correct {
+				correctAnswers = append(correctAnswers, strconv.Itoa(idx+1))
+			}
+		}
+		numericAnswer, tolerance := "", ""
+		if p.NumericAnswer != nil {
+			numericAnswer = strconv.FormatFloat(p.NumericAnswer.Value, 'f', -1, 64)
+			tolerance = strconv.FormatFloat(p.NumericAnswer.Tolerance, 'f', -1, 64)
+		}
+		err = w.Write([]string{
+			string(p.Type),
+			p.Title,
+			p.Description,
+			strings.Join(functional.MapSliceNoErr(p.Answers, func(a problemSetDocumentAnswer) string {
+				return a.Title
+			}), csvValuesSeparator),
+			strings.Join(correctAnswers, csvValuesSeparator),
+			numericAnswer,
+			tolerance,
+			strings.Join(p.AcceptedAnswers, csvValuesSeparator),
+			strings.Join(p.Hints, csvValuesSeparator),
+			p.Explanation,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func (m *Manager) ImportProblemSet(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	problemSetImport shpankids.ProblemSetImportDto,
+) (*shpankids.ProblemSetImportResultDto, error) {
+	err := m.validateProblemSetAdmin(ctx, familyId, forUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings *shpankids.CreateProblemSetDto
+	var problems []importedProblem
+	var importErrors []shpankids.ProblemImportErrorDto
+	switch problemSetImport.Format {
+	case shpankids.ProblemSetFileFormatJson:
+		settings, problems, importErrors = readProblemSetDocument(problemSetImport.Data)
+	case shpankids.ProblemSetFileFormatCsv:
+		if problemSetImport.ProblemSetId == "" {
+			return nil, util.BadInputError(fmt.Errorf("problem set id is required for importing a csv file"))
+		}
+		problems, importErrors = readProblemsCsv(problemSetImport.Data)
+	default:
+		return nil, util.BadInputError(fmt.Errorf("unsupported problem set file format: %s", problemSetImport.Format))
+	}
+
+	if problemSetImport.ProblemSetId != "" {
+		// Importing to an existing problem set, the settings in the file are ignored
+		_, err = m.getProblemSet(ctx, familyId, forUserId, problemSetImport.ProblemSetId)
+		if err != nil {
+			return nil, err
+		}
+		err = m.validateNotTemplateCopy(ctx, familyId, forUserId, problemSetImport.ProblemSetId)
+		if err != nil {
+			return nil, err
+		}
+	} else if settings != nil {
+		err = validateImportedSettings(*settings)
+		if err != nil {
+			importErrors = append(importErrors, shpankids.ProblemImportErrorDto{Row: 0, Message: err.Error()})
+		}
+	}
+
+	for _, p := range problems {
+		err = validateCreateProblem(p.problem)
+		if err != nil {
+			importErrors = append(importErrors, shpankids.ProblemImportErrorDto{Row: p.row, Message: err.Error()})
+		}
+	}
+	slices.SortStableFunc(importErrors, func(a, b shpankids.ProblemImportErrorDto) int {
+		return cmp.Compare(a.Row, b.Row)
+	})
+
+	ret := &shpankids.ProblemSetImportResultDto{
+		ProblemSetId: problemSetImport.ProblemSetId,
+		Errors:       importErrors,
+	}
+	if len(importErrors) > 0 {
+		return ret, nil
+	}
+	ret.ImportedCount = len(problems)
+	if problemSetImport.DryRun {
+		return ret, nil
+	}
+
+	// The problem set and its problems are created together, so a failed import doesn't leave an empty problem set
+	createSet := ret.ProblemSetId == ""
+	if createSet {
+		ret.ProblemSetId = uuid.NewString()
+		settings.ProblemSetId = ret.ProblemSetId
+	}
+	err = m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		if createSet {
+			err := m.createProblemSet(ctx, tx, familyId, forUserId, *settings)
+			if err != nil {
+				return err
+			}
+		}
+		if len(problems) == 0 {
+			return nil
+		}
+		return m.createProblemsInSet(
+			ctx,
+			tx,
+			familyId,
+			forUserId,
+			ret.ProblemSetId,
+			functional.MapSliceNoErr(problems, func(p importedProblem) shpankids.CreateProblemDto {
+				return p.problem
+			}),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func validateImportedSettings(settings shpankids.CreateProblemSetDto) error {
+	if settings.Title == "" {
+		return util.BadInputError(fmt.Errorf("problem set title is required"))
+	}
+	return validateProblemSetSettings(settings)
+}
+
+func readProblemSetDocument(data []byte) (*shpankids.CreateProblemSetDto, []importedProblem, []shpankids.ProblemImportErrorDto) {
+	var doc problemSetDocument
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, nil, []shpankids.ProblemImportErrorDto{{Row: 0, Message: fmt.Sprintf("invalid json document: %s", err)}}
+	}
+	if doc.Version < 1 || doc.Version > problemSetDocumentVersion {
+		return nil, nil, []shpankids.ProblemImportErrorDto{{
+			Row:     0,
+			Message: fmt.Sprintf("unsupported document version %d, up to version %d is supported", doc.Version, problemSetDocumentVersion),
+		}}
+	}
+	settings := &shpankids.CreateProblemSetDto{
+		Title:       doc.ProblemSet.Title,
+		Description: doc.ProblemSet.Description,
+		Points:      doc.ProblemSet.Points,
+		DailyQuota:  doc.ProblemSet.DailyQuota,
+		QuotaType:   doc.ProblemSet.QuotaType,
+		MaxAttempts: doc.ProblemSet.MaxAttempts,
+	}
+	problems := make([]importedProblem, 0, len(doc.Problems))
+	for idx, p := range doc.Problems {
+		problems = append(problems, importedProblem{
+			row: idx + 1,
+			problem: shpankids.CreateProblemDto{
+				Type:        p.Type,
+				Title:       p.Title,
+				Description: p.Description,
+				Hints:       p.Hints,
+				Explanation: p.Explanation,
+				Answers: functional.MapSliceNoErr(p.Answers, func(a problemSetDocumentAnswer) shpankids.CreateProblemAnswerDto {
+					return shpankids.CreateProblemAnswerDto{
+						Title:       a.Title,
+						Description: a.Description,
+						Correct:     a.Correct,
+					}
+				}),
+				NumericAnswer:   fromProblemSetDocumentNumeric(p.NumericAnswer),
+				AcceptedAnswers: p.AcceptedAnswers,
+			},
+		})
+	}
+	return settings, problems, nil
+}
+
+func readProblemsCsv(data []byte) ([]importedProblem, []shpankids.ProblemImportErrorDto) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, []shpankids.ProblemImportErrorDto{{Row: 0, Message: "a header row is required"}}
+	}
+	if err != nil {
+		return nil, []shpankids.ProblemImportErrorDto{{Row: 0, Message: fmt.Sprintf("invalid csv file: %s", err)}}
+	}
+	columns := map[string]int{}
+	for idx, column := range header {
+		column = strings.TrimSpace(column)
+		if !slices.Contains(problemsCsvColumns, column) {
+			return nil, []shpankids.ProblemImportErrorDto{{
+				Row:     1,
+				Message: fmt.Sprintf("unknown column %s, supported columns are %s", column, strings.Join(problemsCsvColumns, ",")),
+			}}
+		}
+		columns[column] = idx
+	}
+	if _, ok := columns[csvColumnTitle]; !ok {
+		return nil, []shpankids.ProblemImportErrorDto{{Row: 1, Message: "title column is required"}}
+	}
+
+	var problems []importedProblem
+	var importErrors []shpankids.ProblemImportErrorDto
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Field positions are only known for records that were read successfully
+			row := 0
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				row = parseErr.StartLine
+			}
+			importErrors = append(importErrors, shpankids.ProblemImportErrorDto{Row: row, Message: fmt.Sprintf("invalid csv row: %s", err)})
+			continue
+		}
+		row, _ := r.FieldPos(0)
+		p, err := readProblemCsvRecord(record, columns)
+		if err != nil {
+			importErrors = append(importErrors, shpankids.ProblemImportErrorDto{Row: row, Message: err.Error()})
+			continue
+		}
+		problems = append(problems, importedProblem{row: row, problem: *p})
+	}
+	return problems, importErrors
+}
+
+func readProblemCsvRecord(record []string, columns map[string]int) (*shpankids.CreateProblemDto, error) {
+	cell := func(column string) string {
+		idx, ok := columns[column]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+	values := func(column string) []string {
+		if cell(column) == "" {
+			return nil
+		}
+		return functional.MapSliceNoErr(strings.Split(cell(column), csvValuesSeparator), strings.TrimSpace)
+	}
+
+	p := &shpankids.CreateProblemDto{
+		Type:            shpankids.ProblemType(cell(csvColumnType)),
+		Title:           cell(csvColumnTitle),
+		Description:     cell(csvColumnDescription),
+		Hints:           values(csvColumnHints),
+		Explanation:     cell(csvColumnExplanation),
+		AcceptedAnswers: values(csvColumnAcceptedAnswers),
+		Answers: functional.MapSliceNoErr(values(csvColumnAnswers), func(title string) shpankids.CreateProblemAnswerDto {
+			return shpankids.CreateProblemAnswerDto{Title: title}
+		}),
+	}
+	for _, correct := range values(csvColumnCorrectAnswers) {
+		idx, err := strconv.Atoi(correct)
+		if err != nil || idx < 1 || idx > len(p.Answers) {
+			return nil, util.BadInputError(fmt.Errorf("correct answer %s must be the index of one of the %d answers", correct, len(p.Answers)))
+		}
+		p.Answers[idx-1].Correct = true
+	}
+	if cell(csvColumnNumericAnswer) != "" {
+		value, err := strconv.ParseFloat(cell(csvColumnNumericAnswer), 64)
+		if err != nil {
+			return nil, util.BadInputError(fmt.Errorf("numeric answer %s is not a number", cell(csvColumnNumericAnswer)))
+		}
+		p.NumericAnswer = &shpankids.NumericAnswerDto{Value: value}
+		if cell(csvColumnTolerance) != "" {
+			p.NumericAnswer.Tolerance, err = strconv.ParseFloat(cell(csvColumnTolerance), 64)
+			if err != nil {
+				return nil, util.BadInputError(fmt.Errorf("tolerance %s is not a number", cell(csvColumnTolerance)))
+			}
+		}
+	}
+	return p, nil
+}
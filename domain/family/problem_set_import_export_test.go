@@ -0,0 +1,153 @@
+package family
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"shpankids/shpankids"
+)
+
+func TestReadProblemsCsv(t *testing.T) {
+	tests := []struct {
+		name       string
+		csv        string
+		wantRows   []int
+		wantTitles []string
+		wantErrors []shpankids.ProblemImportErrorDto
+	}{
+		{
+			name:       "valid rows",
+			csv:        "title,answers,correctAnswers\n1+1,1|2,2\n2+2,3|4,2\n",
+			wantRows:   []int{2, 3},
+			wantTitles: []string{"1+1", "2+2"},
+		},
+		{
+			name:       "empty file",
+			csv:        "",
+			wantErrors: []shpankids.ProblemImportErrorDto{{Row: 0, Message: "a header row is required"}},
+		},
+		{
+			name: "unknown column",
+			csv:  "title,answer\n1+1,2\n",
+			wantErrors: []shpankids.ProblemImportErrorDto{{
+				Row:     1,
+				Message: "unknown column answer, supported columns are type,title,description,answers,correctAnswers,numericAnswer,tolerance,acceptedAnswers,hints,explanation",
+			}},
+		},
+		{
+			name:       "missing title column",
+			csv:        "description\nsome problem\n",
+			wantErrors: []shpankids.ProblemImportErrorDto{{Row: 1, Message: "title column is required"}},
+		},
+		{
+			name:       "parse error in the first field",
+			csv:        "title\na\"b\n2+2\n",
+			wantRows:   []int{3},
+			wantTitles: []string{"2+2"},
+			wantErrors: []shpankids.ProblemImportErrorDto{{
+				Row:     2,
+				Message: "invalid csv row: parse error on line 2, column 2: bare \" in non-quoted-field",
+			}},
+		},
+		{
+			name:       "invalid record",
+			csv:        "title,answers,correctAnswers\n1+1,1|2,3\n2+2,3|4,2\n",
+			wantRows:   []int{3},
+			wantTitles: []string{"2+2"},
+			wantErrors: []shpankids.ProblemImportErrorDto{{
+				Row:     2,
+				Message: "correct answer 3 must be the index of one of the 2 answers",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, importErrors := readProblemsCsv([]byte(tt.csv))
+			require.Equal(t, tt.wantErrors, importErrors)
+			require.Len(t, problems, len(tt.wantRows))
+			for i, p := range problems {
+				require.Equal(t, tt.wantRows[i], p.row)
+				require.Equal(t, tt.wantTitles[i], p.problem.Title)
+			}
+		})
+	}
+}
+
+func TestReadProblemCsvRecord(t *testing.T) {
+	columns := map[string]int{}
+	for idx, column := range problemsCsvColumns {
+		columns[column] = idx
+	}
+	tests := []struct {
+		name    string
+		record  []string
+		want    *shpankids.CreateProblemDto
+		wantErr string
+	}{
+		{
+			name:   "single choice",
+			record: []string{"", " 1+1 ", "", "1 | 2 | 3", "2", "", "", "", "think|count", "one and one"},
+			want: &shpankids.CreateProblemDto{
+				Title: "1+1",
+				Answers: []shpankids.CreateProblemAnswerDto{
+					{Title: "1"},
+					{Title: "2", Correct: true},
+					{Title: "3"},
+				},
+				Hints:       []string{"think", "count"},
+				Explanation: "one and one",
+			},
+		},
+		{
+			name:   "numeric",
+			record: []string{"numeric", "1/3", "", "", "", "0.33", "0.01", "", "", ""},
+			want: &shpankids.CreateProblemDto{
+				Type:          shpankids.ProblemTypeNumeric,
+				Title:         "1/3",
+				Answers:       []shpankids.CreateProblemAnswerDto{},
+				NumericAnswer: &shpankids.NumericAnswerDto{Value: 0.33, Tolerance: 0.01},
+			},
+		},
+		{
+			name:   "short text with missing trailing cells",
+			record: []string{"shortText", "Capital of France", "", "", "", "", "", "Paris|paris"},
+			want: &shpankids.CreateProblemDto{
+				Type:            shpankids.ProblemTypeShortText,
+				Title:           "Capital of France",
+				Answers:         []shpankids.CreateProblemAnswerDto{},
+				AcceptedAnswers: []string{"Paris", "paris"},
+			},
+		},
+		{
+			name:    "correct answer out of range",
+			record:  []string{"", "1+1", "", "1|2", "0", "", "", "", "", ""},
+			wantErr: "correct answer 0 must be the index of one of the 2 answers",
+		},
+		{
+			name:    "correct answer not a number",
+			record:  []string{"", "1+1", "", "1|2", "second", "", "", "", "", ""},
+			wantErr: "correct answer second must be the index of one of the 2 answers",
+		},
+		{
+			name:    "invalid numeric answer",
+			record:  []string{"numeric", "1+1", "", "", "", "two", "", "", "", ""},
+			wantErr: "numeric answer two is not a number",
+		},
+		{
+			name:    "invalid tolerance",
+			record:  []string{"numeric", "1+1", "", "", "", "2", "little", "", "", ""},
+			wantErr: "tolerance little is not a number",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := readProblemCsvRecord(tt.record, columns)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, p)
+		})
+	}
+}
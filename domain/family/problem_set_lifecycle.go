@@ -0,0 +1,423 @@
+package family
+
+import (
+	"context"
+	"fmt"
+	"shpankids/domain/problemset"
+	"shpankids/infra/database/kvstore"
+	"shpankids/infra/shpanstream"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"slices"
+	"time"
+)
+
+func (m *Manager) UpdateProblemSet(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	familyProblemSet shpankids.CreateProblemSetDto,
+) error {
+	err := validateProblemSetSettings(familyProblemSet)
+	if err != nil {
+		return err
+	}
+	if familyProblemSet.Title == "" {
+		return util.BadInputError(fmt.Errorf("title is required"))
+	}
+	return m.updateProblemSet(
+		ctx,
+		familyId,
+		forUserId,
+		familyProblemSet.ProblemSetId,
+		shpankids.AuditActionProblemSetUpdate,
+		func(dbPs *problemset.DbProblemSet) error {
+			if dbPs.Status == shpankids.FamilyAssignmentStatusDeleted {
+				return util.BadInputError(fmt.Errorf("problem set %s was deleted", familyProblemSet.ProblemSetId))
+			}
+			dbPs.Title = familyProblemSet.Title
+			dbPs.Description = familyProblemSet.Description
+			dbPs.Points = familyProblemSet.Points
+			dbPs.DailyQuota = familyProblemSet.DailyQuota
+			dbPs.QuotaType = familyProblemSet.QuotaType
+			dbPs.MaxAttempts = familyProblemSet.MaxAttempts
+			return nil
+		},
+	)
+}
+
+// SetProblemSetStatus archives, restores or deletes a problem set. Only active problem sets are assigned,
+// deleted problem sets can't be restored
+func (m *Manager) SetProblemSetStatus(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	problemSetId string,
+	status shpankids.FamilyAssignmentStatus,
+) error {
+	switch status {
+	case shpankids.FamilyAssignmentStatusActive,
+		shpankids.FamilyAssignmentStatusArchived,
+		shpankids.FamilyAssignmentStatusDeleted:
+	default:
+		return util.BadInputError(fmt.Errorf("invalid problem set status: %s", status))
+	}
+	return m.updateProblemSet(
+		ctx,
+		familyId,
+		forUserId,
+		problemSetId,
+		shpankids.AuditActionProblemSetStatusUpdate,
+		func(dbPs *problemset.DbProblemSet) error {
+			if dbPs.Status == shpankids.FamilyAssignmentStatusDeleted {
+				return util.BadInputError(fmt.Errorf("problem set %s was deleted", problemSetId))
+			}
+			if dbPs.Status == status {
+				return util.BadInputError(fmt.Errorf("problem set %s is already %s", problemSetId, status))
+			}
+			dbPs.Status = status
+			dbPs.StatusDate = time.Now()
+			return nil
+		},
+	)
+}
+
+func (m *Manager) updateProblemSet(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	problemSetId string,
+	auditAction shpankids.AuditAction,
+	updateFunc func(dbPs *problemset.DbProblemSet) error,
+) error {
+	err := m.validateProblemSetAdmin(ctx, familyId, forUserId)
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		psRepo, err := newProblemSetsRepository(ctx, tx, familyId, forUserId)
+		if err != nil {
+			return err
+		}
+		dbPs, err := psRepo.Find(ctx, problemSetId)
+		if err != nil {
+			return err
+		}
+		if dbPs == nil {
+			return util.NotFoundError(fmt.Errorf("problem set %s not found", problemSetId))
+		}
+		err = validateNotTemplateCopy(problemSetId, *dbPs)
+		if err != nil {
+			return err
+		}
+		before := *dbPs
+		err = updateFunc(dbPs)
+		if err != nil {
+			return err
+		}
+		err = psRepo.Set(ctx, problemSetId, *dbPs)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(ctx, tx, familyId, auditAction, problemSetAuditTarget(forUserId, problemSetId), before, *dbPs)
+	})
+}
+
+// validateProblemSetAdmin checks the logged-in user may manage the problem sets of a family member
+func (m *Manager) validateProblemSetAdmin(ctx context.Context, familyId string, forUserId string) error {
+	dbFam, err := m.getFamilyAsAdmin(ctx, familyId, "manage problem sets")
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(dbFam.Members, func(member dbFamilyMember) bool {
+		return member.UserId == forUserId
+	}) {
+		return util.BadInputError(fmt.Errorf("user %s is not part of the family %s", forUserId, dbFam.Name))
+	}
+	return nil
+}
+
+// UpdateProblem replaces the content of a problem, keeping its position in the set and whether it is archived
+func (m *Manager) UpdateProblem(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	problemSetId string,
+	problemId string,
+	familyProblem shpankids.CreateProblemDto,
+) error {
+	err := validateCreateProblem(familyProblem)
+	if err != nil {
+		return err
+	}
+	err = m.validateNotTemplateCopy(ctx, familyId, forUserId, problemSetId)
+	if err != nil {
+		return err
+	}
+	return m.updateProblem(
+		ctx,
+		familyId,
+		forUserId,
+		problemSetId,
+		problemId,
+		shpankids.AuditActionProblemUpdate,
+		func(ctx context.Context, repo familyProblemsRepository, dbP problemset.DbProblem, archived bool) (*problemset.DbProblem, error) {
+			updated := toDbProblem(familyProblem, dbP.Created, dbP.Order)
+			updated.AdminArchived = dbP.AdminArchived
+			return &updated, setProblemKeepingArchived(ctx, repo, problemId, updated, archived)
+		},
+	)
+}
+
+// setProblemKeepingArchived updates a problem, archived problems can only be updated while active
+func setProblemKeepingArchived(
+	ctx context.Context,
+	repo familyProblemsRepository,
+	problemId string,
+	dbP problemset.DbProblem,
+	archived bool,
+) error {
+	if archived {
+		err := repo.UnArchive(ctx, problemId)
+		if err != nil {
+			return err
+		}
+	}
+	err := repo.Set(ctx, problemId, dbP)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return repo.Archive(ctx, problemId)
+	}
+	return nil
+}
+
+func deleteProblem(ctx context.Context, repo familyProblemsRepository, problemId string, archived bool) error {
+	if archived {
+		err := repo.UnArchive(ctx, problemId)
+		if err != nil {
+			return err
+		}
+	}
+	return repo.Unset(ctx, problemId)
+}
+
+// SetProblemStatus archives, restores or deletes a single problem of the set. Restoring an archived problem
+// presents it again as a new problem, deleted problems are removed from the set
+func (m *Manager) SetProblemStatus(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	problemSetId string,
+	problemId string,
+	status shpankids.FamilyAssignmentStatus,
+) error {
+	// Problems of a template copy may be archived and restored, syncing the copy keeps them archived, but only the
+	// template can delete them
+	if status == shpankids.FamilyAssignmentStatusDeleted {
+		err := m.validateNotTemplateCopy(ctx, familyId, forUserId, problemSetId)
+		if err != nil {
+			return err
+		}
+	}
+	return m.updateProblem(
+		ctx,
+		familyId,
+		forUserId,
+		problemSetId,
+		problemId,
+		shpankids.AuditActionProblemStatusUpdate,
+		func(ctx context.Context, repo familyProblemsRepository, dbP problemset.DbProblem, archived bool) (*problemset.DbProblem, error) {
+			switch status {
+			case shpankids.FamilyAssignmentStatusActive:
+				if !archived {
+					return nil, util.BadInputError(fmt.Errorf("problem %s is already active", problemId))
+				}
+				err := repo.UnArchive(ctx, problemId)
+				if err != nil {
+					return nil, err
+				}
+				dbP.AdminArchived = false
+				return &dbP, repo.Set(ctx, problemId, dbP)
+			case shpankids.FamilyAssignmentStatusArchived:
+				if dbP.AdminArchived {
+					return nil, util.BadInputError(fmt.Errorf("problem %s is already archived", problemId))
+				}
+
+				// Solved problems are already archived until they are due for review, they are only marked as archived
+				// by the admin so they are not presented again
+				dbP.AdminArchived = true
+				err := setProblemKeepingArchived(ctx, repo, problemId, dbP, archived)
+				if err != nil || archived {
+					return &dbP, err
+				}
+				return &dbP, repo.Archive(ctx, problemId)
+			case shpankids.FamilyAssignmentStatusDeleted:
+				return nil, deleteProblem(ctx, repo, problemId, archived)
+			default:
+				return nil, util.BadInputError(fmt.Errorf("invalid problem status: %s", status))
+			}
+		},
+	)
+}
+
+func (m *Manager) updateProblem(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	problemSetId string,
+	problemId string,
+	auditAction shpankids.AuditAction,
+	updateFunc func(ctx context.Context, repo familyProblemsRepository, dbP problemset.DbProblem, archived bool) (*problemset.DbProblem, error),
+) error {
+	err := m.validateProblemSetAdmin(ctx, familyId, forUserId)
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		repo, err := newFamilyProblemsRepository(ctx, tx, familyId, forUserId, problemSetId)
+		if err != nil {
+			return err
+		}
+		activeP, err := repo.Find(ctx, problemId)
+		if err != nil {
+			return err
+		}
+		dbP, err := repo.FindIncludingArchived(ctx, problemId)
+		if err != nil {
+			return err
+		}
+		if dbP == nil {
+			return util.NotFoundError(fmt.Errorf("problem %s not found", problemId))
+		}
+		after, err := updateFunc(ctx, repo, *dbP, activeP == nil)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(
+			ctx,
+			tx,
+			familyId,
+			auditAction,
+			fmt.Sprintf("%s/%s", problemSetAuditTarget(forUserId, problemSetId), problemId),
+			*dbP,
+			after,
+		)
+	})
+}
+
+// ReorderProblems sets the order the active problems of the set are presented in, all of them must be listed
+func (m *Manager) ReorderProblems(
+	ctx context.Context,
+	familyId string,
+	forUserId string,
+	problemSetId string,
+	problemIds []string,
+) error {
+	err := m.validateProblemSetAdmin(ctx, familyId, forUserId)
+	if err != nil {
+		return err
+	}
+	err = m.validateNotTemplateCopy(ctx, familyId, forUserId, problemSetId)
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		repo, err := newFamilyProblemsRepository(ctx, tx, familyId, forUserId, problemSetId)
+		if err != nil {
+			return err
+		}
+		activeById, err := collectProblemsById(ctx, repo.Stream(ctx))
+		if err != nil {
+			return err
+		}
+		err = validateAllProblemsListed(problemIds, activeById)
+		if err != nil {
+			return err
+		}
+
+		// Archived problems keep their order, reordered problems are placed after all of them
+		lastOrder := 0
+		err = repo.StreamArchived(ctx).Consume(ctx, func(e *functional.Entry[string, problemset.DbProblem]) {
+			lastOrder = max(lastOrder, e.Value.Order)
+		})
+		if err != nil {
+			return err
+		}
+		before := map[string]int{}
+		after := map[string]int{}
+		for idx, problemId := range problemIds {
+			dbP := activeById[problemId]
+			before[problemId] = dbP.Order
+			dbP.Order = lastOrder + idx + 1
+			after[problemId] = dbP.Order
+			err = repo.Set(ctx, problemId, dbP)
+			if err != nil {
+				return err
+			}
+		}
+		return m.recordAudit(
+			ctx,
+			tx,
+			familyId,
+			shpankids.AuditActionProblemsReorder,
+			problemSetAuditTarget(forUserId, problemSetId),
+			before,
+			after,
+		)
+	})
+}
+
+func collectProblemsById(
+	ctx context.Context,
+	s shpanstream.Stream[functional.Entry[string, problemset.DbProblem]],
+) (map[string]problemset.DbProblem, error) {
+	problems, err := s.CollectFilterNil(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return functional.SliceToMapKeyAndValueNoErr(
+		problems,
+		func(e functional.Entry[string, problemset.DbProblem]) string {
+			return e.Key
+		},
+		func(e functional.Entry[string, problemset.DbProblem]) problemset.DbProblem {
+			return e.Value
+		},
+	), nil
+}
+
+// validateAllProblemsListed checks a new order of problems lists each of the problems exactly once
+func validateAllProblemsListed(problemIds []string, problems map[string]problemset.DbProblem) error {
+	if len(problemIds) != len(problems) || len(functional.SliceToSet(problemIds)) != len(problemIds) {
+		return util.BadInputError(fmt.Errorf("all %d active problems of the set must be listed exactly once", len(problems)))
+	}
+	for _, problemId := range problemIds {
+		if _, ok := problems[problemId]; !ok {
+			return util.BadInputError(fmt.Errorf("problem %s is not an active problem of the set", problemId))
+		}
+	}
+	return nil
+}
+
+// validateNotTemplateCopy makes sure a problem set isn't changed directly when it is a member copy of a template
+func (m *Manager) validateNotTemplateCopy(ctx context.Context, familyId string, userId string, problemSetId string) error {
+	psRepo, err := newProblemSetsRepository(ctx, m.kvs, familyId, userId)
+	if err != nil {
+		return err
+	}
+	dbPs, err := psRepo.Find(ctx, problemSetId)
+	if err != nil || dbPs == nil {
+		return err
+	}
+	return validateNotTemplateCopy(problemSetId, *dbPs)
+}
+
+func validateNotTemplateCopy(problemSetId string, dbPs problemset.DbProblemSet) error {
+	if dbPs.TemplateId != "" {
+		return util.BadInputError(fmt.Errorf("problem set %s is shared by a template, update the template %s instead", problemSetId, dbPs.TemplateId))
+	}
+	return nil
+}
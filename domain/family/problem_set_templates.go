@@ -0,0 +1,543 @@
+package family
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"shpankids/domain/problemset"
+	"shpankids/infra/database/kvstore"
+	"shpankids/infra/shpanstream"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"slices"
+	"time"
+)
+
+// Problem set templates are kept at the family level, each assigned member gets a copy of the template
+// under the member problem sets, having the template id. The copies are kept in sync with the template on every
+// change, while the member solutions, hints and archived (solved) problems are kept per member
+
+func (m *Manager) CreateProblemSetTemplate(
+	ctx context.Context,
+	familyId string,
+	template shpankids.CreateProblemSetDto,
+	memberIds []string,
+) error {
+	if template.ProblemSetId == "" {
+		return util.BadInputError(fmt.Errorf("template id is required"))
+	}
+	if template.Title == "" {
+		return util.BadInputError(fmt.Errorf("title is required"))
+	}
+	err := validateProblemSetSettings(template)
+	if err != nil {
+		return err
+	}
+	err = m.validateTemplateMembers(ctx, familyId, memberIds)
+	if err != nil {
+		return err
+	}
+
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		tRepo, err := newProblemSetTemplatesRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		existing, err := tRepo.Find(ctx, template.ProblemSetId)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return util.DuplicateInputError(fmt.Errorf("template %s already exists", template.ProblemSetId))
+		}
+		createTime := time.Now()
+		dbTemplate := problemset.DbProblemSetTemplate{
+			DbProblemSet: problemset.DbProblemSet{
+				Title:       template.Title,
+				Description: template.Description,
+				Created:     createTime,
+				Status:      shpankids.FamilyAssignmentStatusActive,
+				StatusDate:  createTime,
+				Points:      template.Points,
+				DailyQuota:  template.DailyQuota,
+				QuotaType:   template.QuotaType,
+				MaxAttempts: template.MaxAttempts,
+			},
+			MemberIds: memberIds,
+		}
+		err = tRepo.Set(ctx, template.ProblemSetId, dbTemplate)
+		if err != nil {
+			return err
+		}
+		err = syncTemplateCopies(ctx, tx, familyId, template.ProblemSetId, nil)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(
+			ctx,
+			tx,
+			familyId,
+			shpankids.AuditActionProblemSetTemplateCreate,
+			problemSetTemplateAuditTarget(template.ProblemSetId),
+			nil,
+			dbTemplate,
+		)
+	})
+}
+
+func (m *Manager) UpdateProblemSetTemplate(ctx context.Context, familyId string, template shpankids.CreateProblemSetDto) error {
+	if template.Title == "" {
+		return util.BadInputError(fmt.Errorf("title is required"))
+	}
+	err := validateProblemSetSettings(template)
+	if err != nil {
+		return err
+	}
+	return m.updateTemplate(
+		ctx,
+		familyId,
+		template.ProblemSetId,
+		shpankids.AuditActionProblemSetTemplateUpdate,
+		func(dbTemplate *problemset.DbProblemSetTemplate) ([]string, error) {
+			dbTemplate.Title = template.Title
+			dbTemplate.Description = template.Description
+			dbTemplate.Points = template.Points
+			dbTemplate.DailyQuota = template.DailyQuota
+			dbTemplate.QuotaType = template.QuotaType
+			dbTemplate.MaxAttempts = template.MaxAttempts
+			return nil, nil
+		},
+	)
+}
+
+// AssignProblemSetTemplate sets the members the template is assigned to. New members get a copy of the template,
+// the copies of unassigned members are archived, keeping their progress in case they are assigned again
+func (m *Manager) AssignProblemSetTemplate(ctx context.Context, familyId string, templateId string, memberIds []string) error {
+	err := m.validateTemplateMembers(ctx, familyId, memberIds)
+	if err != nil {
+		return err
+	}
+	return m.updateTemplate(
+		ctx,
+		familyId,
+		templateId,
+		shpankids.AuditActionProblemSetTemplateAssign,
+		func(dbTemplate *problemset.DbProblemSetTemplate) ([]string, error) {
+			unassigned := functional.FilterSlice(dbTemplate.MemberIds, func(memberId string) bool {
+				return !slices.Contains(memberIds, memberId)
+			})
+			dbTemplate.MemberIds = memberIds
+			return unassigned, nil
+		},
+	)
+}
+
+// DeleteProblemSetTemplate deletes the template and the copies of all of its members
+func (m *Manager) DeleteProblemSetTemplate(ctx context.Context, familyId string, templateId string) error {
+	return m.updateTemplate(
+		ctx,
+		familyId,
+		templateId,
+		shpankids.AuditActionProblemSetTemplateDelete,
+		func(dbTemplate *problemset.DbProblemSetTemplate) ([]string, error) {
+			dbTemplate.Status = shpankids.FamilyAssignmentStatusDeleted
+			dbTemplate.StatusDate = time.Now()
+			return nil, nil
+		},
+	)
+}
+
+// updateTemplate updates the template and syncs the member copies, updateFunc returns the members unassigned from it
+func (m *Manager) updateTemplate(
+	ctx context.Context,
+	familyId string,
+	templateId string,
+	auditAction shpankids.AuditAction,
+	updateFunc func(dbTemplate *problemset.DbProblemSetTemplate) ([]string, error),
+) error {
+	_, err := m.getFamilyAsAdmin(ctx, familyId, "manage problem set templates")
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		tRepo, err := newProblemSetTemplatesRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		dbTemplate, err := findActiveTemplate(ctx, tRepo, templateId)
+		if err != nil {
+			return err
+		}
+		before := *dbTemplate
+		unassigned, err := updateFunc(dbTemplate)
+		if err != nil {
+			return err
+		}
+		err = tRepo.Set(ctx, templateId, *dbTemplate)
+		if err != nil {
+			return err
+		}
+		err = syncTemplateCopies(ctx, tx, familyId, templateId, unassigned)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(ctx, tx, familyId, auditAction, problemSetTemplateAuditTarget(templateId), before, *dbTemplate)
+	})
+}
+
+func (m *Manager) ListProblemSetTemplates(ctx context.Context, familyId string) shpanstream.Stream[shpankids.ProblemSetTemplateDto] {
+	tRepo, err := newProblemSetTemplatesRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.ProblemSetTemplateDto](err)
+	}
+	return shpanstream.MapStream(
+		tRepo.Stream(ctx),
+		func(e *functional.Entry[string, problemset.DbProblemSetTemplate]) *shpankids.ProblemSetTemplateDto {
+			return &shpankids.ProblemSetTemplateDto{
+				FamilyProblemSetDto: *mapFamilyProblemSetDbToDto(&functional.Entry[string, problemset.DbProblemSet]{
+					Key:   e.Key,
+					Value: e.Value.DbProblemSet,
+				}),
+				MemberIds: e.Value.MemberIds,
+			}
+		},
+	)
+}
+
+func (m *Manager) ListProblemsForTemplate(ctx context.Context, familyId string, templateId string) shpanstream.Stream[shpankids.FamilyProblemDto] {
+	repo, err := newTemplateProblemsRepository(ctx, m.kvs, familyId, templateId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.FamilyProblemDto](err)
+	}
+	return sortedByProblemOrder(ctx, shpanstream.MapStream(repo.Stream(ctx), mapFamilyProblemDbToDto))
+}
+
+func (m *Manager) CreateProblemsInTemplate(
+	ctx context.Context,
+	familyId string,
+	templateId string,
+	problems []shpankids.CreateProblemDto,
+) error {
+	for _, p := range problems {
+		err := validateCreateProblem(p)
+		if err != nil {
+			return err
+		}
+	}
+	return m.updateTemplateProblems(
+		ctx,
+		familyId,
+		templateId,
+		shpankids.AuditActionTemplateProblemsCreate,
+		func(ctx context.Context, repo familyProblemsRepository, templateProblems map[string]problemset.DbProblem) (any, any, error) {
+			// New problems are placed after all the existing ones
+			lastOrder := 0
+			for _, p := range templateProblems {
+				lastOrder = max(lastOrder, p.Order)
+			}
+			createdTime := time.Now()
+			createdProblems := make(map[string]problemset.DbProblem, len(problems))
+			for idx, p := range problems {
+				problemId := uuid.NewString()
+				createdProblems[problemId] = toDbProblem(p, createdTime, lastOrder+idx+1)
+				err := repo.Set(ctx, problemId, createdProblems[problemId])
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			return nil, createdProblems, nil
+		},
+	)
+}
+
+func (m *Manager) UpdateTemplateProblem(
+	ctx context.Context,
+	familyId string,
+	templateId string,
+	problemId string,
+	problem shpankids.CreateProblemDto,
+) error {
+	err := validateCreateProblem(problem)
+	if err != nil {
+		return err
+	}
+	return m.updateTemplateProblems(
+		ctx,
+		familyId,
+		templateId,
+		shpankids.AuditActionTemplateProblemUpdate,
+		func(ctx context.Context, repo familyProblemsRepository, templateProblems map[string]problemset.DbProblem) (any, any, error) {
+			dbP, ok := templateProblems[problemId]
+			if !ok {
+				return nil, nil, util.NotFoundError(fmt.Errorf("problem %s not found in template %s", problemId, templateId))
+			}
+			updated := toDbProblem(problem, dbP.Created, dbP.Order)
+			return dbP, updated, repo.Set(ctx, problemId, updated)
+		},
+	)
+}
+
+// DeleteTemplateProblem removes the problem from the template and from all the member copies
+func (m *Manager) DeleteTemplateProblem(ctx context.Context, familyId string, templateId string, problemId string) error {
+	return m.updateTemplateProblems(
+		ctx,
+		familyId,
+		templateId,
+		shpankids.AuditActionTemplateProblemDelete,
+		func(ctx context.Context, repo familyProblemsRepository, templateProblems map[string]problemset.DbProblem) (any, any, error) {
+			dbP, ok := templateProblems[problemId]
+			if !ok {
+				return nil, nil, util.NotFoundError(fmt.Errorf("problem %s not found in template %s", problemId, templateId))
+			}
+			return dbP, nil, repo.Unset(ctx, problemId)
+		},
+	)
+}
+
+// ReorderTemplateProblems sets the order of the template problems, all of them must be listed
+func (m *Manager) ReorderTemplateProblems(ctx context.Context, familyId string, templateId string, problemIds []string) error {
+	return m.updateTemplateProblems(
+		ctx,
+		familyId,
+		templateId,
+		shpankids.AuditActionTemplateProblemsReorder,
+		func(ctx context.Context, repo familyProblemsRepository, templateProblems map[string]problemset.DbProblem) (any, any, error) {
+			err := validateAllProblemsListed(problemIds, templateProblems)
+			if err != nil {
+				return nil, nil, err
+			}
+			before := map[string]int{}
+			after := map[string]int{}
+			for idx, problemId := range problemIds {
+				dbP := templateProblems[problemId]
+				before[problemId] = dbP.Order
+				dbP.Order = idx + 1
+				after[problemId] = dbP.Order
+				err = repo.Set(ctx, problemId, dbP)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			return before, after, nil
+		},
+	)
+}
+
+// updateTemplateProblems changes the template problems and syncs the member copies,
+// updateFunc returns the before and after values recorded in the audit log
+func (m *Manager) updateTemplateProblems(
+	ctx context.Context,
+	familyId string,
+	templateId string,
+	auditAction shpankids.AuditAction,
+	updateFunc func(
+		ctx context.Context,
+		repo familyProblemsRepository,
+		templateProblems map[string]problemset.DbProblem,
+	) (any, any, error),
+) error {
+	_, err := m.getFamilyAsAdmin(ctx, familyId, "manage problem set templates")
+	if err != nil {
+		return err
+	}
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		tRepo, err := newProblemSetTemplatesRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		_, err = findActiveTemplate(ctx, tRepo, templateId)
+		if err != nil {
+			return err
+		}
+		repo, err := newTemplateProblemsRepository(ctx, tx, familyId, templateId)
+		if err != nil {
+			return err
+		}
+		templateProblems, err := collectProblemsById(ctx, repo.Stream(ctx))
+		if err != nil {
+			return err
+		}
+		before, after, err := updateFunc(ctx, repo, templateProblems)
+		if err != nil {
+			return err
+		}
+		err = syncTemplateCopies(ctx, tx, familyId, templateId, nil)
+		if err != nil {
+			return err
+		}
+		return m.recordAudit(ctx, tx, familyId, auditAction, problemSetTemplateAuditTarget(templateId), before, after)
+	})
+}
+
+func findActiveTemplate(
+	ctx context.Context,
+	tRepo familyProblemSetTemplatesRepository,
+	templateId string,
+) (*problemset.DbProblemSetTemplate, error) {
+	dbTemplate, err := tRepo.Find(ctx, templateId)
+	if err != nil {
+		return nil, err
+	}
+	if dbTemplate == nil || dbTemplate.Status == shpankids.FamilyAssignmentStatusDeleted {
+		return nil, util.NotFoundError(fmt.Errorf("problem set template %s not found", templateId))
+	}
+	return dbTemplate, nil
+}
+
+// syncTemplateCopies brings the copies of the template members up to date with the template,
+// the copies of the unassigned members are archived
+func syncTemplateCopies(
+	ctx context.Context,
+	tx kvstore.RawJsonStore,
+	familyId string,
+	templateId string,
+	unassignedMemberIds []string,
+) error {
+	tRepo, err := newProblemSetTemplatesRepository(ctx, tx, familyId)
+	if err != nil {
+		return err
+	}
+	dbTemplate, err := tRepo.Get(ctx, templateId)
+	if err != nil {
+		return err
+	}
+	tpRepo, err := newTemplateProblemsRepository(ctx, tx, familyId, templateId)
+	if err != nil {
+		return err
+	}
+	templateProblems, err := collectProblemsById(ctx, tpRepo.Stream(ctx))
+	if err != nil {
+		return err
+	}
+
+	for _, memberId := range dbTemplate.MemberIds {
+		err = syncTemplateCopy(ctx, tx, familyId, memberId, templateId, dbTemplate.DbProblemSet, templateProblems)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, memberId := range unassignedMemberIds {
+		unassignedCopy := dbTemplate.DbProblemSet
+		if unassignedCopy.Status == shpankids.FamilyAssignmentStatusActive {
+			unassignedCopy.Status = shpankids.FamilyAssignmentStatusArchived
+		}
+		err = syncTemplateCopy(ctx, tx, familyId, memberId, templateId, unassignedCopy, templateProblems)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncTemplateCopy updates the member copy of the template, keeping the member archived (solved) problems archived
+func syncTemplateCopy(
+	ctx context.Context,
+	tx kvstore.RawJsonStore,
+	familyId string,
+	memberId string,
+	templateId string,
+	templateSet problemset.DbProblemSet,
+	templateProblems map[string]problemset.DbProblem,
+) error {
+	psRepo, err := newProblemSetsRepository(ctx, tx, familyId, memberId)
+	if err != nil {
+		return err
+	}
+	existing, err := psRepo.Find(ctx, templateId)
+	if err != nil {
+		return err
+	}
+
+	// Problem sets of the member having the same id are not copies of the template, and must not be overwritten
+	if existing != nil && existing.TemplateId != templateId {
+		return util.DuplicateInputError(fmt.Errorf(
+			"problem set %s of %s already exists and is not a copy of template %s",
+			existing.Title,
+			memberId,
+			templateId,
+		))
+	}
+	memberCopy := templateSet
+	memberCopy.TemplateId = templateId
+	if existing != nil {
+		memberCopy.Created = existing.Created
+		memberCopy.StatusDate = existing.StatusDate
+		if existing.Status != memberCopy.Status {
+			memberCopy.StatusDate = time.Now()
+		}
+	}
+	err = psRepo.Set(ctx, templateId, memberCopy)
+	if err != nil {
+		return err
+	}
+
+	pRepo, err := newFamilyProblemsRepository(ctx, tx, familyId, memberId, templateId)
+	if err != nil {
+		return err
+	}
+	archived, err := collectProblemsById(ctx, pRepo.StreamArchived(ctx))
+	if err != nil {
+		return err
+	}
+	active, err := collectProblemsById(ctx, pRepo.Stream(ctx))
+	if err != nil {
+		return err
+	}
+
+	// Removing the problems deleted from the template
+	for problemId := range active {
+		if _, ok := templateProblems[problemId]; !ok {
+			err = deleteProblem(ctx, pRepo, problemId, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for problemId := range archived {
+		if _, ok := templateProblems[problemId]; !ok {
+			err = deleteProblem(ctx, pRepo, problemId, true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for problemId, dbP := range templateProblems {
+		archivedP, isArchived := archived[problemId]
+		dbP.AdminArchived = archivedP.AdminArchived
+		err = setProblemKeepingArchived(ctx, pRepo, problemId, dbP, isArchived)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTemplateMembers checks the logged-in user is an admin of the family, and that all members are part of it
+func (m *Manager) validateTemplateMembers(ctx context.Context, familyId string, memberIds []string) error {
+	if len(memberIds) == 0 {
+		return util.BadInputError(fmt.Errorf("at least one member is required for a problem set template"))
+	}
+	if len(functional.SliceToSet(memberIds)) != len(memberIds) {
+		return util.BadInputError(fmt.Errorf("problem set template members must not repeat"))
+	}
+	dbFam, err := m.getFamilyAsAdmin(ctx, familyId, "manage problem set templates")
+	if err != nil {
+		return err
+	}
+	for _, memberId := range memberIds {
+		if !slices.ContainsFunc(dbFam.Members, func(member dbFamilyMember) bool {
+			return member.UserId == memberId
+		}) {
+			return util.BadInputError(fmt.Errorf("member %s is not part of the family %s", memberId, dbFam.Name))
+		}
+	}
+	return nil
+}
+
+// problemSetTemplateAuditTarget identifies a problem set template in the audit log
+func problemSetTemplateAuditTarget(templateId string) string {
+	return fmt.Sprintf("templates/%s", templateId)
+}
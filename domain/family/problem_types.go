@@ -0,0 +1,180 @@
+package family
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"math"
+	"shpankids/domain/problemset"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func problemTypeOrDefault(t shpankids.ProblemType) shpankids.ProblemType {
+	if t == "" {
+		return shpankids.ProblemTypeSingleChoice
+	}
+	return t
+}
+
+// validateCreateProblem checks the problem is well-formed for its type
+func validateCreateProblem(p shpankids.CreateProblemDto) error {
+	if p.Title == "" {
+		return util.BadInputError(fmt.Errorf("title is required"))
+	}
+	answerTitles := map[string]bool{}
+	for _, a := range p.Answers {
+		if a.Title == "" {
+			return util.BadInputError(fmt.Errorf("alternative title is required"))
+		}
+		if answerTitles[normalizeShortText(a.Title)] {
+			return util.BadInputError(fmt.Errorf("answer %s appears more than once in problem %s", a.Title, p.Title))
+		}
+		answerTitles[normalizeShortText(a.Title)] = true
+	}
+	correctCount := functional.CountSliceNoErr(p.Answers, func(a shpankids.CreateProblemAnswerDto) bool {
+		return a.Correct
+	})
+
+	switch problemTypeOrDefault(p.Type) {
+	case shpankids.ProblemTypeSingleChoice:
+		if correctCount != 1 {
+			return util.BadInputError(fmt.Errorf("one and only one correct answer is required for problem %s", p.Title))
+		}
+	case shpankids.ProblemTypeMultipleSelect:
+		if len(p.Answers) < 2 {
+			return util.BadInputError(fmt.Errorf("at least two answers are required for multiple select problem %s", p.Title))
+		}
+		if correctCount == 0 {
+			return util.BadInputError(fmt.Errorf("at least one correct answer is required for problem %s", p.Title))
+		}
+	case shpankids.ProblemTypeNumeric:
+		if p.NumericAnswer == nil {
+			return util.BadInputError(fmt.Errorf("numeric answer is required for numeric problem %s", p.Title))
+		}
+		if p.NumericAnswer.Tolerance < 0 {
+			return util.BadInputError(fmt.Errorf("numeric answer tolerance must not be negative for problem %s", p.Title))
+		}
+		if len(p.Answers) > 0 {
+			return util.BadInputError(fmt.Errorf("numeric problem %s must not have answer alternatives", p.Title))
+		}
+	case shpankids.ProblemTypeShortText:
+		if len(p.AcceptedAnswers) == 0 {
+			return util.BadInputError(fmt.Errorf("at least one accepted answer is required for short text problem %s", p.Title))
+		}
+		if slices.ContainsFunc(p.AcceptedAnswers, func(a string) bool {
+			return normalizeShortText(a) == ""
+		}) {
+			return util.BadInputError(fmt.Errorf("accepted answers must not be blank for problem %s", p.Title))
+		}
+		if len(p.Answers) > 0 {
+			return util.BadInputError(fmt.Errorf("short text problem %s must not have answer alternatives", p.Title))
+		}
+	case shpankids.ProblemTypeOrdering:
+		if len(p.Answers) < 2 {
+			return util.BadInputError(fmt.Errorf("at least two items are required for ordering problem %s", p.Title))
+		}
+	default:
+		return util.BadInputError(fmt.Errorf("invalid problem type %s for problem %s", p.Type, p.Title))
+	}
+	return nil
+}
+
+func toDbProblem(p shpankids.CreateProblemDto, created time.Time, order int) problemset.DbProblem {
+	return problemset.DbProblem{
+		Type:            problemTypeOrDefault(p.Type),
+		Title:           p.Title,
+		Description:     p.Description,
+		Created:         created,
+		Hints:           p.Hints,
+		Explanation:     p.Explanation,
+		Answers:         toDbProblemAnswers(p),
+		NumericAnswer:   toDbNumericAnswer(p.NumericAnswer),
+		AcceptedAnswers: p.AcceptedAnswers,
+		Order:           order,
+	}
+}
+
+func toDbProblemAnswers(p shpankids.CreateProblemDto) map[string]problemset.DbProblemAnswer {
+	dbAnswers := make(map[string]problemset.DbProblemAnswer, len(p.Answers))
+	for idx, a := range p.Answers {
+		if problemTypeOrDefault(p.Type) == shpankids.ProblemTypeOrdering {
+			// Random ids, so the ids don't give away the correct order
+			dbAnswers[uuid.NewString()[:8]] = problemset.DbProblemAnswer{
+				Title:       a.Title,
+				Description: a.Description,
+				Order:       idx + 1,
+			}
+		} else {
+			dbAnswers[fmt.Sprintf("%d", idx)] = problemset.DbProblemAnswer{
+				Title:       a.Title,
+				Description: a.Description,
+				Correct:     a.Correct,
+			}
+		}
+	}
+	return dbAnswers
+}
+
+// gradeProblemAnswer checks the submitted answer against the problem, according to the problem type
+func gradeProblemAnswer(problemId string, p problemset.DbProblem, submission shpankids.ProblemAnswerSubmissionDto) (bool, error) {
+	for _, aId := range submission.AnswerIds {
+		if _, ok := p.Answers[aId]; !ok {
+			return false, util.BadInputError(fmt.Errorf("answer %s not found for problem %s", aId, problemId))
+		}
+	}
+
+	switch problemTypeOrDefault(p.Type) {
+	case shpankids.ProblemTypeSingleChoice:
+		if len(submission.AnswerIds) != 1 {
+			return false, util.BadInputError(fmt.Errorf("exactly one answer is required for problem %s", problemId))
+		}
+		return p.Answers[submission.AnswerIds[0]].Correct, nil
+	case shpankids.ProblemTypeMultipleSelect:
+		selected := functional.SliceToSet(submission.AnswerIds)
+		for aId, a := range p.Answers {
+			if selected[aId] != a.Correct {
+				return false, nil
+			}
+		}
+		return true, nil
+	case shpankids.ProblemTypeNumeric:
+		if p.NumericAnswer == nil {
+			return false, fmt.Errorf("no numeric answer found for problem %s", problemId)
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(submission.Value), 64)
+		if err != nil {
+			return false, util.BadInputError(fmt.Errorf("a number is required as the answer for problem %s", problemId))
+		}
+		return math.Abs(v-p.NumericAnswer.Value) <= p.NumericAnswer.Tolerance, nil
+	case shpankids.ProblemTypeShortText:
+		v := normalizeShortText(submission.Value)
+		if v == "" {
+			return false, util.BadInputError(fmt.Errorf("an answer is required for problem %s", problemId))
+		}
+		return slices.ContainsFunc(p.AcceptedAnswers, func(a string) bool {
+			return normalizeShortText(a) == v
+		}), nil
+	case shpankids.ProblemTypeOrdering:
+		if len(submission.AnswerIds) != len(p.Answers) {
+			return false, util.BadInputError(fmt.Errorf("all %d items must be ordered for problem %s", len(p.Answers), problemId))
+		}
+		for idx, aId := range submission.AnswerIds {
+			if p.Answers[aId].Order != idx+1 {
+				return false, nil
+			}
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported problem type %s for problem %s", p.Type, problemId)
+	}
+}
+
+// normalizeShortText makes short text answers comparable, ignoring case and extra whitespace
+func normalizeShortText(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
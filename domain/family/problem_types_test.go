@@ -0,0 +1,349 @@
+package family
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"shpankids/domain/problemset"
+	"shpankids/shpankids"
+)
+
+func TestGradeProblemAnswer(t *testing.T) {
+	singleChoice := problemset.DbProblem{
+		Type: shpankids.ProblemTypeSingleChoice,
+		Answers: map[string]problemset.DbProblemAnswer{
+			"0": {Title: "3"},
+			"1": {Title: "4", Correct: true},
+		},
+	}
+	multipleSelect := problemset.DbProblem{
+		Type: shpankids.ProblemTypeMultipleSelect,
+		Answers: map[string]problemset.DbProblemAnswer{
+			"0": {Title: "2", Correct: true},
+			"1": {Title: "3", Correct: true},
+			"2": {Title: "4"},
+		},
+	}
+	numeric := problemset.DbProblem{
+		Type:          shpankids.ProblemTypeNumeric,
+		NumericAnswer: &problemset.DbNumericAnswer{Value: 3.14, Tolerance: 0.01},
+	}
+	shortText := problemset.DbProblem{
+		Type:            shpankids.ProblemTypeShortText,
+		AcceptedAnswers: []string{"New York", "NYC"},
+	}
+	ordering := problemset.DbProblem{
+		Type: shpankids.ProblemTypeOrdering,
+		Answers: map[string]problemset.DbProblemAnswer{
+			"a": {Title: "one", Order: 1},
+			"b": {Title: "two", Order: 2},
+			"c": {Title: "three", Order: 3},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		problem    problemset.DbProblem
+		submission shpankids.ProblemAnswerSubmissionDto
+		want       bool
+		wantErr    string
+	}{
+		{
+			name:       "single choice correct",
+			problem:    singleChoice,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"1"}},
+			want:       true,
+		},
+		{
+			name:       "single choice wrong",
+			problem:    singleChoice,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"0"}},
+			want:       false,
+		},
+		{
+			name:       "single choice defaults the type",
+			problem:    problemset.DbProblem{Answers: singleChoice.Answers},
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"1"}},
+			want:       true,
+		},
+		{
+			name:       "single choice with two answers",
+			problem:    singleChoice,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"0", "1"}},
+			wantErr:    "exactly one answer is required for problem p1",
+		},
+		{
+			name:       "unknown answer",
+			problem:    singleChoice,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"7"}},
+			wantErr:    "answer 7 not found for problem p1",
+		},
+		{
+			name:       "multiple select all correct picks",
+			problem:    multipleSelect,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"1", "0"}},
+			want:       true,
+		},
+		{
+			name:       "multiple select partial picks",
+			problem:    multipleSelect,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"0"}},
+			want:       false,
+		},
+		{
+			name:       "multiple select extra pick",
+			problem:    multipleSelect,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"0", "1", "2"}},
+			want:       false,
+		},
+		{
+			name:       "multiple select no picks",
+			problem:    multipleSelect,
+			submission: shpankids.ProblemAnswerSubmissionDto{},
+			want:       false,
+		},
+		{
+			name:       "numeric exact",
+			problem:    numeric,
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: "3.14"},
+			want:       true,
+		},
+		{
+			name:       "numeric within tolerance",
+			problem:    numeric,
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: " 3.145 "},
+			want:       true,
+		},
+		{
+			name:       "numeric out of tolerance",
+			problem:    numeric,
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: "3.16"},
+			want:       false,
+		},
+		{
+			name: "numeric without tolerance",
+			problem: problemset.DbProblem{
+				Type:          shpankids.ProblemTypeNumeric,
+				NumericAnswer: &problemset.DbNumericAnswer{Value: 12},
+			},
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: "12.0"},
+			want:       true,
+		},
+		{
+			name:       "numeric not a number",
+			problem:    numeric,
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: "pi"},
+			wantErr:    "a number is required as the answer for problem p1",
+		},
+		{
+			name:       "short text exact",
+			problem:    shortText,
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: "NYC"},
+			want:       true,
+		},
+		{
+			name:       "short text ignores case and whitespace",
+			problem:    shortText,
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: "  new   YORK "},
+			want:       true,
+		},
+		{
+			name:       "short text wrong",
+			problem:    shortText,
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: "Boston"},
+			want:       false,
+		},
+		{
+			name:       "short text blank",
+			problem:    shortText,
+			submission: shpankids.ProblemAnswerSubmissionDto{Value: "   "},
+			wantErr:    "an answer is required for problem p1",
+		},
+		{
+			name:       "ordering correct",
+			problem:    ordering,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"a", "b", "c"}},
+			want:       true,
+		},
+		{
+			name:       "ordering wrong",
+			problem:    ordering,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"b", "a", "c"}},
+			want:       false,
+		},
+		{
+			name:       "ordering missing items",
+			problem:    ordering,
+			submission: shpankids.ProblemAnswerSubmissionDto{AnswerIds: []string{"a", "b"}},
+			wantErr:    "all 3 items must be ordered for problem p1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			correct, err := gradeProblemAnswer("p1", tt.problem, tt.submission)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, correct)
+		})
+	}
+}
+
+func TestValidateCreateProblem(t *testing.T) {
+	tests := []struct {
+		name    string
+		problem shpankids.CreateProblemDto
+		wantErr string
+	}{
+		{
+			name: "single choice",
+			problem: shpankids.CreateProblemDto{
+				Title:   "2+2",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "3"}, {Title: "4", Correct: true}},
+			},
+		},
+		{
+			name:    "missing title",
+			problem: shpankids.CreateProblemDto{Answers: []shpankids.CreateProblemAnswerDto{{Title: "4", Correct: true}}},
+			wantErr: "title is required",
+		},
+		{
+			name: "blank answer",
+			problem: shpankids.CreateProblemDto{
+				Title:   "2+2",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: ""}, {Title: "4", Correct: true}},
+			},
+			wantErr: "alternative title is required",
+		},
+		{
+			name: "duplicate answers",
+			problem: shpankids.CreateProblemDto{
+				Title:   "2+2",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "Four", Correct: true}, {Title: " four"}},
+			},
+			wantErr: "answer  four appears more than once in problem 2+2",
+		},
+		{
+			name: "single choice with two correct answers",
+			problem: shpankids.CreateProblemDto{
+				Title:   "2+2",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "4", Correct: true}, {Title: "four", Correct: true}},
+			},
+			wantErr: "one and only one correct answer is required for problem 2+2",
+		},
+		{
+			name: "multiple select",
+			problem: shpankids.CreateProblemDto{
+				Type:    shpankids.ProblemTypeMultipleSelect,
+				Title:   "primes",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "2", Correct: true}, {Title: "3", Correct: true}},
+			},
+		},
+		{
+			name: "multiple select with a single answer",
+			problem: shpankids.CreateProblemDto{
+				Type:    shpankids.ProblemTypeMultipleSelect,
+				Title:   "primes",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "2", Correct: true}},
+			},
+			wantErr: "at least two answers are required for multiple select problem primes",
+		},
+		{
+			name: "multiple select with no correct answer",
+			problem: shpankids.CreateProblemDto{
+				Type:    shpankids.ProblemTypeMultipleSelect,
+				Title:   "primes",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "4"}, {Title: "6"}},
+			},
+			wantErr: "at least one correct answer is required for problem primes",
+		},
+		{
+			name: "numeric",
+			problem: shpankids.CreateProblemDto{
+				Type:          shpankids.ProblemTypeNumeric,
+				Title:         "pi",
+				NumericAnswer: &shpankids.NumericAnswerDto{Value: 3.14, Tolerance: 0.01},
+			},
+		},
+		{
+			name:    "numeric without answer",
+			problem: shpankids.CreateProblemDto{Type: shpankids.ProblemTypeNumeric, Title: "pi"},
+			wantErr: "numeric answer is required for numeric problem pi",
+		},
+		{
+			name: "numeric with negative tolerance",
+			problem: shpankids.CreateProblemDto{
+				Type:          shpankids.ProblemTypeNumeric,
+				Title:         "pi",
+				NumericAnswer: &shpankids.NumericAnswerDto{Value: 3.14, Tolerance: -1},
+			},
+			wantErr: "numeric answer tolerance must not be negative for problem pi",
+		},
+		{
+			name: "numeric with alternatives",
+			problem: shpankids.CreateProblemDto{
+				Type:          shpankids.ProblemTypeNumeric,
+				Title:         "pi",
+				NumericAnswer: &shpankids.NumericAnswerDto{Value: 3.14},
+				Answers:       []shpankids.CreateProblemAnswerDto{{Title: "3.14", Correct: true}},
+			},
+			wantErr: "numeric problem pi must not have answer alternatives",
+		},
+		{
+			name: "short text",
+			problem: shpankids.CreateProblemDto{
+				Type:            shpankids.ProblemTypeShortText,
+				Title:           "capital",
+				AcceptedAnswers: []string{"Paris"},
+			},
+		},
+		{
+			name:    "short text without accepted answers",
+			problem: shpankids.CreateProblemDto{Type: shpankids.ProblemTypeShortText, Title: "capital"},
+			wantErr: "at least one accepted answer is required for short text problem capital",
+		},
+		{
+			name: "short text with a blank accepted answer",
+			problem: shpankids.CreateProblemDto{
+				Type:            shpankids.ProblemTypeShortText,
+				Title:           "capital",
+				AcceptedAnswers: []string{"Paris", "  "},
+			},
+			wantErr: "accepted answers must not be blank for problem capital",
+		},
+		{
+			name: "ordering",
+			problem: shpankids.CreateProblemDto{
+				Type:    shpankids.ProblemTypeOrdering,
+				Title:   "sort",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "1"}, {Title: "2"}},
+			},
+		},
+		{
+			name: "ordering with a single item",
+			problem: shpankids.CreateProblemDto{
+				Type:    shpankids.ProblemTypeOrdering,
+				Title:   "sort",
+				Answers: []shpankids.CreateProblemAnswerDto{{Title: "1"}},
+			},
+			wantErr: "at least two items are required for ordering problem sort",
+		},
+		{
+			name:    "unknown type",
+			problem: shpankids.CreateProblemDto{Type: "essay", Title: "why"},
+			wantErr: "invalid problem type essay for problem why",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCreateProblem(tt.problem)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
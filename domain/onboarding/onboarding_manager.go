@@ -0,0 +1,154 @@
+package onboarding
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"log/slog"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"strings"
+	"time"
+)
+
+type manager struct {
+	userSessionManager shpankids.UserSessionManager
+	userManager        shpankids.UserManager
+	familyManager      shpankids.FamilyManager
+	sessionManager     shpankids.SessionManager
+}
+
+func NewOnboardingManager(
+	userSessionManager shpankids.UserSessionManager,
+	userManager shpankids.UserManager,
+	familyManager shpankids.FamilyManager,
+	sessionManager shpankids.SessionManager,
+) shpankids.OnboardingManager {
+	return &manager{
+		userSessionManager: userSessionManager,
+		userManager:        userManager,
+		familyManager:      familyManager,
+		sessionManager:     sessionManager,
+	}
+}
+
+func (m *manager) OnLogin(ctx context.Context) error {
+	userId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitations, err := m.familyManager.ListUserInvitations(ctx, *userId).CollectFilterNil(ctx)
+	if err != nil {
+		return err
+	}
+	if len(invitations) == 0 {
+		return nil
+	}
+
+	usr, err := m.userManager.FindUser(ctx, *userId)
+	if err != nil {
+		return err
+	}
+	if usr == nil {
+		firstName, lastName := userNamesFromInvitation(*userId, invitations[0])
+		err = m.userManager.CreateUser(ctx, *userId, firstName, lastName, time.Time{})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, inv := range invitations {
+		err = m.familyManager.AcceptFamilyInvitation(ctx, inv.FamilyId)
+		if err != nil {
+			return err
+		}
+		slog.Info(fmt.Sprintf("User %s joined family %s, invited by %s", *userId, inv.FamilyId, inv.InvitedBy))
+	}
+
+	s, err := m.sessionManager.Find(ctx, *userId)
+	if err != nil {
+		return err
+	}
+	if s != nil {
+		return nil
+	}
+
+	// New users start with the time zone of whoever invited them, until they pick their own
+	location := time.UTC
+	inviterSession, err := m.sessionManager.Find(ctx, invitations[0].InvitedBy)
+	if err != nil {
+		return err
+	}
+	if inviterSession != nil {
+		location = inviterSession.Location
+	}
+	return m.sessionManager.Set(ctx, *userId, shpankids.Session{
+		FamilyId: invitations[0].FamilyId,
+		Location: location,
+	})
+}
+
+func (m *manager) CreateOwnFamily(
+	ctx context.Context,
+	familyName string,
+	location *time.Location,
+	firstName string,
+	lastName string,
+) (string, error) {
+	if familyName == "" {
+		return "", util.BadInputError(fmt.Errorf("family name is required"))
+	}
+	if location == nil {
+		return "", util.BadInputError(fmt.Errorf("time zone is required"))
+	}
+
+	userId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	usr, err := m.userManager.FindUser(ctx, *userId)
+	if err != nil {
+		return "", err
+	}
+	if usr == nil {
+		if firstName == "" {
+			firstName = firstNameFromEmail(*userId)
+		}
+		err = m.userManager.CreateUser(ctx, *userId, firstName, lastName, time.Time{})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// The logged-in user becomes the family admin, users already in other families keep their membership and can
+	// switch back to them
+	familyId := uuid.NewString()
+	err = m.familyManager.CreateFamily(ctx, familyId, familyName, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	slog.Info(fmt.Sprintf("User %s created family %s (%s)", *userId, familyName, familyId))
+
+	err = m.sessionManager.Set(ctx, *userId, shpankids.Session{
+		FamilyId: familyId,
+		Location: location,
+	})
+	if err != nil {
+		return "", err
+	}
+	return familyId, nil
+}
+
+func userNamesFromInvitation(email string, inv shpankids.FamilyInvitationDto) (string, string) {
+	if inv.FirstName != "" {
+		return inv.FirstName, inv.LastName
+	}
+	return firstNameFromEmail(email), inv.LastName
+}
+
+// firstNameFromEmail is used as the first name of new users, until they set their own
+func firstNameFromEmail(email string) string {
+	return strings.Split(email, "@")[0]
+}
@@ -0,0 +1,380 @@
+package points
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/database/kvstore"
+	"shpankids/infra/shpanstream"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"shpankids/shpankids"
+	"time"
+)
+
+type manager struct {
+	kvs                kvstore.RawJsonStore
+	userSessionManager shpankids.UserSessionManager
+	familyManager      shpankids.FamilyManager
+	sessionManager     shpankids.SessionManager
+}
+
+func NewPointsManager(
+	kvs kvstore.RawJsonStore,
+	userSessionManager shpankids.UserSessionManager,
+	familyManager shpankids.FamilyManager,
+	sessionManager shpankids.SessionManager,
+) shpankids.PointsManager {
+	return &manager{
+		kvs:                kvs,
+		userSessionManager: userSessionManager,
+		familyManager:      familyManager,
+		sessionManager:     sessionManager,
+	}
+}
+
+// getCallerRole returns the logged-in user and its role in the family, failing for non family members
+func (m *manager) getCallerRole(ctx context.Context, familyId string) (string, shpankids.Role, error) {
+	userId, err := m.userSessionManager(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	f, err := m.familyManager.GetFamily(ctx, familyId)
+	if err != nil {
+		return "", "", err
+	}
+	fm := functional.FindFirst(f.Members, func(member shpankids.FamilyMemberDto) bool {
+		return member.UserId == *userId
+	})
+	if fm == nil {
+		return "", "", util.ForbiddenError(fmt.Errorf("user %s is not a member of family %s", *userId, f.Name))
+	}
+	return *userId, fm.Role, nil
+}
+
+func (m *manager) checkAdmin(ctx context.Context, familyId string, action string) (string, error) {
+	callerId, role, err := m.getCallerRole(ctx, familyId)
+	if err != nil {
+		return "", err
+	}
+	if role != shpankids.RoleAdmin {
+		return "", util.ForbiddenError(fmt.Errorf("only admin can %s for family %s", action, familyId))
+	}
+	return callerId, nil
+}
+
+// checkSelfOrAdmin makes sure users only access their own points, unless they are family admins
+func (m *manager) checkSelfOrAdmin(ctx context.Context, familyId string, userId string) error {
+	callerId, role, err := m.getCallerRole(ctx, familyId)
+	if err != nil {
+		return err
+	}
+	if callerId != userId && role != shpankids.RoleAdmin {
+		return util.ForbiddenError(fmt.Errorf("only admin can view points of other family members"))
+	}
+	return nil
+}
+
+func (m *manager) GetBalance(ctx context.Context, familyId string, userId string) (*shpankids.PointsBalanceDto, error) {
+	err := m.checkSelfOrAdmin(ctx, familyId, userId)
+	if err != nil {
+		return nil, err
+	}
+	return m.calcBalance(ctx, m.kvs, familyId, userId)
+}
+
+func (m *manager) calcBalance(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+) (*shpankids.PointsBalanceDto, error) {
+	ledgerRepo, err := NewLedgerRepository(ctx, kvs, familyId, userId)
+	if err != nil {
+		return nil, err
+	}
+	ret := &shpankids.PointsBalanceDto{UserId: userId}
+	err = ledgerRepo.Stream(ctx).Consume(ctx, func(e *datekvs.DatedRecord[functional.Entry[string, DbPointsEntry]]) {
+		ret.Balance += e.Value.Value.Points
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	redemptionsRepo, err := newRedemptionsRepository(ctx, kvs, familyId)
+	if err != nil {
+		return nil, err
+	}
+	err = redemptionsRepo.Stream(ctx).Consume(ctx, func(e *functional.Entry[string, dbRedemption]) {
+		if e.Value.UserId == userId && e.Value.Status == shpankids.RedemptionStatusPending {
+			ret.Reserved += e.Value.Cost
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (m *manager) ListLedger(
+	ctx context.Context,
+	familyId string,
+	userId string,
+	from datekvs.Date,
+	to datekvs.Date,
+) shpanstream.Stream[shpankids.PointsEntryDto] {
+	err := m.checkSelfOrAdmin(ctx, familyId, userId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.PointsEntryDto](err)
+	}
+	ledgerRepo, err := NewLedgerRepository(ctx, m.kvs, familyId, userId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.PointsEntryDto](err)
+	}
+	return shpanstream.MapStream(
+		ledgerRepo.StreamRange(ctx, from, to.AddDay()),
+		func(e *datekvs.DatedRecord[functional.Entry[string, DbPointsEntry]]) *shpankids.PointsEntryDto {
+			return &shpankids.PointsEntryDto{
+				EntryId: e.Value.Key,
+				ForDate: e.Date,
+				Points:  e.Value.Value.Points,
+				Reason:  e.Value.Value.Reason,
+				Created: e.Value.Value.Created,
+			}
+		},
+	)
+}
+
+func (m *manager) CreateReward(ctx context.Context, familyId string, reward shpankids.RewardDto) error {
+	if reward.RewardId == "" {
+		return util.BadInputError(fmt.Errorf("reward id is required"))
+	}
+	if reward.Title == "" {
+		return util.BadInputError(fmt.Errorf("title is required"))
+	}
+	if reward.Cost <= 0 {
+		return util.BadInputError(fmt.Errorf("reward cost must be positive"))
+	}
+	_, err := m.checkAdmin(ctx, familyId, "create rewards")
+	if err != nil {
+		return err
+	}
+	repo, err := newRewardsRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	return repo.Set(ctx, reward.RewardId, dbReward{
+		Title:       reward.Title,
+		Description: reward.Description,
+		Cost:        reward.Cost,
+		Created:     now,
+		Status:      shpankids.FamilyAssignmentStatusActive,
+		StatusDate:  now,
+	})
+}
+
+func (m *manager) DeleteReward(ctx context.Context, familyId string, rewardId string) error {
+	_, err := m.checkAdmin(ctx, familyId, "delete rewards")
+	if err != nil {
+		return err
+	}
+	repo, err := newRewardsRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return err
+	}
+	r, err := repo.Get(ctx, rewardId)
+	if err != nil {
+		return err
+	}
+	r.Status = shpankids.FamilyAssignmentStatusDeleted
+	r.StatusDate = time.Now()
+	return repo.Set(ctx, rewardId, r)
+}
+
+func (m *manager) ListRewards(ctx context.Context, familyId string) shpanstream.Stream[shpankids.RewardDto] {
+	_, _, err := m.getCallerRole(ctx, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.RewardDto](err)
+	}
+	repo, err := newRewardsRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.RewardDto](err)
+	}
+	return shpanstream.MapStreamWhileFiltering(
+		repo.Stream(ctx),
+		func(e *functional.Entry[string, dbReward]) *shpankids.RewardDto {
+			if e.Value.Status != shpankids.FamilyAssignmentStatusActive {
+				return nil
+			}
+			return &shpankids.RewardDto{
+				RewardId:    e.Key,
+				Title:       e.Value.Title,
+				Description: e.Value.Description,
+				Cost:        e.Value.Cost,
+				Status:      e.Value.Status,
+				Created:     e.Value.Created,
+			}
+		},
+	)
+}
+
+func (m *manager) RequestRedemption(ctx context.Context, familyId string, rewardId string) (string, error) {
+	userId, _, err := m.getCallerRole(ctx, familyId)
+	if err != nil {
+		return "", err
+	}
+	rewardsRepo, err := newRewardsRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return "", err
+	}
+	r, err := rewardsRepo.Get(ctx, rewardId)
+	if err != nil {
+		return "", err
+	}
+	if r.Status != shpankids.FamilyAssignmentStatusActive {
+		return "", util.BadInputError(fmt.Errorf("reward %s is no longer available", r.Title))
+	}
+
+	redemptionId := uuid.NewString()
+	err = m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		balance, err := m.calcBalance(ctx, tx, familyId, userId)
+		if err != nil {
+			return err
+		}
+		if balance.Balance-balance.Reserved < r.Cost {
+			return util.BadInputError(fmt.Errorf(
+				"not enough points for %s, %d points are required and only %d are available",
+				r.Title,
+				r.Cost,
+				balance.Balance-balance.Reserved,
+			))
+		}
+		redemptionsRepo, err := newRedemptionsRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		return redemptionsRepo.Set(ctx, redemptionId, dbRedemption{
+			UserId:      userId,
+			RewardId:    rewardId,
+			RewardTitle: r.Title,
+			Cost:        r.Cost,
+			Status:      shpankids.RedemptionStatusPending,
+			Requested:   time.Now(),
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+	return redemptionId, nil
+}
+
+func (m *manager) ListRedemptions(
+	ctx context.Context,
+	familyId string,
+	status *shpankids.RedemptionStatus,
+) shpanstream.Stream[shpankids.RedemptionDto] {
+	callerId, role, err := m.getCallerRole(ctx, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.RedemptionDto](err)
+	}
+	repo, err := newRedemptionsRepository(ctx, m.kvs, familyId)
+	if err != nil {
+		return shpanstream.NewErrorStream[shpankids.RedemptionDto](err)
+	}
+
+	// Admins see the redemptions of the whole family, members only their own
+	return shpanstream.MapStreamWhileFiltering(
+		repo.Stream(ctx),
+		func(e *functional.Entry[string, dbRedemption]) *shpankids.RedemptionDto {
+			if role != shpankids.RoleAdmin && e.Value.UserId != callerId {
+				return nil
+			}
+			if status != nil && e.Value.Status != *status {
+				return nil
+			}
+			return &shpankids.RedemptionDto{
+				RedemptionId: e.Key,
+				UserId:       e.Value.UserId,
+				RewardId:     e.Value.RewardId,
+				RewardTitle:  e.Value.RewardTitle,
+				Cost:         e.Value.Cost,
+				Status:       e.Value.Status,
+				Requested:    e.Value.Requested,
+				DecidedBy:    e.Value.DecidedBy,
+				DecidedAt:    e.Value.DecidedAt,
+				Comment:      e.Value.Comment,
+			}
+		},
+	)
+}
+
+func (m *manager) DecideRedemption(
+	ctx context.Context,
+	familyId string,
+	redemptionId string,
+	approve bool,
+	comment string,
+) error {
+	callerId, err := m.checkAdmin(ctx, familyId, "decide on reward redemptions")
+	if err != nil {
+		return err
+	}
+	s, err := m.sessionManager.Get(ctx, callerId)
+	if err != nil {
+		return err
+	}
+
+	return m.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		repo, err := newRedemptionsRepository(ctx, tx, familyId)
+		if err != nil {
+			return err
+		}
+		rd, err := repo.Get(ctx, redemptionId)
+		if err != nil {
+			return err
+		}
+		if rd.Status != shpankids.RedemptionStatusPending {
+			return util.BadInputError(fmt.Errorf("redemption of %s was already %s", rd.RewardTitle, rd.Status))
+		}
+
+		now := time.Now()
+		rd.DecidedBy = callerId
+		rd.DecidedAt = now
+		rd.Comment = comment
+		if !approve {
+			rd.Status = shpankids.RedemptionStatusRejected
+			return repo.Set(ctx, redemptionId, rd)
+		}
+
+		balance, err := m.calcBalance(ctx, tx, familyId, rd.UserId)
+		if err != nil {
+			return err
+		}
+		if balance.Balance < rd.Cost {
+			return util.BadInputError(fmt.Errorf(
+				"not enough points for %s, %d points are required and only %d are available",
+				rd.RewardTitle,
+				rd.Cost,
+				balance.Balance,
+			))
+		}
+		rd.Status = shpankids.RedemptionStatusApproved
+		err = repo.Set(ctx, redemptionId, rd)
+		if err != nil {
+			return err
+		}
+
+		// Spending the points
+		ledgerRepo, err := NewLedgerRepository(ctx, tx, familyId, rd.UserId)
+		if err != nil {
+			return err
+		}
+		return ledgerRepo.Set(ctx, datekvs.TodayDate(s.Location), redemptionEntryKey(redemptionId), DbPointsEntry{
+			Points:  -rd.Cost,
+			Reason:  fmt.Sprintf("Redeemed %s", rd.RewardTitle),
+			Created: now,
+		})
+	})
+}
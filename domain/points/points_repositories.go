@@ -0,0 +1,104 @@
+package points
+
+import (
+	"context"
+	"fmt"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/database/kvstore"
+	"shpankids/shpankids"
+	"time"
+)
+
+const familiesSpaceStoreUri = "families"
+
+// DbPointsEntry is a ledger entry, stored by the date the points were earned (or spent) on
+type DbPointsEntry struct {
+	Points  int       `json:"points"`
+	Reason  string    `json:"reason"`
+	Created time.Time `json:"created"`
+}
+
+type LedgerRepository datekvs.DateKvStore[DbPointsEntry]
+
+// NewLedgerRepository returns the points ledger of a user in a family
+func NewLedgerRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	familyId string,
+	userId string,
+) (LedgerRepository, error) {
+	ledgerStore, err := kvs.CreateSpaceStore(ctx, []string{
+		familiesSpaceStoreUri,
+		familyId,
+		"points",
+		userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return datekvs.NewDateKvsImpl[DbPointsEntry](ledgerStore), nil
+}
+
+// TaskEntryKey is the ledger key for completing a task, there is at most one per task per day
+func TaskEntryKey(taskId string) string {
+	return fmt.Sprintf("task-%s", taskId)
+}
+
+// ProblemEntryKey is the ledger key for correctly solving a problem
+func ProblemEntryKey(problemSetId string, problemId string) string {
+	return fmt.Sprintf("problem-%s-%s", problemSetId, problemId)
+}
+
+func redemptionEntryKey(redemptionId string) string {
+	return fmt.Sprintf("redemption-%s", redemptionId)
+}
+
+type dbReward struct {
+	Title       string                           `json:"title"`
+	Description string                           `json:"description"`
+	Cost        int                              `json:"cost"`
+	Created     time.Time                        `json:"created"`
+	Status      shpankids.FamilyAssignmentStatus `json:"status"`
+	StatusDate  time.Time                        `json:"statusDate"`
+}
+
+type dbRedemption struct {
+	UserId      string                     `json:"userId"`
+	RewardId    string                     `json:"rewardId"`
+	RewardTitle string                     `json:"rewardTitle"`
+	Cost        int                        `json:"cost"`
+	Status      shpankids.RedemptionStatus `json:"status"`
+	Requested   time.Time                  `json:"requested"`
+	DecidedBy   string                     `json:"decidedBy,omitempty"`
+	DecidedAt   time.Time                  `json:"decidedAt,omitempty"`
+	Comment     string                     `json:"comment,omitempty"`
+}
+
+type rewardsRepository kvstore.JsonKvStore[string, dbReward]
+type redemptionsRepository kvstore.JsonKvStore[string, dbRedemption]
+
+func newRewardsRepository(ctx context.Context, kvs kvstore.RawJsonStore, familyId string) (rewardsRepository, error) {
+	familyStore, err := kvs.CreateSpaceStore(ctx, []string{familiesSpaceStoreUri, familyId})
+	if err != nil {
+		return nil, err
+	}
+	return kvstore.NewJsonKvStoreImpl[string, dbReward](
+		familyStore,
+		"rewards",
+		kvstore.StringKeyToString,
+		kvstore.StringToKey,
+	), nil
+}
+
+func newRedemptionsRepository(ctx context.Context, kvs kvstore.RawJsonStore, familyId string) (redemptionsRepository, error) {
+	familyStore, err := kvs.CreateSpaceStore(ctx, []string{familiesSpaceStoreUri, familyId})
+	if err != nil {
+		return nil, err
+	}
+	return kvstore.NewJsonKvStoreImpl[string, dbRedemption](
+		familyStore,
+		"rewardRedemptions",
+		kvstore.StringKeyToString,
+		kvstore.StringToKey,
+	), nil
+}
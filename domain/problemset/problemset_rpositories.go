@@ -13,7 +13,9 @@ const problemSetsRepoUri = "problemSets"
 
 type ProblemsRepository archkvs.ArchivedKvs[string, DbProblem]
 type ProblemSetsRepository kvstore.JsonKvStore[string, DbProblemSet]
+type ProblemSetTemplatesRepository kvstore.JsonKvStore[string, DbProblemSetTemplate]
 type ProblemSolutionsRepository datekvs.DateKvStore[DbProblemSolution]
+type ProblemHintRevealsRepository kvstore.JsonKvStore[string, DbProblemHintReveal]
 
 func NewProblemSetProblemsRepository(
 	ctx context.Context,
@@ -58,6 +60,25 @@ func NewProblemsSolutionsRepository(
 	return datekvs.NewDateKvsImpl[DbProblemSolution](psStore), nil
 }
 
+// NewProblemHintRevealsRepository keeps the hints revealed per problem until the problem is answered, next to the
+// problem set solutions
+func NewProblemHintRevealsRepository(
+	ctx context.Context,
+	kvs kvstore.RawJsonStore,
+	problemSetId string,
+) (ProblemHintRevealsRepository, error) {
+	psStore, err := createRootProblemSetStore(ctx, kvs, problemSetId)
+	if err != nil {
+		return nil, err
+	}
+	return kvstore.NewJsonKvStoreImpl[string, DbProblemHintReveal](
+		psStore,
+		"hintReveals",
+		kvstore.StringKeyToString,
+		kvstore.StringToKey,
+	), nil
+}
+
 func NewProblemSetsRepository(
 	kvs kvstore.RawJsonStore,
 ) (ProblemSetsRepository, error) {
@@ -69,30 +90,96 @@ func NewProblemSetsRepository(
 	), nil
 }
 
+// NewProblemSetTemplatesRepository keeps problem set templates, their problems are kept like the problems of a problem set
+func NewProblemSetTemplatesRepository(
+	kvs kvstore.RawJsonStore,
+) (ProblemSetTemplatesRepository, error) {
+	return kvstore.NewJsonKvStoreImpl[string, DbProblemSetTemplate](
+		kvs,
+		problemSetsRepoUri,
+		kvstore.StringKeyToString,
+		kvstore.StringToKey,
+	), nil
+}
+
 type DbProblemSet struct {
 	Title       string                           `json:"title"`
 	Description string                           `json:"description"`
 	Created     time.Time                        `json:"created"`
 	Status      shpankids.FamilyAssignmentStatus `json:"status"`
 	StatusDate  time.Time                        `json:"statusDate"`
+	Points      int                              `json:"points,omitempty"`
+	DailyQuota  int                              `json:"dailyQuota,omitempty"`
+	QuotaType   shpankids.ProblemSetQuotaType    `json:"quotaType,omitempty"`
+	MaxAttempts int                              `json:"maxAttempts,omitempty"`
+
+	// TemplateId is set for a member copy of a problem set template, the copy is only changed through the template
+	TemplateId string `json:"templateId,omitempty"`
+}
+
+// DbProblemSetTemplate is a problem set shared by several family members, each member solves a copy of it
+type DbProblemSetTemplate struct {
+	DbProblemSet
+	MemberIds []string `json:"memberIds"`
 }
 
 type DbProblem struct {
-	Title       string                     `json:"title"`
-	Description string                     `json:"description"`
-	Created     time.Time                  `json:"created"`
-	Hints       []string                   `json:"hints,omitempty"`
-	Explanation string                     `json:"explanation,omitempty"`
-	Answers     map[string]DbProblemAnswer `json:"answers"`
+	Type            shpankids.ProblemType      `json:"type,omitempty"`
+	Title           string                     `json:"title"`
+	Description     string                     `json:"description"`
+	Created         time.Time                  `json:"created"`
+	Hints           []string                   `json:"hints,omitempty"`
+	Explanation     string                     `json:"explanation,omitempty"`
+	Answers         map[string]DbProblemAnswer `json:"answers"`
+	NumericAnswer   *DbNumericAnswer           `json:"numericAnswer,omitempty"`
+	AcceptedAnswers []string                   `json:"acceptedAnswers,omitempty"`
+
+	// Order is the position of the problem in the set, problems are presented by it
+	Order int `json:"order,omitempty"`
+
+	// AdminArchived marks a problem archived by an admin. Solved problems are archived as well, until they are due for
+	// review, but problems archived by an admin are not presented for review
+	AdminArchived bool `json:"adminArchived,omitempty"`
 }
 
 type DbProblemAnswer struct {
 	Title       string `json:"title"`
 	Description string `json:"description,omitempty"`
 	Correct     bool   `json:"correct,omitempty"`
+
+	// Order is the 1 based position of the answer in the correct order of ordering problems
+	Order int `json:"order,omitempty"`
+}
+
+type DbNumericAnswer struct {
+	Value     float64 `json:"value"`
+	Tolerance float64 `json:"tolerance,omitempty"`
 }
 
 type DbProblemSolution struct {
-	SelectedAnswerId string `json:"selectedAnswerId"`
-	Correct          bool   `json:"correct"`
+	SelectedAnswerId  string   `json:"selectedAnswerId"`
+	SelectedAnswerIds []string `json:"selectedAnswerIds,omitempty"`
+	Value             string   `json:"value,omitempty"`
+	Correct           bool     `json:"correct"`
+
+	// HintsUsed is the number of hints revealed before the answer was submitted
+	HintsUsed int `json:"hintsUsed,omitempty"`
+
+	// Attempts holds every answer submitted, the fields above reflect the last one.
+	// InProgress is set while the answer is wrong and more attempts are allowed
+	Attempts   []DbProblemAttempt `json:"attempts,omitempty"`
+	InProgress bool               `json:"inProgress,omitempty"`
+}
+
+type DbProblemAttempt struct {
+	SelectedAnswerIds []string  `json:"selectedAnswerIds,omitempty"`
+	Value             string    `json:"value,omitempty"`
+	Correct           bool      `json:"correct"`
+	HintsUsed         int       `json:"hintsUsed,omitempty"`
+	Time              time.Time `json:"time"`
+}
+
+type DbProblemHintReveal struct {
+	RevealedCount int       `json:"revealedCount"`
+	LastRevealed  time.Time `json:"lastRevealed"`
 }
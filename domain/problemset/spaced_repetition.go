@@ -0,0 +1,51 @@
+package problemset
+
+import (
+	"shpankids/infra/database/datekvs"
+	"slices"
+)
+
+// leitnerBoxIntervalDays is the number of days until a problem in each Leitner box is presented again.
+// A correct answer moves the problem to the next box and a wrong answer moves it back to the first one,
+// a correct answer with the help of hints keeps the problem in its box.
+// A problem answered correctly while in the last box is mastered and is not presented again.
+var leitnerBoxIntervalDays = []int{1, 2, 4, 8, 16}
+
+// LeitnerBox returns the box (starting from 1) a problem is in according to its solutions history,
+// 0 when the problem was never solved and len(leitnerBoxIntervalDays)+1 once it is mastered
+func LeitnerBox(history []datekvs.DatedRecord[DbProblemSolution]) int {
+	history = sortedCompletedByDate(history)
+	box := 0
+	for _, h := range history {
+		if h.Value.Correct && h.Value.HintsUsed > 0 {
+			box = max(box, 1)
+		} else if h.Value.Correct {
+			box = min(max(box, 1)+1, len(leitnerBoxIntervalDays)+1)
+		} else {
+			box = 1
+		}
+	}
+	return box
+}
+
+// NextReviewDate returns the date a problem is due to be presented again according to its solutions history,
+// nil when the problem was never solved or was already mastered
+func NextReviewDate(history []datekvs.DatedRecord[DbProblemSolution]) *datekvs.Date {
+	box := LeitnerBox(history)
+	if box == 0 || box > len(leitnerBoxIntervalDays) {
+		return nil
+	}
+	completed := sortedCompletedByDate(history)
+	lastSolved := completed[len(completed)-1].Date
+	return datekvs.NewDateFromTime(lastSolved.AddDate(0, 0, leitnerBoxIntervalDays[box-1]))
+}
+
+// sortedCompletedByDate sorts the history, leaving out solutions still in progress (wrong, with attempts left)
+func sortedCompletedByDate(history []datekvs.DatedRecord[DbProblemSolution]) []datekvs.DatedRecord[DbProblemSolution] {
+	completed := slices.DeleteFunc(slices.Clone(history), func(h datekvs.DatedRecord[DbProblemSolution]) bool {
+		return h.Value.InProgress
+	})
+	return slices.SortedFunc(slices.Values(completed), func(a, b datekvs.DatedRecord[DbProblemSolution]) int {
+		return a.Date.Compare(b.Date.Time)
+	})
+}
@@ -0,0 +1,133 @@
+package problemset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"shpankids/infra/database/datekvs"
+)
+
+func TestLeitnerBoxAndNextReviewDate(t *testing.T) {
+	correct := DbProblemSolution{Correct: true}
+	wrong := DbProblemSolution{}
+	correctWithHints := DbProblemSolution{Correct: true, HintsUsed: 1}
+	inProgress := DbProblemSolution{InProgress: true}
+
+	// solved returns the solutions history, one solution a day starting on 2026-10-01
+	solved := func(solutions ...DbProblemSolution) []datekvs.DatedRecord[DbProblemSolution] {
+		ret := make([]datekvs.DatedRecord[DbProblemSolution], 0, len(solutions))
+		for idx, s := range solutions {
+			ret = append(ret, datekvs.DatedRecord[DbProblemSolution]{
+				Date:  datekvs.NewDate(2026, 10, 1+idx),
+				Value: s,
+			})
+		}
+		return ret
+	}
+	date := func(day int) *datekvs.Date {
+		d := datekvs.NewDate(2026, 10, day)
+		return &d
+	}
+
+	tests := []struct {
+		name           string
+		history        []datekvs.DatedRecord[DbProblemSolution]
+		wantBox        int
+		wantNextReview *datekvs.Date
+	}{
+		{
+			name:    "never solved",
+			wantBox: 0,
+		},
+		{
+			name:    "only in progress",
+			history: solved(inProgress),
+			wantBox: 0,
+		},
+		{
+			name:           "wrong",
+			history:        solved(wrong),
+			wantBox:        1,
+			wantNextReview: date(2),
+		},
+		{
+			name:           "correct",
+			history:        solved(correct),
+			wantBox:        2,
+			wantNextReview: date(3),
+		},
+		{
+			name:           "correct with hints",
+			history:        solved(correctWithHints),
+			wantBox:        1,
+			wantNextReview: date(2),
+		},
+		{
+			name:           "correct with hints keeps the box",
+			history:        solved(correct, correct, correctWithHints),
+			wantBox:        3,
+			wantNextReview: date(7),
+		},
+		{
+			name:           "wrong moves back to the first box",
+			history:        solved(correct, correct, correct, wrong),
+			wantBox:        1,
+			wantNextReview: date(5),
+		},
+		{
+			name:           "correct after wrong",
+			history:        solved(wrong, correct),
+			wantBox:        2,
+			wantNextReview: date(4),
+		},
+		{
+			name:           "third box",
+			history:        solved(correct, correct),
+			wantBox:        3,
+			wantNextReview: date(6),
+		},
+		{
+			name:           "fourth box",
+			history:        solved(correct, correct, correct),
+			wantBox:        4,
+			wantNextReview: date(11),
+		},
+		{
+			name:           "last box",
+			history:        solved(correct, correct, correct, correct),
+			wantBox:        5,
+			wantNextReview: date(20),
+		},
+		{
+			name:    "graduates after the last box",
+			history: solved(correct, correct, correct, correct, correct),
+			wantBox: 6,
+		},
+		{
+			name:    "mastered stays mastered",
+			history: solved(correct, correct, correct, correct, correct, correct),
+			wantBox: 6,
+		},
+		{
+			name:           "in progress solutions are ignored",
+			history:        solved(correct, inProgress),
+			wantBox:        2,
+			wantNextReview: date(3),
+		},
+		{
+			name: "history is sorted by date",
+			history: []datekvs.DatedRecord[DbProblemSolution]{
+				{Date: datekvs.NewDate(2026, 10, 5), Value: correct},
+				{Date: datekvs.NewDate(2026, 10, 1), Value: wrong},
+			},
+			wantBox:        2,
+			wantNextReview: date(7),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantBox, LeitnerBox(tt.history))
+			require.Equal(t, tt.wantNextReview, NextReviewDate(tt.history))
+		})
+	}
+}
@@ -38,6 +38,17 @@ func (m *manager) Get(ctx context.Context, email string) (*shpankids.Session, er
 
 }
 
+func (m *manager) Find(ctx context.Context, email string) (*shpankids.Session, error) {
+	dbS, err := m.repository.Find(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if dbS == nil {
+		return nil, nil
+	}
+	return mapSession(*dbS)
+}
+
 func mapSession(s dbSession) (*shpankids.Session, error) {
 	l, err := time.LoadLocation(s.TimeZone)
 	if err != nil {
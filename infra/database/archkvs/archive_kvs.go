@@ -2,8 +2,6 @@ package archkvs
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
 	"shpankids/infra/database/kvstore"
 	"shpankids/infra/shpanstream"
 	"shpankids/infra/util/functional"
@@ -23,6 +21,10 @@ type ArchivedKvs[K comparable, V any] interface {
 type ArchivedKvsImpl[K comparable, V any] struct {
 	*kvstore.JsonKvStoreImpl[K, V]
 	archivedStore *kvstore.JsonKvStoreImpl[K, V]
+	kvs           kvstore.RawJsonStore
+	namespace     string
+	keyToStrFunc  func(K) string
+	strToKeyFunc  func(string) (K, error)
 }
 
 func NewArchivedKvsImpl[K comparable, V any](
@@ -39,29 +41,37 @@ func NewArchivedKvsImpl[K comparable, V any](
 	return &ArchivedKvsImpl[K, V]{
 		JsonKvStoreImpl: kvstore.NewJsonKvStoreImpl[K, V](kvs, namespace, keyToStrFunc, strToKeyFunc),
 		archivedStore:   kvstore.NewJsonKvStoreImpl[K, V](archivedKvs, namespace, keyToStrFunc, strToKeyFunc),
+		kvs:             kvs,
+		namespace:       namespace,
+		keyToStrFunc:    keyToStrFunc,
+		strToKeyFunc:    strToKeyFunc,
 	}, nil
 
 }
 
+// inTx runs f with a copy of the store bound to a transaction of the underlying kvs
+func (a ArchivedKvsImpl[K, V]) inTx(ctx context.Context, f func(ctx context.Context, txStore *ArchivedKvsImpl[K, V]) error) error {
+	return a.kvs.RunInTx(ctx, func(ctx context.Context, tx kvstore.RawJsonStore) error {
+		txStore, err := NewArchivedKvsImpl[K, V](ctx, tx, a.namespace, a.keyToStrFunc, a.strToKeyFunc)
+		if err != nil {
+			return err
+		}
+		return f(ctx, txStore)
+	})
+}
+
 func (a ArchivedKvsImpl[K, V]) Archive(ctx context.Context, key K) error {
-	activeValue, err := a.Get(ctx, key)
-	if err != nil {
-		return err
-	}
-	err = a.archivedStore.Set(ctx, key, activeValue)
-	if err != nil {
-		return err
-	}
-	err = a.Unset(ctx, key)
-	if err != nil {
-		// try to rollback
-		rollbackErr := a.archivedStore.Unset(ctx, key)
-		if rollbackErr != nil {
-			slog.Error(fmt.Sprintf("Failed to rollback archive operation %v", rollbackErr))
+	return a.inTx(ctx, func(ctx context.Context, txStore *ArchivedKvsImpl[K, V]) error {
+		activeValue, err := txStore.Get(ctx, key)
+		if err != nil {
+			return err
 		}
-		return err
-	}
-	return nil
+		err = txStore.archivedStore.Set(ctx, key, activeValue)
+		if err != nil {
+			return err
+		}
+		return txStore.Unset(ctx, key)
+	})
 }
 
 func (a ArchivedKvsImpl[K, V]) StreamIncludingArchived(ctx context.Context) shpanstream.Stream[functional.Entry[K, V]] {
@@ -73,24 +83,17 @@ func (a ArchivedKvsImpl[K, V]) StreamArchived(ctx context.Context) shpanstream.S
 }
 
 func (a ArchivedKvsImpl[K, V]) UnArchive(ctx context.Context, key K) error {
-	archivedValue, err := a.archivedStore.Get(ctx, key)
-	if err != nil {
-		return err
-	}
-	err = a.Set(ctx, key, archivedValue)
-	if err != nil {
-		return err
-	}
-	err = a.archivedStore.Unset(ctx, key)
-	if err != nil {
-		// try to rollback
-		rollbackErr := a.Unset(ctx, key)
-		if rollbackErr != nil {
-			slog.Error(fmt.Sprintf("Failed to rollback unarchive operation %v", rollbackErr))
+	return a.inTx(ctx, func(ctx context.Context, txStore *ArchivedKvsImpl[K, V]) error {
+		archivedValue, err := txStore.archivedStore.Get(ctx, key)
+		if err != nil {
+			return err
 		}
-		return err
-	}
-	return nil
+		err = txStore.Set(ctx, key, archivedValue)
+		if err != nil {
+			return err
+		}
+		return txStore.archivedStore.Unset(ctx, key)
+	})
 }
 
 func (a ArchivedKvsImpl[K, V]) GetIncludingArchived(ctx context.Context, key K) (V, error) {
@@ -18,6 +18,9 @@ import (
 type kvsImpl struct {
 	client         *firestore.Client
 	parentDocument *firestore.DocumentRef
+
+	// tx is set when the store is used within a firestore transaction
+	tx *firestore.Transaction
 }
 
 func (kvs *kvsImpl) StreamAllNamespaces(ctx context.Context) shpanstream.Stream[string] {
@@ -46,6 +49,7 @@ func (kvs *kvsImpl) StreamAllJson(
 ) shpanstream.Stream[functional.Entry[string, json.RawMessage]] {
 	return shpanstream.NewStream[functional.Entry[string, json.RawMessage]](&fsStreamProvider{
 		colRef: kvs.getCollectionRef(namespace),
+		tx:     kvs.tx,
 	})
 }
 
@@ -56,6 +60,33 @@ func NewFirestoreKvs(client *firestore.Client, parentDocument *firestore.Documen
 	}
 }
 
+func (kvs *kvsImpl) RunInTx(ctx context.Context, f func(ctx context.Context, tx kvstore.RawJsonStore) error) error {
+	return kvs.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		// Firestore requires all reads to happen before any write, so writes are buffered until f is done and then
+		// written through tx, which commits them atomically
+		return kvstore.RunBufferedTx(ctx, &kvsImpl{
+			client:         kvs.client,
+			parentDocument: kvs.parentDocument,
+			tx:             tx,
+		}, f)
+	})
+}
+
+func (kvs *kvsImpl) getDoc(ctx context.Context, docRef *firestore.DocumentRef) (*firestore.DocumentSnapshot, error) {
+	if kvs.tx != nil {
+		return kvs.tx.Get(docRef)
+	}
+	return docRef.Get(ctx)
+}
+
+func (kvs *kvsImpl) deleteDoc(ctx context.Context, docRef *firestore.DocumentRef) error {
+	if kvs.tx != nil {
+		return kvs.tx.Delete(docRef)
+	}
+	_, err := docRef.Delete(ctx)
+	return err
+}
+
 func (kvs *kvsImpl) getCollectionRef(namespace string) *firestore.CollectionRef {
 	if kvs.parentDocument == nil {
 		return kvs.client.Collection(namespace)
@@ -82,7 +113,11 @@ func (kvs *kvsImpl) CreateSpaceStore(_ context.Context, spaceHierarchy []string)
 			documentRef = documentRef.Collection(colId).Doc(docId)
 		}
 	}
-	return NewFirestoreKvs(kvs.client, documentRef), nil
+	return &kvsImpl{
+		client:         kvs.client,
+		parentDocument: documentRef,
+		tx:             kvs.tx,
+	}, nil
 
 }
 
@@ -92,23 +127,25 @@ func (kvs *kvsImpl) SetJSON(ctx context.Context, namespace, key string, rawJson
 	if err != nil {
 		return err
 	}
-	_, err = kvs.getCollectionRef(namespace).Doc(key).Set(ctx, toFirestore)
+	docRef := kvs.getCollectionRef(namespace).Doc(key)
+	if kvs.tx != nil {
+		return kvs.tx.Set(docRef, toFirestore)
+	}
+	_, err = docRef.Set(ctx, toFirestore)
 	return err
 }
 
 func (kvs *kvsImpl) UnSetJSON(ctx context.Context, namespace, key string) error {
 	//todo: should theoretically return error if key does not exist, but who cares
-	_, err := kvs.getCollectionRef(namespace).Doc(key).Delete(ctx)
-	return err
+	return kvs.deleteDoc(ctx, kvs.getCollectionRef(namespace).Doc(key))
 }
 
 func (kvs *kvsImpl) UnSetJSONIfExist(ctx context.Context, namespace, key string) error {
-	_, err := kvs.getCollectionRef(namespace).Doc(key).Delete(ctx)
-	return err
+	return kvs.deleteDoc(ctx, kvs.getCollectionRef(namespace).Doc(key))
 }
 
 func (kvs *kvsImpl) GetJSON(ctx context.Context, namespace, key string) (json.RawMessage, error) {
-	docSnapshot, err := kvs.getCollectionRef(namespace).Doc(key).Get(ctx)
+	docSnapshot, err := kvs.getDoc(ctx, kvs.getCollectionRef(namespace).Doc(key))
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +158,7 @@ func (kvs *kvsImpl) GetJSON(ctx context.Context, namespace, key string) (json.Ra
 }
 
 func (kvs *kvsImpl) GetJSONIfExist(ctx context.Context, namespace, key string) (*json.RawMessage, error) {
-	docSnapshot, err := kvs.getCollectionRef(namespace).Doc(key).Get(ctx)
+	docSnapshot, err := kvs.getDoc(ctx, kvs.getCollectionRef(namespace).Doc(key))
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return nil, nil
@@ -138,7 +175,7 @@ func (kvs *kvsImpl) GetJSONIfExist(ctx context.Context, namespace, key string) (
 }
 
 func (kvs *kvsImpl) ListAllJSON(ctx context.Context, namespace string) (map[string]json.RawMessage, error) {
-	allDocs := kvs.getCollectionRef(namespace).Documents(ctx)
+	allDocs := kvs.getDocuments(ctx, kvs.getCollectionRef(namespace))
 	defer allDocs.Stop()
 	result := make(map[string]json.RawMessage)
 	for {
@@ -158,15 +195,27 @@ func (kvs *kvsImpl) ListAllJSON(ctx context.Context, namespace string) (map[stri
 	return result, nil
 }
 
+func (kvs *kvsImpl) getDocuments(ctx context.Context, colRef *firestore.CollectionRef) *firestore.DocumentIterator {
+	if kvs.tx != nil {
+		return kvs.tx.Documents(colRef)
+	}
+	return colRef.Documents(ctx)
+}
+
 type fsStreamProvider struct {
 	colRef  *firestore.CollectionRef
+	tx      *firestore.Transaction
 	docIter *firestore.DocumentIterator
 	//started time.Time
 }
 
 func (f *fsStreamProvider) Open(ctx context.Context) error {
 	//f.started = time.Now()
-	f.docIter = f.colRef.Documents(ctx)
+	if f.tx != nil {
+		f.docIter = f.tx.Documents(f.colRef)
+	} else {
+		f.docIter = f.colRef.Documents(ctx)
+	}
 	return nil
 }
 
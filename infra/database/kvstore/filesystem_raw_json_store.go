@@ -20,6 +20,9 @@ type FileSystemRawJsonStore struct {
 	rootDir        string
 	filenamePrefix string
 	mu             sync.RWMutex
+
+	// txMu makes transactions wait for each other, it is shared by all the spaces of the same root store
+	txMu *sync.Mutex
 }
 
 func (s *FileSystemRawJsonStore) StreamAllNamespaces(_ context.Context) shpanstream.Stream[string] {
@@ -47,7 +50,16 @@ func (s *FileSystemRawJsonStore) StreamAllJson(_ context.Context, namespace stri
 }
 
 func (s *FileSystemRawJsonStore) CreateSpaceStore(_ context.Context, spaceHierarchy []string) (RawJsonStore, error) {
-	return NewFileSystemRawJsonStore(filepath.Join(append([]string{s.rootDir}, spaceHierarchy...)...))
+	spaceStore, err := NewFileSystemRawJsonStore(filepath.Join(append([]string{s.rootDir}, spaceHierarchy...)...))
+	if err != nil {
+		return nil, err
+	}
+	spaceStore.txMu = s.txMu
+	return spaceStore, nil
+}
+
+func (s *FileSystemRawJsonStore) RunInTx(ctx context.Context, f func(ctx context.Context, tx RawJsonStore) error) error {
+	return runSerializedTx(ctx, s, s.txMu, f)
 }
 
 func (s *FileSystemRawJsonStore) UnSetJSON(_ context.Context, namespace, key string) error {
@@ -92,7 +104,7 @@ func NewFileSystemRawJsonStore(rootDir string) (*FileSystemRawJsonStore, error)
 	if err != nil {
 		return nil, err
 	}
-	return &FileSystemRawJsonStore{rootDir: rootDir, filenamePrefix: "key-"}, nil
+	return &FileSystemRawJsonStore{rootDir: rootDir, filenamePrefix: "key-", txMu: &sync.Mutex{}}, nil
 }
 
 // SetJSON stores JSON data with a given key and namespace
@@ -15,6 +15,9 @@ type InMemoryRawJsonStore struct {
 	mu                sync.RWMutex
 	store             map[string]map[string]json.RawMessage
 	spacesBySpaceName map[string]*InMemoryRawJsonStore
+
+	// txMu makes transactions wait for each other, it is shared by all the spaces of the same root store
+	txMu *sync.Mutex
 }
 
 func (s *InMemoryRawJsonStore) StreamAllNamespaces(_ context.Context) shpanstream.Stream[string] {
@@ -46,7 +49,7 @@ func (s *InMemoryRawJsonStore) CreateSpaceStore(_ context.Context, spaceHierarch
 	currInMemoryStore := s
 	for _, spaceName := range spaceHierarchy {
 		if currInMemoryStore.spacesBySpaceName[spaceName] == nil {
-			currInMemoryStore.spacesBySpaceName[spaceName] = NewInMemoryRawJsonStore()
+			currInMemoryStore.spacesBySpaceName[spaceName] = newInMemoryRawJsonStore(s.txMu)
 		}
 		currInMemoryStore = currInMemoryStore.spacesBySpaceName[spaceName]
 	}
@@ -81,12 +84,21 @@ func (s *InMemoryRawJsonStore) UnSetJSONIfExist(_ context.Context, namespace, ke
 
 // NewInMemoryRawJsonStore creates a new InMemoryRawJsonStore
 func NewInMemoryRawJsonStore() *InMemoryRawJsonStore {
+	return newInMemoryRawJsonStore(&sync.Mutex{})
+}
+
+func newInMemoryRawJsonStore(txMu *sync.Mutex) *InMemoryRawJsonStore {
 	return &InMemoryRawJsonStore{
 		store:             make(map[string]map[string]json.RawMessage),
 		spacesBySpaceName: map[string]*InMemoryRawJsonStore{},
+		txMu:              txMu,
 	}
 }
 
+func (s *InMemoryRawJsonStore) RunInTx(ctx context.Context, f func(ctx context.Context, tx RawJsonStore) error) error {
+	return runSerializedTx(ctx, s, s.txMu, f)
+}
+
 // SetJSON stores JSON data with a given key and namespace
 func (s *InMemoryRawJsonStore) SetJSON(_ context.Context, namespace, key string, rawJson json.RawMessage) error {
 	s.mu.Lock()
@@ -33,5 +33,9 @@ type RawJsonStore interface {
 
 	StreamAllNamespaces(ctx context.Context) shpanstream.Stream[string]
 
-	//RunInTx(ctx context.Context, f func(ctx context.Context, tx RawJsonStore) error) error
+	// RunInTx runs f within a transaction, writes done via tx are applied only if f succeeds. Calling RunInTx on tx
+	// joins the running transaction. f may be run more than once, e.g. when firestore retries a conflicting
+	// transaction, so it must not change state outside of it. The local stores only make transactions wait for each
+	// other, see runSerializedTx
+	RunInTx(ctx context.Context, f func(ctx context.Context, tx RawJsonStore) error) error
 }
@@ -0,0 +1,266 @@
+package kvstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"shpankids/infra/shpanstream"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
+	"strings"
+	"sync"
+)
+
+// txSpaceSeparator is used to build the key identifying a space store within a transaction
+const txSpaceSeparator = "/"
+
+type txKey struct {
+	space     string
+	namespace string
+	key       string
+}
+
+type txState struct {
+	// staged holds the latest value written in the transaction for each key, nil marks a removed key
+	staged map[txKey]*json.RawMessage
+
+	// touched keeps the order in which keys were first written, and the store they should be applied to
+	touched []txKey
+	stores  map[string]RawJsonStore
+}
+
+// txRawJsonStore is a RawJsonStore view that buffers all writes until the transaction is committed,
+// reads are served from the buffered writes first and then from the underlying store
+type txRawJsonStore struct {
+	base  RawJsonStore
+	space string
+	state *txState
+}
+
+// RunBufferedTx runs f with a transactional view of base. Writes done via the view are buffered, so reads within the
+// transaction see them, and are applied to base in order once f returns successfully. base is expected to apply the
+// writes atomically, e.g. a store bound to a firestore transaction, which also requires all reads to happen before
+// any write
+func RunBufferedTx(
+	ctx context.Context,
+	base RawJsonStore,
+	f func(ctx context.Context, tx RawJsonStore) error,
+) error {
+	state := newTxState(base)
+	err := f(ctx, &txRawJsonStore{base: base, state: state})
+	if err != nil {
+		return err
+	}
+	return state.apply(ctx)
+}
+
+// heldTxLockKey marks the context of a transaction running while holding the transactions lock of a local store
+type heldTxLockKey struct {
+	txMu *sync.Mutex
+}
+
+// runSerializedTx is the transaction of the local stores, which have no transactions of their own. It only makes
+// transactions wait for each other on txMu: writes done outside of transactions may still land in the middle of one,
+// reads are not checked for conflicts, and if applying the writes fails midway the already applied writes are
+// reverted on a best effort basis. txMu is not reentrant, so calling RunInTx on the store rather than on tx within
+// the transaction fails instead of waiting for itself
+func runSerializedTx(
+	ctx context.Context,
+	base RawJsonStore,
+	txMu *sync.Mutex,
+	f func(ctx context.Context, tx RawJsonStore) error,
+) error {
+	lockKey := heldTxLockKey{txMu: txMu}
+	if ctx.Value(lockKey) != nil {
+		return fmt.Errorf("RunInTx was called on the store within a running transaction, use the transaction store instead")
+	}
+	txMu.Lock()
+	defer txMu.Unlock()
+
+	ctx = context.WithValue(ctx, lockKey, true)
+	state := newTxState(base)
+	err := f(ctx, &txRawJsonStore{base: base, state: state})
+	if err != nil {
+		return err
+	}
+	return state.applyOrRevert(ctx)
+}
+
+func newTxState(base RawJsonStore) *txState {
+	return &txState{
+		staged: map[txKey]*json.RawMessage{},
+		stores: map[string]RawJsonStore{"": base},
+	}
+}
+
+func (s *txState) stage(space string, namespace string, key string, value *json.RawMessage) {
+	k := txKey{space: space, namespace: namespace, key: key}
+	if _, ok := s.staged[k]; !ok {
+		s.touched = append(s.touched, k)
+	}
+	s.staged[k] = value
+}
+
+func (s *txState) apply(ctx context.Context) error {
+	for _, k := range s.touched {
+		err := applyTxValue(ctx, s.stores[k.space], k, s.staged[k])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *txState) applyOrRevert(ctx context.Context) error {
+	// Read all previous values first, so the applied writes can be reverted
+	prevValues := make([]*json.RawMessage, len(s.touched))
+	for i, k := range s.touched {
+		prev, err := s.stores[k.space].GetJSONIfExist(ctx, k.namespace, k.key)
+		if err != nil {
+			return err
+		}
+		prevValues[i] = prev
+	}
+
+	for i, k := range s.touched {
+		err := applyTxValue(ctx, s.stores[k.space], k, s.staged[k])
+		if err != nil {
+			// Revert whatever was applied so far
+			for j := i - 1; j >= 0; j-- {
+				revertErr := applyTxValue(ctx, s.stores[s.touched[j].space], s.touched[j], prevValues[j])
+				if revertErr != nil {
+					slog.Error(fmt.Sprintf("Failed to revert transaction write for %s:%s %v", s.touched[j].namespace, s.touched[j].key, revertErr))
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func applyTxValue(ctx context.Context, store RawJsonStore, k txKey, value *json.RawMessage) error {
+	if value == nil {
+		return store.UnSetJSONIfExist(ctx, k.namespace, k.key)
+	}
+	return store.SetJSON(ctx, k.namespace, k.key, *value)
+}
+
+func (t *txRawJsonStore) stagedNamespace(namespace string) map[string]*json.RawMessage {
+	ret := map[string]*json.RawMessage{}
+	for k, v := range t.state.staged {
+		if k.space == t.space && k.namespace == namespace {
+			ret[k.key] = v
+		}
+	}
+	return ret
+}
+
+func (t *txRawJsonStore) CreateSpaceStore(ctx context.Context, spaceHierarchy []string) (RawJsonStore, error) {
+	subStore, err := t.base.CreateSpaceStore(ctx, spaceHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	subSpace := strings.Join(append([]string{t.space}, spaceHierarchy...), txSpaceSeparator)
+	if _, ok := t.state.stores[subSpace]; !ok {
+		t.state.stores[subSpace] = subStore
+	}
+	return &txRawJsonStore{base: t.state.stores[subSpace], space: subSpace, state: t.state}, nil
+}
+
+func (t *txRawJsonStore) SetJSON(_ context.Context, namespace, key string, rawJson json.RawMessage) error {
+	t.state.stage(t.space, namespace, key, &rawJson)
+	return nil
+}
+
+func (t *txRawJsonStore) UnSetJSON(ctx context.Context, namespace, key string) error {
+	existing, err := t.GetJSONIfExist(ctx, namespace, key)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return util.NotFoundError(fmt.Errorf("key %s not found, on namespace %s", key, namespace))
+	}
+	t.state.stage(t.space, namespace, key, nil)
+	return nil
+}
+
+func (t *txRawJsonStore) UnSetJSONIfExist(_ context.Context, namespace, key string) error {
+	t.state.stage(t.space, namespace, key, nil)
+	return nil
+}
+
+func (t *txRawJsonStore) GetJSON(ctx context.Context, namespace, key string) (json.RawMessage, error) {
+	ret, err := t.GetJSONIfExist(ctx, namespace, key)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return nil, util.NotFoundError(fmt.Errorf("key %s not found, on namespace %s", key, namespace))
+	}
+	return *ret, nil
+}
+
+func (t *txRawJsonStore) GetJSONIfExist(ctx context.Context, namespace, key string) (*json.RawMessage, error) {
+	if staged, ok := t.state.staged[txKey{space: t.space, namespace: namespace, key: key}]; ok {
+		return staged, nil
+	}
+	return t.base.GetJSONIfExist(ctx, namespace, key)
+}
+
+func (t *txRawJsonStore) ListAllJSON(ctx context.Context, namespace string) (map[string]json.RawMessage, error) {
+	ret, err := t.base.ListAllJSON(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range t.stagedNamespace(namespace) {
+		if v == nil {
+			delete(ret, k)
+		} else {
+			ret[k] = *v
+		}
+	}
+	return ret, nil
+}
+
+func (t *txRawJsonStore) StreamAllJson(
+	ctx context.Context,
+	namespace string,
+) shpanstream.Stream[functional.Entry[string, json.RawMessage]] {
+	staged := t.stagedNamespace(namespace)
+	return shpanstream.ConcatenatedStream(
+		t.base.StreamAllJson(ctx, namespace).Filter(func(e *functional.Entry[string, json.RawMessage]) bool {
+			_, ok := staged[e.Key]
+			return !ok
+		}),
+		shpanstream.Just(functional.MapSliceWhileFilteringNoErr(
+			functional.MapKeys(staged),
+			func(k string) *functional.Entry[string, json.RawMessage] {
+				if staged[k] == nil {
+					return nil
+				}
+				return &functional.Entry[string, json.RawMessage]{Key: k, Value: *staged[k]}
+			},
+		)...),
+	)
+}
+
+func (t *txRawJsonStore) StreamAllNamespaces(ctx context.Context) shpanstream.Stream[string] {
+	stagedNamespaces := map[string]bool{}
+	for k, v := range t.state.staged {
+		if k.space == t.space && v != nil {
+			stagedNamespaces[k.namespace] = true
+		}
+	}
+	return shpanstream.ConcatenatedStream(
+		t.base.StreamAllNamespaces(ctx).Filter(func(ns *string) bool {
+			return !stagedNamespaces[*ns]
+		}),
+		shpanstream.Just(functional.MapKeys(stagedNamespaces)...),
+	)
+}
+
+// RunInTx joins the currently running transaction
+func (t *txRawJsonStore) RunInTx(ctx context.Context, f func(ctx context.Context, tx RawJsonStore) error) error {
+	return f(ctx, t)
+}
@@ -0,0 +1,77 @@
+package kvstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testRunInTx(t *testing.T, kvs RawJsonStore) {
+	ctx := context.Background()
+	require.NoError(t, kvs.SetJSON(ctx, "ns", "a", json.RawMessage(`{"v":1}`)))
+
+	// Failed transaction should not leave any trace
+	err := kvs.RunInTx(ctx, func(ctx context.Context, tx RawJsonStore) error {
+		require.NoError(t, tx.SetJSON(ctx, "ns", "b", json.RawMessage(`{"v":2}`)))
+		require.NoError(t, tx.UnSetJSON(ctx, "ns", "a"))
+
+		// Reads within the transaction see the transaction writes
+		all, err := tx.ListAllJSON(ctx, "ns")
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		require.Contains(t, all, "b")
+		return fmt.Errorf("boom")
+	})
+	require.Error(t, err)
+	all, err := kvs.ListAllJSON(ctx, "ns")
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Contains(t, all, "a")
+
+	// Successful transaction applies all writes, including writes to spaces and nested transactions
+	err = kvs.RunInTx(ctx, func(ctx context.Context, tx RawJsonStore) error {
+		require.NoError(t, tx.UnSetJSON(ctx, "ns", "a"))
+		spaceTx, err := tx.CreateSpaceStore(ctx, []string{"space", "1"})
+		require.NoError(t, err)
+		return spaceTx.RunInTx(ctx, func(ctx context.Context, nestedTx RawJsonStore) error {
+			return nestedTx.SetJSON(ctx, "ns", "c", json.RawMessage(`{"v":3}`))
+		})
+	})
+	require.NoError(t, err)
+
+	found, err := kvs.GetJSONIfExist(ctx, "ns", "a")
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	space, err := kvs.CreateSpaceStore(ctx, []string{"space", "1"})
+	require.NoError(t, err)
+	entries, err := space.StreamAllJson(ctx, "ns").Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "c", entries[0].Key)
+	require.JSONEq(t, `{"v":3}`, string(entries[0].Value))
+
+	// Running a transaction on the store, rather than on tx, within a transaction fails instead of waiting for itself
+	err = kvs.RunInTx(ctx, func(ctx context.Context, tx RawJsonStore) error {
+		return space.RunInTx(ctx, func(ctx context.Context, nestedTx RawJsonStore) error {
+			return nestedTx.SetJSON(ctx, "ns", "d", json.RawMessage(`{"v":4}`))
+		})
+	})
+	require.Error(t, err)
+	found, err = space.GetJSONIfExist(ctx, "ns", "d")
+	require.NoError(t, err)
+	require.Nil(t, found)
+}
+
+func TestInMemoryRunInTx(t *testing.T) {
+	testRunInTx(t, NewInMemoryRawJsonStore())
+}
+
+func TestFileSystemRunInTx(t *testing.T) {
+	kvs, err := NewFileSystemRawJsonStore(t.TempDir())
+	require.NoError(t, err)
+	testRunInTx(t, kvs)
+}
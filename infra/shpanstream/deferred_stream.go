@@ -0,0 +1,38 @@
+package shpanstream
+
+import (
+	"context"
+	"fmt"
+)
+
+type deferredProvider[T any] struct {
+	streamFactory func(ctx context.Context) Stream[T]
+	inner         *stream[T]
+}
+
+func (dp *deferredProvider[T]) Open(ctx context.Context) error {
+	s, ok := dp.streamFactory(ctx).(*stream[T])
+	if !ok {
+		return fmt.Errorf("failed to cast Stream to stream")
+	}
+	dp.inner = s
+	return openSubStream(ctx, dp.inner)
+}
+
+func (dp *deferredProvider[T]) Close() {
+	if dp.inner != nil {
+		closeSubStream(dp.inner)
+	}
+}
+
+func (dp *deferredProvider[T]) Emit(ctx context.Context) (*T, error) {
+	return dp.inner.provider(ctx)
+}
+
+// DeferredStream creates the stream only when it is opened, useful for concatenating a stream that depends on the
+// elements of the streams consumed before it
+func DeferredStream[T any](streamFactory func(ctx context.Context) Stream[T]) Stream[T] {
+	return NewStream[T](&deferredProvider[T]{
+		streamFactory: streamFactory,
+	})
+}
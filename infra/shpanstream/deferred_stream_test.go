@@ -0,0 +1,26 @@
+package shpanstream
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"shpankids/infra/util/functional"
+	"testing"
+)
+
+func TestDeferredStream(t *testing.T) {
+	ctx := context.Background()
+
+	// The deferred stream is created only after the first stream is consumed
+	sum := 0
+	first := MapStream(Just(1, 2, 3), func(v *int) *int {
+		sum += *v
+		return v
+	})
+	concStream := ConcatenatedStream(first, DeferredStream(func(ctx context.Context) Stream[int] {
+		return Just(sum)
+	}))
+
+	results, err := concStream.Collect(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, []int{1, 2, 3, 6}, functional.MapSliceUnPtr(results))
+}
@@ -0,0 +1,88 @@
+package shpanstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+type jsonArrayProvider[T any] struct {
+	chunks  Stream[string]
+	reader  *io.PipeReader
+	decoder *json.Decoder
+	inArray bool
+}
+
+func (jp *jsonArrayProvider[T]) Open(ctx context.Context) error {
+	// The chunks are written to a pipe by a goroutine, while the decoder reads each element once it is complete
+	pr, pw := io.Pipe()
+	jp.reader = pr
+	jp.decoder = json.NewDecoder(pr)
+	jp.inArray = false
+
+	go func() {
+		pw.CloseWithError(jp.chunks.ConsumeWithErr(ctx, func(chunk *string) error {
+			_, err := pw.Write([]byte(*chunk))
+			return err
+		}))
+	}()
+	return nil
+}
+
+func (jp *jsonArrayProvider[T]) Close() {
+	// Closing the reader fails pending writes, stopping the chunks consumption
+	_ = jp.reader.Close()
+}
+
+func (jp *jsonArrayProvider[T]) Emit(_ context.Context) (*T, error) {
+	if !jp.inArray {
+		// Skipping anything preceding the array, such as an object wrapping it
+		for {
+			t, err := jp.decoder.Token()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil, fmt.Errorf("json array not found in stream")
+				}
+				return nil, err
+			}
+			if t == json.Delim('[') {
+				break
+			}
+		}
+		jp.inArray = true
+	}
+
+	if !jp.decoder.More() {
+		// Reading the closing bracket, to tell a complete array from a truncated one
+		_, err := jp.decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+
+		// Reading the rest of the chunks, so the chunks stream completes, e.g. models report the usage at the end
+		_, err = io.Copy(io.Discard, jp.reader)
+		if err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	var v T
+	err := jp.decoder.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// JsonArrayElements decodes the elements of the first JSON array in the text chunks, emitting each element as soon as
+// it is complete. Useful for parsing a JSON response that is being streamed
+func JsonArrayElements[T any](chunks Stream[string]) Stream[T] {
+	return NewStream[T](&jsonArrayProvider[T]{
+		chunks: chunks,
+	})
+}
@@ -0,0 +1,89 @@
+package shpanstream
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type jsonArrayElement struct {
+	Title string `json:"title"`
+	Value int    `json:"value"`
+}
+
+func TestJsonArrayElements(t *testing.T) {
+	ctx := context.Background()
+
+	// Elements are split across chunks
+	chunks := Just(`[{"title":"o`, `ne","value":1},`, `{"title":"two"`, `,"value":2}`, `]`)
+
+	results, err := JsonArrayElements[jsonArrayElement](chunks).CollectFilterNil(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, []jsonArrayElement{{Title: "one", Value: 1}, {Title: "two", Value: 2}}, results)
+}
+
+func TestJsonArrayElementsWrapped(t *testing.T) {
+	ctx := context.Background()
+
+	chunks := Just(`{"items":`, `[{"title":"one","value":1}]}`)
+
+	results, err := JsonArrayElements[jsonArrayElement](chunks).CollectFilterNil(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, []jsonArrayElement{{Title: "one", Value: 1}}, results)
+}
+
+func TestJsonArrayElementsConsumesAllChunks(t *testing.T) {
+	ctx := context.Background()
+
+	// Chunks following the array are still consumed
+	completed := false
+	chunks := ConcatenatedStream(
+		Just(`{"items":[{"title":"one","value":1}]`, `}`),
+		DeferredStream(func(_ context.Context) Stream[string] {
+			completed = true
+			return EmptyStream[string]()
+		}),
+	)
+
+	results, err := JsonArrayElements[jsonArrayElement](chunks).CollectFilterNil(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, []jsonArrayElement{{Title: "one", Value: 1}}, results)
+	require.True(t, completed)
+}
+
+func TestJsonArrayElementsEmpty(t *testing.T) {
+	ctx := context.Background()
+
+	results, err := JsonArrayElements[jsonArrayElement](Just(`[`, `]`)).CollectFilterNil(ctx)
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+func TestJsonArrayElementsEmitsBeforeEnd(t *testing.T) {
+	ctx := context.Background()
+
+	// The first element is emitted before the chunks stream fails
+	chunksErr := errors.New("chunks failed")
+	chunks := ConcatenatedStream(
+		Just(`[{"title":"one","value":1},`),
+		NewErrorStream[string](chunksErr),
+	)
+
+	var emitted []jsonArrayElement
+	err := JsonArrayElements[jsonArrayElement](chunks).Consume(ctx, func(e *jsonArrayElement) {
+		emitted = append(emitted, *e)
+	})
+	require.ErrorIs(t, err, chunksErr)
+	require.EqualValues(t, []jsonArrayElement{{Title: "one", Value: 1}}, emitted)
+}
+
+func TestJsonArrayElementsTruncated(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := JsonArrayElements[jsonArrayElement](Just(`[{"title":"one","value":1}`)).CollectFilterNil(ctx)
+	require.Error(t, err)
+
+	_, err = JsonArrayElements[jsonArrayElement](Just(`{"title":"one"}`)).CollectFilterNil(ctx)
+	require.Error(t, err)
+}
@@ -40,7 +40,14 @@ func StreamToJsonWriterWithInit[T any](ctx context.Context, w io.Writer, stream
 			return err
 		}
 		_, err = w.Write(rawJson)
-		return err
+		if err != nil {
+			return err
+		}
+		// Pushing each element to the client as soon as it is available
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		return nil
 	})
 	if err != nil {
 		return err
@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"fmt"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"math/rand"
 	"shpankids/infra/database/datekvs"
 	"shpankids/infra/shpanstream"
 	"shpankids/infra/util/castutil"
@@ -10,6 +12,8 @@ import (
 	"shpankids/internal/infra/util"
 	"shpankids/openapi"
 	"shpankids/shpankids"
+	"slices"
+	"strconv"
 	"time"
 )
 
@@ -19,6 +23,10 @@ type OapiServerApiImpl struct {
 	assignmentManager  shpankids.AssignmentManager
 	familyManager      shpankids.FamilyManager
 	sessionManager     shpankids.SessionManager
+	onboardingManager  shpankids.OnboardingManager
+	pointsManager      shpankids.PointsManager
+	auditManager       shpankids.AuditManager
+	aiUsageManager     shpankids.AiUsageManager
 }
 
 func (oa *OapiServerApiImpl) GetProblem(ctx context.Context, request openapi.GetProblemRequestObject) (openapi.GetProblemResponseObject, error) {
@@ -66,22 +74,94 @@ func (oa *OapiServerApiImpl) SubmitProblemAnswer(
 	if err != nil {
 		return nil, err
 	}
-	isCorrect, correctAnswerId, problemDto, err := oa.familyManager.SubmitProblemAnswer(
+	submission := shpankids.ProblemAnswerSubmissionDto{
+		Value: castutil.StrPtrToStr(request.Body.Value),
+	}
+	if request.Body.AnswerIds != nil {
+		submission.AnswerIds = *request.Body.AnswerIds
+	} else if request.Body.AnswerId != nil {
+		submission.AnswerIds = []string{*request.Body.AnswerId}
+	}
+	result, err := oa.familyManager.SubmitProblemAnswer(
 		ctx,
 		s.FamilyId,
 		userId,
 		request.Body.AssignmentId,
 		request.Body.ProblemId,
 		datekvs.TodayDate(s.Location),
-		request.Body.AnswerId,
+		submission,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := openapi.SubmitProblemAnswer200JSONResponse{
+		IsCorrect:         result.Correct,
+		RemainingAttempts: result.RemainingAttempts,
+	}
+	if result.RemainingAttempts > 0 {
+		return &resp, nil
+	}
+
+	// Revealing the correct answer, in the form matching the problem type
+	p := result.Problem
+	resp.Explanation = castutil.StrToStrPtr(p.Explanation)
+	switch p.Type {
+	case shpankids.ProblemTypeSingleChoice:
+		if correct := functional.FindFirst(p.Answers, func(a shpankids.ProblemAnswerDto) bool {
+			return a.Correct
+		}); correct != nil {
+			resp.CorrectAnswerId = correct.Id
+		}
+	case shpankids.ProblemTypeMultipleSelect:
+		resp.CorrectAnswerIds = functional.ValueToPointer(functional.MapSliceWhileFilteringNoErr(
+			p.Answers,
+			func(a shpankids.ProblemAnswerDto) *string {
+				if !a.Correct {
+					return nil
+				}
+				return &a.Id
+			},
+		))
+	case shpankids.ProblemTypeOrdering:
+		resp.CorrectAnswerIds = functional.ValueToPointer(functional.MapSliceNoErr(
+			p.Answers,
+			func(a shpankids.ProblemAnswerDto) string {
+				return a.Id
+			},
+		))
+	case shpankids.ProblemTypeNumeric:
+		if p.NumericAnswer != nil {
+			resp.CorrectValue = functional.ValueToPointer(strconv.FormatFloat(p.NumericAnswer.Value, 'f', -1, 64))
+		}
+	case shpankids.ProblemTypeShortText:
+		if len(p.AcceptedAnswers) > 0 {
+			resp.CorrectValue = &p.AcceptedAnswers[0]
+		}
+	}
+	return &resp, nil
+}
+
+func (oa *OapiServerApiImpl) RevealProblemHint(
+	ctx context.Context,
+	request openapi.RevealProblemHintRequestObject,
+) (openapi.RevealProblemHintResponseObject, error) {
+	userId, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hints, err := oa.familyManager.RevealNextProblemHint(
+		ctx,
+		s.FamilyId,
+		userId,
+		request.Body.AssignmentId,
+		request.Body.ProblemId,
 	)
 	if err != nil {
 		return nil, err
 	}
-	return &openapi.SubmitProblemAnswer200JSONResponse{
-		CorrectAnswerId: correctAnswerId,
-		Explanation:     castutil.StrToStrPtr(problemDto.Explanation),
-		IsCorrect:       isCorrect,
+	return &openapi.RevealProblemHint200JSONResponse{
+		RevealedHints: hints.RevealedHints,
+		HintsCount:    hints.HintsCount,
 	}, nil
 }
 
@@ -98,7 +178,7 @@ func (oa *OapiServerApiImpl) CreateProblemsInSet(
 		s.FamilyId,
 		request.Body.ForUserId,
 		request.Body.ProblemSetId,
-		functional.MapSliceNoErr(request.Body.Problems, toCreateFamilyProblemDto),
+		functional.MapSliceNoErr(request.Body.Problems, ToCreateFamilyProblemDto),
 	)
 	if err != nil {
 		return nil, err
@@ -107,12 +187,27 @@ func (oa *OapiServerApiImpl) CreateProblemsInSet(
 
 }
 
-func toCreateFamilyProblemDto(p openapi.ApiProblemForEdit) shpankids.CreateProblemDto {
-	return shpankids.CreateProblemDto{
+func ToCreateFamilyProblemDto(p openapi.ApiProblemForEdit) shpankids.CreateProblemDto {
+	ret := shpankids.CreateProblemDto{
+		Type:        shpankids.ProblemType(castutil.ValPtrToVal(p.Type)),
 		Description: castutil.StrPtrToStr(p.Description),
 		Title:       p.Title,
 		Answers:     functional.MapSliceNoErr(p.Answers, toCreateProblemAnswerDto),
+		Explanation: castutil.StrPtrToStr(p.Explanation),
+	}
+	if p.Hints != nil {
+		ret.Hints = *p.Hints
 	}
+	if p.NumericAnswer != nil {
+		ret.NumericAnswer = &shpankids.NumericAnswerDto{
+			Value:     p.NumericAnswer.Value,
+			Tolerance: castutil.ValPtrToVal(p.NumericAnswer.Tolerance),
+		}
+	}
+	if p.AcceptedAnswers != nil {
+		ret.AcceptedAnswers = *p.AcceptedAnswers
+	}
+	return ret
 }
 
 func toCreateProblemAnswerDto(a openapi.ApiProblemAnswerForEdit) shpankids.CreateProblemAnswerDto {
@@ -129,13 +224,31 @@ func (oa *OapiServerApiImpl) getUserAndSession(ctx context.Context) (string, *sh
 	if err != nil {
 		return "", nil, err
 	}
-	s, err := oa.sessionManager.Get(ctx, *userId)
+	s, err := oa.sessionManager.Find(ctx, *userId)
+	if err != nil {
+		return "", nil, err
+	}
+	if s == nil {
+		return "", nil, util.ForbiddenError(fmt.Errorf("user %s is not part of any family yet, onboarding is required", *userId))
+	}
+
+	// The user might have been removed from the session family since it was selected
+	f, err := oa.familyManager.FindFamily(ctx, s.FamilyId)
 	if err != nil {
 		return "", nil, err
 	}
+	if f == nil || !isFamilyMember(f, *userId) {
+		return "", nil, util.ForbiddenError(fmt.Errorf("user %s is no longer a member of family %s, switch to another family", *userId, s.FamilyId))
+	}
 	return *userId, s, nil
 }
 
+func isFamilyMember(f *shpankids.FamilyDto, userId string) bool {
+	return slices.ContainsFunc(f.Members, func(member shpankids.FamilyMemberDto) bool {
+		return member.UserId == userId
+	})
+}
+
 func (oa *OapiServerApiImpl) ListProblemSetProblems(
 	ctx context.Context,
 	request openapi.ListProblemSetProblemsRequestObject,
@@ -152,7 +265,7 @@ func (oa *OapiServerApiImpl) ListProblemSetProblems(
 				s.FamilyId,
 				request.UserId,
 				request.ProblemSetId,
-				false,
+				castutil.ValPtrToVal(request.Params.IncludeArchived),
 			),
 			ToApiProblemForEdit,
 		),
@@ -160,14 +273,72 @@ func (oa *OapiServerApiImpl) ListProblemSetProblems(
 }
 
 func ToApiProblemForEdit(p *shpankids.FamilyProblemDto) *openapi.ApiProblemForEdit {
-	return &openapi.ApiProblemForEdit{
+	ret := &openapi.ApiProblemForEdit{
 		Description: castutil.StrToStrPtr(p.Description),
 		Id:          functional.ValueToPointer(p.ProblemId),
+		Type:        functional.ValueToPointer(openapi.ApiProblemType(p.Type)),
 		Title:       p.Title,
 		Answers:     functional.MapSliceNoErr(p.Answers, toApiProblemAnswerForEdit),
 	}
+	if p.NumericAnswer != nil {
+		ret.NumericAnswer = &openapi.ApiNumericAnswer{
+			Value:     p.NumericAnswer.Value,
+			Tolerance: castutil.ValToValPtr(p.NumericAnswer.Tolerance),
+		}
+	}
+	if len(p.AcceptedAnswers) > 0 {
+		ret.AcceptedAnswers = &p.AcceptedAnswers
+	}
+	if len(p.Hints) > 0 {
+		ret.Hints = &p.Hints
+	}
+	if p.Explanation != "" {
+		ret.Explanation = &p.Explanation
+	}
+	if p.Order > 0 {
+		ret.Order = &p.Order
+	}
+	if p.Archived {
+		ret.Archived = &p.Archived
+	}
+	return ret
 
 }
+
+// ToApiProblemForEditFromCreateDto presents a problem that was not created yet, such as a generated problem
+func ToApiProblemForEditFromCreateDto(p shpankids.CreateProblemDto) openapi.ApiProblemForEdit {
+	ret := openapi.ApiProblemForEdit{
+		Description: castutil.StrToStrPtr(p.Description),
+		Title:       p.Title,
+		Answers: functional.MapSliceNoErr(p.Answers, func(a shpankids.CreateProblemAnswerDto) openapi.ApiProblemAnswerForEdit {
+			return openapi.ApiProblemAnswerForEdit{
+				Description: castutil.StrToStrPtr(a.Description),
+				IsCorrect:   a.Correct,
+				Title:       a.Title,
+			}
+		}),
+	}
+	if p.Type != "" {
+		ret.Type = functional.ValueToPointer(openapi.ApiProblemType(p.Type))
+	}
+	if p.NumericAnswer != nil {
+		ret.NumericAnswer = &openapi.ApiNumericAnswer{
+			Value:     p.NumericAnswer.Value,
+			Tolerance: castutil.ValToValPtr(p.NumericAnswer.Tolerance),
+		}
+	}
+	if len(p.AcceptedAnswers) > 0 {
+		ret.AcceptedAnswers = &p.AcceptedAnswers
+	}
+	if len(p.Hints) > 0 {
+		ret.Hints = &p.Hints
+	}
+	if p.Explanation != "" {
+		ret.Explanation = &p.Explanation
+	}
+	return ret
+}
+
 func toApiProblemAnswerForEdit(a shpankids.ProblemAnswerDto) openapi.ApiProblemAnswerForEdit {
 	return openapi.ApiProblemAnswerForEdit{
 		Description: castutil.StrToStrPtr(a.Description),
@@ -187,17 +358,106 @@ func (oa *OapiServerApiImpl) ListUserFamilyProblemSets(
 	}
 	return &streamingProblemSets{
 		stream: shpanstream.MapStream(
-			oa.familyManager.ListProblemSetsForUser(ctx, s.FamilyId, request.Params.UserId),
+			oa.familyManager.ListProblemSetsForUser(ctx, s.FamilyId, request.Params.UserId).
+				Filter(func(p *shpankids.FamilyProblemSetDto) bool {
+					return p.Status != shpankids.FamilyAssignmentStatusDeleted
+				}),
 			toApiProblemSet,
 		),
 		ctx: ctx,
 	}, nil
 }
+
+func (oa *OapiServerApiImpl) ListFamilyInvitations(
+	ctx context.Context,
+	_ openapi.ListFamilyInvitationsRequestObject,
+) (openapi.ListFamilyInvitationsResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingFamilyInvitations{
+		stream: shpanstream.MapStream(
+			oa.familyManager.ListFamilyInvitations(ctx, s.FamilyId),
+			toApiFamilyInvitation,
+		),
+		ctx: ctx,
+	}, nil
+}
+
+func toApiFamilyInvitation(inv *shpankids.FamilyInvitationDto) *openapi.ApiFamilyInvitation {
+	return &openapi.ApiFamilyInvitation{
+		Email:     openapitypes.Email(inv.Email),
+		Role:      openapi.ApiFamilyRole(inv.Role),
+		FirstName: castutil.StrToStrPtr(inv.FirstName),
+		LastName:  castutil.StrToStrPtr(inv.LastName),
+		InvitedBy: inv.InvitedBy,
+		Created:   inv.Created,
+	}
+}
+
+func (oa *OapiServerApiImpl) ListProblemSetTemplates(
+	ctx context.Context,
+	_ openapi.ListProblemSetTemplatesRequestObject,
+) (openapi.ListProblemSetTemplatesResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingProblemSetTemplates{
+		stream: shpanstream.MapStream(
+			oa.familyManager.ListProblemSetTemplates(ctx, s.FamilyId).
+				Filter(func(t *shpankids.ProblemSetTemplateDto) bool {
+					return t.Status != shpankids.FamilyAssignmentStatusDeleted
+				}),
+			toApiProblemSetTemplate,
+		),
+		ctx: ctx,
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) ListProblemSetTemplateProblems(
+	ctx context.Context,
+	request openapi.ListProblemSetTemplateProblemsRequestObject,
+) (openapi.ListProblemSetTemplateProblemsResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingProblemsForEdit{
+		ctx: ctx,
+		stream: shpanstream.MapStream(
+			oa.familyManager.ListProblemsForTemplate(ctx, s.FamilyId, request.TemplateId),
+			ToApiProblemForEdit,
+		),
+	}, nil
+}
+
+func toApiProblemSetTemplate(t *shpankids.ProblemSetTemplateDto) *openapi.ApiProblemSetTemplate {
+	return &openapi.ApiProblemSetTemplate{
+		Id:          t.ProblemSetId,
+		Title:       t.Title,
+		Status:      openapi.ApiLifecycleStatus(t.Status),
+		Description: castutil.StrToStrPtr(t.Description),
+		Points:      castutil.ValToValPtr(t.Points),
+		DailyQuota:  castutil.ValToValPtr(t.DailyQuota),
+		QuotaType:   castutil.ValToValPtr(openapi.ApiProblemSetQuotaType(t.QuotaType)),
+		MaxAttempts: castutil.ValToValPtr(t.MaxAttempts),
+		MemberIds:   t.MemberIds,
+	}
+}
+
 func toApiProblemSet(p *shpankids.FamilyProblemSetDto) *openapi.ApiProblemSet {
 	return &openapi.ApiProblemSet{
 		Id:          p.ProblemSetId,
 		Title:       p.Title,
+		Status:      openapi.ApiLifecycleStatus(p.Status),
 		Description: castutil.StrToStrPtr(p.Description),
+		Points:      castutil.ValToValPtr(p.Points),
+		DailyQuota:  castutil.ValToValPtr(p.DailyQuota),
+		QuotaType:   castutil.ValToValPtr(openapi.ApiProblemSetQuotaType(p.QuotaType)),
+		MaxAttempts: castutil.ValToValPtr(p.MaxAttempts),
+		TemplateId:  castutil.StrToStrPtr(p.TemplateId),
 	}
 }
 
@@ -210,19 +470,37 @@ func (oa *OapiServerApiImpl) LoadProblemForAssignment(
 		return nil, err
 	}
 
-	first, err := shpanstream.MapStreamWithError(
-		oa.familyManager.ListProblemsForProblemSet(ctx, s.FamilyId, userId, request.Body.AssignmentId, false),
-		toApiProblem,
-	).GetFirst(ctx)
-
+	next, err := oa.familyManager.GetNextProblem(
+		ctx,
+		s.FamilyId,
+		userId,
+		request.Body.AssignmentId,
+		*datekvs.NewDateFromTime(request.Body.ForDate.In(s.Location)),
+	)
 	if err != nil {
 		return nil, err
 	}
-	if first == nil {
+	if next == nil {
 		return nil, util.NotFoundError(fmt.Errorf("no problems found for set"))
-	} else {
-		return &openapi.LoadProblemForAssignment200JSONResponse{Problem: *first}, nil
 	}
+	p, err := toApiProblem(ctx, next)
+	if err != nil {
+		return nil, err
+	}
+	hints, err := oa.familyManager.ListRevealedProblemHints(
+		ctx,
+		s.FamilyId,
+		userId,
+		request.Body.AssignmentId,
+		next.ProblemId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(hints.RevealedHints) > 0 {
+		p.RevealedHints = &hints.RevealedHints
+	}
+	return &openapi.LoadProblemForAssignment200JSONResponse{Problem: *p}, nil
 
 }
 
@@ -236,11 +514,19 @@ func toApiProblem(_ context.Context, p *shpankids.FamilyProblemDto) (*openapi.Ap
 	if err != nil {
 		return nil, err
 	}
+	if p.Type == shpankids.ProblemTypeOrdering {
+		// Ordering problem items are kept in the correct order, they must be mixed before presenting them
+		rand.Shuffle(len(mapAnswers), func(i, j int) {
+			mapAnswers[i], mapAnswers[j] = mapAnswers[j], mapAnswers[i]
+		})
+	}
 	return &openapi.ApiProblem{
 		Description: castutil.StrToStrPtr(p.Description),
 		Id:          p.ProblemId,
+		Type:        openapi.ApiProblemType(p.Type),
 		Title:       p.Title,
 		Answers:     mapAnswers,
+		HintsCount:  len(p.Hints),
 	}, nil
 }
 
@@ -272,10 +558,24 @@ func (oa *OapiServerApiImpl) GetStats(
 			oa.assignmentManager.GetTaskStats(ctx, from, to),
 			func(s *shpankids.TaskStats) *openapi.ApiTaskStats {
 				return &openapi.ApiTaskStats{
-					UserId:          s.UserId,
-					ForDate:         s.ForDate,
-					TotalTasksCount: s.TotalTasksCount,
-					DoneTasksCount:  s.DoneTasksCount,
+					UserId:            s.UserId,
+					ForDate:           s.ForDate,
+					TotalTasksCount:   s.TotalTasksCount,
+					DoneTasksCount:    s.DoneTasksCount,
+					ClaimedTasksCount: s.ClaimedTasksCount,
+					ProblemSets: functional.ValueToPointer(functional.MapSliceNoErr(
+						s.ProblemSets,
+						func(p shpankids.ProblemSetProgressDto) openapi.ApiProblemSetProgress {
+							return openapi.ApiProblemSetProgress{
+								ProblemSetId:          p.ProblemSetId,
+								Title:                 p.Title,
+								Completed:             p.Completed,
+								Required:              p.Required,
+								CorrectCount:          p.CorrectCount,
+								CorrectWithHintsCount: p.CorrectWithHintsCount,
+							}
+						},
+					)),
 				}
 
 			}),
@@ -289,7 +589,10 @@ func NewOapiServerApiImpl(
 	assignmentManager shpankids.AssignmentManager,
 	familyManager shpankids.FamilyManager,
 	sessionManager shpankids.SessionManager,
-
+	onboardingManager shpankids.OnboardingManager,
+	pointsManager shpankids.PointsManager,
+	auditManager shpankids.AuditManager,
+	aiUsageManager shpankids.AiUsageManager,
 ) *OapiServerApiImpl {
 	return &OapiServerApiImpl{
 		userSessionManager: userSessionManager,
@@ -297,6 +600,10 @@ func NewOapiServerApiImpl(
 		assignmentManager:  assignmentManager,
 		familyManager:      familyManager,
 		sessionManager:     sessionManager,
+		onboardingManager:  onboardingManager,
+		pointsManager:      pointsManager,
+		auditManager:       auditManager,
+		aiUsageManager:     aiUsageManager,
 	}
 }
 
@@ -304,6 +611,10 @@ func (oa *OapiServerApiImpl) ListAssignments(
 	ctx context.Context,
 	_ openapi.ListAssignmentsRequestObject,
 ) (openapi.ListAssignmentsResponseObject, error) {
+	_, _, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &streamingAssignments{
 		ctx: ctx,
 		stream: shpanstream.MapStream[shpankids.Assignment, openapi.ApiAssignment](
@@ -322,5 +633,290 @@ func toApiAssignment(a *shpankids.Assignment) *openapi.ApiAssignment {
 		Status:      openapi.ApiAssignmentStatus(a.Status),
 		Title:       a.Title,
 		Type:        openapi.ApiAssignmentType(a.Type),
+		Progress:    toApiAssignmentProgress(a.Progress),
+	}
+}
+
+func toApiAssignmentProgress(p *shpankids.AssignmentProgressDto) *openapi.ApiAssignmentProgress {
+	if p == nil {
+		return nil
+	}
+	return &openapi.ApiAssignmentProgress{
+		Completed: p.Completed,
+		Required:  p.Required,
+	}
+}
+
+func (oa *OapiServerApiImpl) GetPointsBalance(
+	ctx context.Context,
+	request openapi.GetPointsBalanceRequestObject,
+) (openapi.GetPointsBalanceResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, err := oa.pointsManager.GetBalance(ctx, s.FamilyId, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.GetPointsBalance200JSONResponse{
+		UserId:   b.UserId,
+		Balance:  b.Balance,
+		Reserved: b.Reserved,
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) GetAchievements(
+	ctx context.Context,
+	request openapi.GetAchievementsRequestObject,
+) (openapi.GetAchievementsResponseObject, error) {
+	_, _, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := oa.assignmentManager.GetAchievements(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.GetAchievements200JSONResponse{
+		UserId:              a.UserId,
+		TasksStreak:         toStreakDto(a.TasksStreak),
+		ProblemsStreak:      toStreakDto(a.ProblemsStreak),
+		ProblemsSolvedCount: a.ProblemsSolvedCount,
+		Badges: functional.MapSliceNoErr(a.Badges, func(b shpankids.BadgeDto) openapi.ApiBadge {
+			ret := openapi.ApiBadge{
+				Id:          b.BadgeId,
+				Title:       b.Title,
+				Description: b.Description,
+				Target:      b.Target,
+				Progress:    b.Progress,
+				Achieved:    b.AchievedOn != nil,
+			}
+			if b.AchievedOn != nil {
+				ret.AchievedDate = &b.AchievedOn.Time
+			}
+			return ret
+		}),
+	}, nil
+}
+
+func toStreakDto(s shpankids.StreakDto) openapi.ApiStreak {
+	return openapi.ApiStreak{
+		Current: s.Current,
+		Best:    s.Best,
+	}
+}
+
+func (oa *OapiServerApiImpl) ListPointsLedger(
+	ctx context.Context,
+	request openapi.ListPointsLedgerRequestObject,
+) (openapi.ListPointsLedgerResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Defaulting to the last month
+	to := datekvs.TodayDate(s.Location)
+	from := *datekvs.NewDateFromTime(to.AddDate(0, -1, 0))
+	if request.Params.From != nil {
+		from = *datekvs.NewDateFromTime(request.Params.From.In(s.Location))
+	}
+	if request.Params.To != nil {
+		to = *datekvs.NewDateFromTime(request.Params.To.In(s.Location))
+	}
+	if to.Before(from.Time) {
+		return nil, util.BadInputError(fmt.Errorf("to date is before from date"))
+	}
+
+	return &streamingPointsLedger{
+		stream: shpanstream.MapStream(
+			oa.pointsManager.ListLedger(ctx, s.FamilyId, request.UserId, from, to),
+			func(e *shpankids.PointsEntryDto) *openapi.ApiPointsEntry {
+				return &openapi.ApiPointsEntry{
+					Id:      e.EntryId,
+					ForDate: e.ForDate.Time,
+					Points:  e.Points,
+					Reason:  e.Reason,
+				}
+			},
+		),
+		ctx: ctx,
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) ListRewards(
+	ctx context.Context,
+	_ openapi.ListRewardsRequestObject,
+) (openapi.ListRewardsResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingRewards{
+		stream: shpanstream.MapStream(
+			oa.pointsManager.ListRewards(ctx, s.FamilyId),
+			func(r *shpankids.RewardDto) *openapi.ApiReward {
+				return &openapi.ApiReward{
+					Id:          r.RewardId,
+					Title:       r.Title,
+					Description: castutil.StrToStrPtr(r.Description),
+					Cost:        r.Cost,
+				}
+			},
+		),
+		ctx: ctx,
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) ListRewardRedemptions(
+	ctx context.Context,
+	request openapi.ListRewardRedemptionsRequestObject,
+) (openapi.ListRewardRedemptionsResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var status *shpankids.RedemptionStatus
+	if request.Params.Status != nil {
+		status = functional.ValueToPointer(shpankids.RedemptionStatus(*request.Params.Status))
+	}
+	return &streamingRewardRedemptions{
+		stream: shpanstream.MapStream(
+			oa.pointsManager.ListRedemptions(ctx, s.FamilyId, status),
+			toApiRewardRedemption,
+		),
+		ctx: ctx,
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) ListAuditLog(
+	ctx context.Context,
+	request openapi.ListAuditLogRequestObject,
+) (openapi.ListAuditLogResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Defaulting to the last week
+	to := datekvs.TodayDate(s.Location)
+	from := *datekvs.NewDateFromTime(to.AddDate(0, 0, -7))
+	if request.Params.From != nil {
+		from = *datekvs.NewDateFromTime(request.Params.From.In(s.Location))
+	}
+	if request.Params.To != nil {
+		to = *datekvs.NewDateFromTime(request.Params.To.In(s.Location))
+	}
+	if to.Before(from.Time) {
+		return nil, util.BadInputError(fmt.Errorf("to date is before from date"))
+	}
+
+	return &streamingAuditLog{
+		stream: shpanstream.MapStream(
+			oa.auditManager.ListAuditLog(ctx, s.FamilyId, from, to, shpankids.AuditFilterDto{
+				ActorId:  castutil.StrPtrToStr(request.Params.ActorId),
+				Action:   shpankids.AuditAction(castutil.StrPtrToStr(request.Params.Action)),
+				TargetId: castutil.StrPtrToStr(request.Params.TargetId),
+			}),
+			func(e *shpankids.AuditEntryDto) *openapi.ApiAuditEntry {
+				return &openapi.ApiAuditEntry{
+					Id:       e.EntryId,
+					Time:     e.Time,
+					ActorId:  e.ActorId,
+					Action:   string(e.Action),
+					TargetId: e.TargetId,
+					Before:   castutil.StrToStrPtr(e.Before),
+					After:    castutil.StrToStrPtr(e.After),
+				}
+			},
+		),
+		ctx: ctx,
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) GetAiUsageReport(
+	ctx context.Context,
+	request openapi.GetAiUsageReportRequestObject,
+) (openapi.GetAiUsageReportResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Defaulting to the last week, usage is kept by UTC days
+	to := datekvs.TodayDate(time.UTC)
+	from := *datekvs.NewDateFromTime(to.AddDate(0, 0, -7))
+	if request.Params.From != nil {
+		from = *datekvs.NewDateFromTime(request.Params.From.UTC())
+	}
+	if request.Params.To != nil {
+		to = *datekvs.NewDateFromTime(request.Params.To.UTC())
+	}
+	if to.Before(from.Time) {
+		return nil, util.BadInputError(fmt.Errorf("to date is before from date"))
+	}
+
+	report, err := oa.aiUsageManager.GetAiUsageReport(ctx, s.FamilyId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.GetAiUsageReport200JSONResponse{
+		FamilyDailyCallsLimit: report.FamilyDailyCallsLimit,
+		UserDailyCallsLimit:   report.UserDailyCallsLimit,
+		Usage: functional.MapSliceNoErr(report.Usage, func(u shpankids.AiUsageDto) openapi.ApiAiUsage {
+			return openapi.ApiAiUsage{
+				Date:           u.Date.Time,
+				UserId:         u.UserId,
+				Calls:          u.Calls,
+				PromptTokens:   u.PromptTokens,
+				ResponseTokens: u.ResponseTokens,
+			}
+		}),
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) ListPendingTaskApprovals(
+	ctx context.Context,
+	_ openapi.ListPendingTaskApprovalsRequestObject,
+) (openapi.ListPendingTaskApprovalsResponseObject, error) {
+	_, _, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingTaskApprovals{
+		stream: shpanstream.MapStream(
+			oa.assignmentManager.ListPendingTaskApprovals(ctx),
+			func(a *shpankids.TaskApprovalDto) *openapi.ApiTaskApproval {
+				return &openapi.ApiTaskApproval{
+					Id:        a.ApprovalId,
+					UserId:    a.UserId,
+					TaskId:    a.TaskId,
+					TaskTitle: a.TaskTitle,
+					ForDate:   a.ForDate.Time,
+					Comment:   castutil.StrToStrPtr(a.Comment),
+					Claimed:   a.Claimed,
+				}
+			},
+		),
+		ctx: ctx,
+	}, nil
+}
+
+func toApiRewardRedemption(r *shpankids.RedemptionDto) *openapi.ApiRewardRedemption {
+	ret := &openapi.ApiRewardRedemption{
+		Id:          r.RedemptionId,
+		UserId:      r.UserId,
+		RewardId:    r.RewardId,
+		RewardTitle: r.RewardTitle,
+		Cost:        r.Cost,
+		Status:      openapi.ApiRedemptionStatus(r.Status),
+		Requested:   r.Requested,
+		DecidedBy:   castutil.StrToStrPtr(r.DecidedBy),
+		Comment:     castutil.StrToStrPtr(r.Comment),
+	}
+	if !r.DecidedAt.IsZero() {
+		ret.DecidedAt = &r.DecidedAt
 	}
+	return ret
 }
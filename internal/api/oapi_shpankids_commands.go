@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/google/uuid"
+	"shpankids/infra/database/datekvs"
 	"shpankids/infra/shpanstream"
 	"shpankids/infra/util/castutil"
+	"shpankids/infra/util/functional"
+	"shpankids/internal/infra/util"
 	"shpankids/openapi"
 	"shpankids/shpankids"
+	"strings"
 	"time"
 )
 
@@ -15,7 +19,11 @@ func (oa *OapiServerApiImpl) UpdateTaskStatus(
 	ctx context.Context,
 	request openapi.UpdateTaskStatusRequestObject,
 ) (openapi.UpdateTaskStatusResponseObject, error) {
-	err := oa.assignmentManager.UpdateTaskStatus(
+	_, _, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.assignmentManager.UpdateTaskStatus(
 		ctx,
 		request.Body.ForDate,
 		request.Body.TaskId,
@@ -28,21 +36,69 @@ func (oa *OapiServerApiImpl) UpdateTaskStatus(
 	return openapi.UpdateTaskStatus200Response{}, nil
 }
 
+func (oa *OapiServerApiImpl) UpdateMemberTaskStatus(
+	ctx context.Context,
+	request openapi.UpdateMemberTaskStatusRequestObject,
+) (openapi.UpdateMemberTaskStatusResponseObject, error) {
+	_, _, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.assignmentManager.UpdateMemberTaskStatus(
+		ctx,
+		request.Body.UserId,
+		request.Body.ForDate,
+		request.Body.TaskId,
+		shpankids.AssignmentStatus(request.Body.Status),
+		castutil.ValPtrToVal(request.Body.Comment),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateMemberTaskStatus200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) UpdateFamilySettings(
+	ctx context.Context,
+	request openapi.UpdateFamilySettingsRequestObject,
+) (openapi.UpdateFamilySettingsResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f, err := oa.familyManager.GetFamily(ctx, s.FamilyId)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.UpdateFamilySettings(ctx, s.FamilyId, shpankids.FamilySettingsDto{
+		TaskBackEditDays:        request.Body.TaskBackEditDays,
+		AiFamilyDailyCallsLimit: castutil.ValPtrOrAlt(request.Body.AiFamilyDailyCallsLimit, f.AiFamilyDailyCallsLimit),
+		AiUserDailyCallsLimit:   castutil.ValPtrOrAlt(request.Body.AiUserDailyCallsLimit, f.AiUserDailyCallsLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateFamilySettings200Response{}, nil
+}
+
 func (oa *OapiServerApiImpl) CreateFamilyTask(ctx context.Context, request openapi.CreateFamilyTaskRequestObject) (openapi.CreateFamilyTaskResponseObject, error) {
-	uId, err := oa.userSessionManager(ctx)
+	_, s, err := oa.getUserAndSession(ctx)
 	if err != nil {
 		return nil, err
 	}
-	s, err := oa.sessionManager.Get(ctx, *uId)
+	schedule, err := toTaskScheduleDto(request.Body.Task.Schedule)
 	if err != nil {
 		return nil, err
 	}
 	err = oa.familyManager.CreateFamilyTask(ctx, s.FamilyId, shpankids.FamilyTaskDto{
-		TaskId:      uuid.NewString(),
-		Title:       request.Body.Task.Title,
-		Description: castutil.StrPtrToStr(request.Body.Task.Description),
-		MemberIds:   request.Body.Task.MemberIds,
-		Created:     time.Now(),
+		TaskId:           uuid.NewString(),
+		Title:            request.Body.Task.Title,
+		Description:      castutil.StrPtrToStr(request.Body.Task.Description),
+		MemberIds:        request.Body.Task.MemberIds,
+		Points:           castutil.ValPtrToVal(request.Body.Task.Points),
+		Schedule:         schedule,
+		RequiresApproval: castutil.ValPtrToVal(request.Body.Task.RequiresApproval),
+		Created:          time.Now(),
 	})
 	if err != nil {
 		return nil, err
@@ -54,17 +110,670 @@ func (oa *OapiServerApiImpl) UpdateFamilyTask(
 	ctx context.Context,
 	request openapi.UpdateFamilyTaskRequestObject,
 ) (openapi.UpdateFamilyTaskResponseObject, error) {
-	return nil, fmt.Errorf("not implemented")
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := toTaskScheduleDto(request.Body.Task.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.UpdateFamilyTask(ctx, s.FamilyId, shpankids.FamilyTaskDto{
+		TaskId:           request.Body.TaskId,
+		Title:            request.Body.Task.Title,
+		Description:      castutil.StrPtrToStr(request.Body.Task.Description),
+		MemberIds:        request.Body.Task.MemberIds,
+		Points:           castutil.ValPtrToVal(request.Body.Task.Points),
+		Schedule:         schedule,
+		RequiresApproval: castutil.ValPtrToVal(request.Body.Task.RequiresApproval),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateFamilyTask200Response{}, nil
 }
-func (oa *OapiServerApiImpl) DeleteFamilyTask(
+
+func (oa *OapiServerApiImpl) AddFamilyMember(
 	ctx context.Context,
-	request openapi.DeleteFamilyTaskRequestObject,
-) (openapi.DeleteFamilyTaskResponseObject, error) {
-	uId, err := oa.userSessionManager(ctx)
+	request openapi.AddFamilyMemberRequestObject,
+) (openapi.AddFamilyMemberResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.AddFamilyMember(ctx, s.FamilyId, request.Body.UserId, shpankids.Role(request.Body.Role))
+	if err != nil {
+		return nil, err
+	}
+	return openapi.AddFamilyMember200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) UpdateFamilyMemberRole(
+	ctx context.Context,
+	request openapi.UpdateFamilyMemberRoleRequestObject,
+) (openapi.UpdateFamilyMemberRoleResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.UpdateFamilyMemberRole(ctx, s.FamilyId, request.Body.UserId, shpankids.Role(request.Body.Role))
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateFamilyMemberRole200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) RemoveFamilyMember(
+	ctx context.Context,
+	request openapi.RemoveFamilyMemberRequestObject,
+) (openapi.RemoveFamilyMemberResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.RemoveFamilyMember(ctx, s.FamilyId, request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.RemoveFamilyMember200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) InviteFamilyMember(
+	ctx context.Context,
+	request openapi.InviteFamilyMemberRequestObject,
+) (openapi.InviteFamilyMemberResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.InviteFamilyMember(ctx, s.FamilyId, shpankids.FamilyInvitationDto{
+		Email:     string(request.Body.Email),
+		Role:      shpankids.Role(request.Body.Role),
+		FirstName: castutil.StrPtrToStr(request.Body.FirstName),
+		LastName:  castutil.StrPtrToStr(request.Body.LastName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return openapi.InviteFamilyMember200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) RevokeFamilyInvitation(
+	ctx context.Context,
+	request openapi.RevokeFamilyInvitationRequestObject,
+) (openapi.RevokeFamilyInvitationResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.RevokeFamilyInvitation(ctx, s.FamilyId, string(request.Body.Email))
+	if err != nil {
+		return nil, err
+	}
+	return openapi.RevokeFamilyInvitation200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) CreateOwnFamily(
+	ctx context.Context,
+	request openapi.CreateOwnFamilyRequestObject,
+) (openapi.CreateOwnFamilyResponseObject, error) {
+	location, err := time.LoadLocation(request.Body.TimeZone)
+	if err != nil {
+		return nil, util.BadInputError(fmt.Errorf("invalid time zone %s: %w", request.Body.TimeZone, err))
+	}
+	_, err = oa.onboardingManager.CreateOwnFamily(
+		ctx,
+		request.Body.FamilyName,
+		location,
+		castutil.StrPtrToStr(request.Body.FirstName),
+		castutil.StrPtrToStr(request.Body.LastName),
+	)
 	if err != nil {
 		return nil, err
 	}
-	s, err := oa.sessionManager.Get(ctx, *uId)
+	return openapi.CreateOwnFamily200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) SwitchFamily(
+	ctx context.Context,
+	request openapi.SwitchFamilyRequestObject,
+) (openapi.SwitchFamilyResponseObject, error) {
+	userId, err := oa.userSessionManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s, err := oa.sessionManager.Find(ctx, *userId)
+	if err != nil {
+		return nil, err
+	}
+	f, err := oa.familyManager.FindFamily(ctx, request.Body.FamilyId)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil || !isFamilyMember(f, *userId) {
+		return nil, util.ForbiddenError(fmt.Errorf("user %s is not a member of family %s", *userId, request.Body.FamilyId))
+	}
+
+	// Keeping the user time zone, only the active family changes
+	location := time.UTC
+	if s != nil {
+		location = s.Location
+	}
+	err = oa.sessionManager.Set(ctx, *userId, shpankids.Session{
+		FamilyId: f.Id,
+		Location: location,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return openapi.SwitchFamily200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) CreateReward(
+	ctx context.Context,
+	request openapi.CreateRewardRequestObject,
+) (openapi.CreateRewardResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.pointsManager.CreateReward(ctx, s.FamilyId, shpankids.RewardDto{
+		RewardId:    uuid.NewString(),
+		Title:       request.Body.Title,
+		Description: castutil.StrPtrToStr(request.Body.Description),
+		Cost:        request.Body.Cost,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return openapi.CreateReward200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) DeleteReward(
+	ctx context.Context,
+	request openapi.DeleteRewardRequestObject,
+) (openapi.DeleteRewardResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.pointsManager.DeleteReward(ctx, s.FamilyId, request.Body.RewardId)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.DeleteReward200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) RedeemReward(
+	ctx context.Context,
+	request openapi.RedeemRewardRequestObject,
+) (openapi.RedeemRewardResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = oa.pointsManager.RequestRedemption(ctx, s.FamilyId, request.Body.RewardId)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.RedeemReward200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) DecideRewardRedemption(
+	ctx context.Context,
+	request openapi.DecideRewardRedemptionRequestObject,
+) (openapi.DecideRewardRedemptionResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.pointsManager.DecideRedemption(
+		ctx,
+		s.FamilyId,
+		request.Body.RedemptionId,
+		request.Body.Approve,
+		castutil.StrPtrToStr(request.Body.Comment),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.DecideRewardRedemption200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) DecideTaskApproval(
+	ctx context.Context,
+	request openapi.DecideTaskApprovalRequestObject,
+) (openapi.DecideTaskApprovalResponseObject, error) {
+	_, _, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.assignmentManager.DecideTaskApproval(
+		ctx,
+		request.Body.ApprovalId,
+		request.Body.Approve,
+		castutil.StrPtrToStr(request.Body.Comment),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.DecideTaskApproval200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) UpdateProblemSet(
+	ctx context.Context,
+	request openapi.UpdateProblemSetRequestObject,
+) (openapi.UpdateProblemSetResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.UpdateProblemSet(
+		ctx,
+		s.FamilyId,
+		request.Body.ForUserId,
+		shpankids.CreateProblemSetDto{
+			ProblemSetId: request.Body.ProblemSetId,
+			Title:        request.Body.Title,
+			Description:  castutil.StrPtrToStr(request.Body.Description),
+			Points:       castutil.ValPtrToVal(request.Body.Points),
+			DailyQuota:   castutil.ValPtrToVal(request.Body.DailyQuota),
+			QuotaType:    shpankids.ProblemSetQuotaType(castutil.ValPtrToVal(request.Body.QuotaType)),
+			MaxAttempts:  castutil.ValPtrToVal(request.Body.MaxAttempts),
+		})
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateProblemSet200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) UpdateProblemSetStatus(
+	ctx context.Context,
+	request openapi.UpdateProblemSetStatusRequestObject,
+) (openapi.UpdateProblemSetStatusResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.SetProblemSetStatus(
+		ctx,
+		s.FamilyId,
+		request.Body.ForUserId,
+		request.Body.ProblemSetId,
+		shpankids.FamilyAssignmentStatus(request.Body.Status),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateProblemSetStatus200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) UpdateProblem(
+	ctx context.Context,
+	request openapi.UpdateProblemRequestObject,
+) (openapi.UpdateProblemResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.UpdateProblem(
+		ctx,
+		s.FamilyId,
+		request.Body.ForUserId,
+		request.Body.ProblemSetId,
+		request.Body.ProblemId,
+		ToCreateFamilyProblemDto(request.Body.Problem),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateProblem200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) UpdateProblemStatus(
+	ctx context.Context,
+	request openapi.UpdateProblemStatusRequestObject,
+) (openapi.UpdateProblemStatusResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.SetProblemStatus(
+		ctx,
+		s.FamilyId,
+		request.Body.ForUserId,
+		request.Body.ProblemSetId,
+		request.Body.ProblemId,
+		shpankids.FamilyAssignmentStatus(request.Body.Status),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateProblemStatus200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) ReorderProblems(
+	ctx context.Context,
+	request openapi.ReorderProblemsRequestObject,
+) (openapi.ReorderProblemsResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.ReorderProblems(
+		ctx,
+		s.FamilyId,
+		request.Body.ForUserId,
+		request.Body.ProblemSetId,
+		request.Body.ProblemIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.ReorderProblems200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) ExportProblemSet(
+	ctx context.Context,
+	request openapi.ExportProblemSetRequestObject,
+) (openapi.ExportProblemSetResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	format := shpankids.ProblemSetFileFormat(request.Body.Format)
+	content, err := oa.familyManager.ExportProblemSet(
+		ctx,
+		s.FamilyId,
+		request.Body.ForUserId,
+		request.Body.ProblemSetId,
+		format,
+	)
+	if err != nil {
+		return nil, err
+	}
+	contentType := "application/json"
+	if format == shpankids.ProblemSetFileFormatCsv {
+		contentType = "text/csv"
+	}
+	return openapi.ExportProblemSet200JSONResponse{
+		FileName:    fmt.Sprintf("problem-set-%s.%s", request.Body.ProblemSetId, format),
+		ContentType: contentType,
+		Content:     string(content),
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) ImportProblemSet(
+	ctx context.Context,
+	request openapi.ImportProblemSetRequestObject,
+) (openapi.ImportProblemSetResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := oa.familyManager.ImportProblemSet(
+		ctx,
+		s.FamilyId,
+		request.Body.ForUserId,
+		shpankids.ProblemSetImportDto{
+			ProblemSetId: castutil.StrPtrToStr(request.Body.ProblemSetId),
+			Format:       shpankids.ProblemSetFileFormat(request.Body.Format),
+			Data:         []byte(request.Body.Content),
+			DryRun:       castutil.ValPtrToVal(request.Body.DryRun),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.ImportProblemSet200JSONResponse{
+		ProblemSetId:  castutil.StrToStrPtr(result.ProblemSetId),
+		ImportedCount: result.ImportedCount,
+		Errors: functional.MapSliceNoErr(result.Errors, func(e shpankids.ProblemImportErrorDto) openapi.ApiProblemImportError {
+			return openapi.ApiProblemImportError{
+				Row:     e.Row,
+				Message: e.Message,
+			}
+		}),
+	}, nil
+}
+
+func (oa *OapiServerApiImpl) CreateProblemSetTemplate(
+	ctx context.Context,
+	request openapi.CreateProblemSetTemplateRequestObject,
+) (openapi.CreateProblemSetTemplateResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.CreateProblemSetTemplate(
+		ctx,
+		s.FamilyId,
+		shpankids.CreateProblemSetDto{
+			ProblemSetId: uuid.NewString(),
+			Title:        request.Body.Title,
+			Description:  castutil.StrPtrToStr(request.Body.Description),
+			Points:       castutil.ValPtrToVal(request.Body.Points),
+			DailyQuota:   castutil.ValPtrToVal(request.Body.DailyQuota),
+			QuotaType:    shpankids.ProblemSetQuotaType(castutil.ValPtrToVal(request.Body.QuotaType)),
+			MaxAttempts:  castutil.ValPtrToVal(request.Body.MaxAttempts),
+		},
+		request.Body.MemberIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.CreateProblemSetTemplate200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) UpdateProblemSetTemplate(
+	ctx context.Context,
+	request openapi.UpdateProblemSetTemplateRequestObject,
+) (openapi.UpdateProblemSetTemplateResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.UpdateProblemSetTemplate(
+		ctx,
+		s.FamilyId,
+		shpankids.CreateProblemSetDto{
+			ProblemSetId: request.Body.TemplateId,
+			Title:        request.Body.Title,
+			Description:  castutil.StrPtrToStr(request.Body.Description),
+			Points:       castutil.ValPtrToVal(request.Body.Points),
+			DailyQuota:   castutil.ValPtrToVal(request.Body.DailyQuota),
+			QuotaType:    shpankids.ProblemSetQuotaType(castutil.ValPtrToVal(request.Body.QuotaType)),
+			MaxAttempts:  castutil.ValPtrToVal(request.Body.MaxAttempts),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateProblemSetTemplate200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) AssignProblemSetTemplate(
+	ctx context.Context,
+	request openapi.AssignProblemSetTemplateRequestObject,
+) (openapi.AssignProblemSetTemplateResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.AssignProblemSetTemplate(ctx, s.FamilyId, request.Body.TemplateId, request.Body.MemberIds)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.AssignProblemSetTemplate200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) DeleteProblemSetTemplate(
+	ctx context.Context,
+	request openapi.DeleteProblemSetTemplateRequestObject,
+) (openapi.DeleteProblemSetTemplateResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.DeleteProblemSetTemplate(ctx, s.FamilyId, request.Body.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.DeleteProblemSetTemplate200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) CreateProblemsInTemplate(
+	ctx context.Context,
+	request openapi.CreateProblemsInTemplateRequestObject,
+) (openapi.CreateProblemsInTemplateResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.CreateProblemsInTemplate(
+		ctx,
+		s.FamilyId,
+		request.Body.TemplateId,
+		functional.MapSliceNoErr(request.Body.Problems, ToCreateFamilyProblemDto),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.CreateProblemsInTemplate200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) UpdateTemplateProblem(
+	ctx context.Context,
+	request openapi.UpdateTemplateProblemRequestObject,
+) (openapi.UpdateTemplateProblemResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.UpdateTemplateProblem(
+		ctx,
+		s.FamilyId,
+		request.Body.TemplateId,
+		request.Body.ProblemId,
+		ToCreateFamilyProblemDto(request.Body.Problem),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.UpdateTemplateProblem200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) DeleteTemplateProblem(
+	ctx context.Context,
+	request openapi.DeleteTemplateProblemRequestObject,
+) (openapi.DeleteTemplateProblemResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.DeleteTemplateProblem(ctx, s.FamilyId, request.Body.TemplateId, request.Body.ProblemId)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.DeleteTemplateProblem200Response{}, nil
+}
+
+func (oa *OapiServerApiImpl) ReorderTemplateProblems(
+	ctx context.Context,
+	request openapi.ReorderTemplateProblemsRequestObject,
+) (openapi.ReorderTemplateProblemsResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = oa.familyManager.ReorderTemplateProblems(ctx, s.FamilyId, request.Body.TemplateId, request.Body.ProblemIds)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.ReorderTemplateProblems200Response{}, nil
+}
+
+func toTaskScheduleDto(s *openapi.ApiTaskSchedule) (*shpankids.TaskScheduleDto, error) {
+	if s == nil {
+		return nil, nil
+	}
+	ret := &shpankids.TaskScheduleDto{
+		EveryNDays: castutil.ValPtrToVal(s.EveryNDays),
+		StartDate:  toDatePtr(s.StartDate),
+		EndDate:    toDatePtr(s.EndDate),
+	}
+	if s.WeekDays != nil {
+		weekDays, err := functional.MapSlice(*s.WeekDays, func(wd openapi.ApiWeekDay) (time.Weekday, error) {
+			weekDay, ok := weekDaysByApiWeekDay[wd]
+			if !ok {
+				return 0, util.BadInputError(fmt.Errorf("invalid week day: %s", wd))
+			}
+			return weekDay, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		ret.WeekDays = weekDays
+	}
+	if s.ExcludedDates != nil {
+		ret.ExcludedDates = functional.MapSliceNoErr(*s.ExcludedDates, func(t time.Time) datekvs.Date {
+			return *datekvs.NewDateFromTime(t)
+		})
+	}
+	return ret, nil
+}
+
+func toApiTaskSchedule(s *shpankids.TaskScheduleDto) *openapi.ApiTaskSchedule {
+	if s == nil {
+		return nil
+	}
+	ret := &openapi.ApiTaskSchedule{
+		EveryNDays: castutil.ValToValPtr(s.EveryNDays),
+		StartDate:  fromDatePtr(s.StartDate),
+		EndDate:    fromDatePtr(s.EndDate),
+	}
+	if len(s.WeekDays) > 0 {
+		ret.WeekDays = functional.ValueToPointer(functional.MapSliceNoErr(s.WeekDays, func(wd time.Weekday) openapi.ApiWeekDay {
+			return openapi.ApiWeekDay(strings.ToLower(wd.String()))
+		}))
+	}
+	if len(s.ExcludedDates) > 0 {
+		ret.ExcludedDates = functional.ValueToPointer(functional.MapSliceNoErr(s.ExcludedDates, func(d datekvs.Date) time.Time {
+			return d.Time
+		}))
+	}
+	return ret
+}
+
+var weekDaysByApiWeekDay = map[openapi.ApiWeekDay]time.Weekday{
+	openapi.Sunday:    time.Sunday,
+	openapi.Monday:    time.Monday,
+	openapi.Tuesday:   time.Tuesday,
+	openapi.Wednesday: time.Wednesday,
+	openapi.Thursday:  time.Thursday,
+	openapi.Friday:    time.Friday,
+	openapi.Saturday:  time.Saturday,
+}
+
+func toDatePtr(t *time.Time) *datekvs.Date {
+	if t == nil {
+		return nil
+	}
+	return datekvs.NewDateFromTime(*t)
+}
+
+func fromDatePtr(d *datekvs.Date) *time.Time {
+	if d == nil {
+		return nil
+	}
+	return &d.Time
+}
+
+func (oa *OapiServerApiImpl) DeleteFamilyTask(
+	ctx context.Context,
+	request openapi.DeleteFamilyTaskRequestObject,
+) (openapi.DeleteFamilyTaskResponseObject, error) {
+	_, s, err := oa.getUserAndSession(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +800,10 @@ func (oa *OapiServerApiImpl) CreateProblemSet(
 			ProblemSetId: uuid.NewString(),
 			Title:        request.Body.Title,
 			Description:  castutil.StrPtrToStr(request.Body.Description),
+			Points:       castutil.ValPtrToVal(request.Body.Points),
+			DailyQuota:   castutil.ValPtrToVal(request.Body.DailyQuota),
+			QuotaType:    shpankids.ProblemSetQuotaType(castutil.ValPtrToVal(request.Body.QuotaType)),
+			MaxAttempts:  castutil.ValPtrToVal(request.Body.MaxAttempts),
 		})
 	if err != nil {
 		return nil, err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	openapitypes "github.com/oapi-codegen/runtime/types"
+	"shpankids/infra/shpanstream"
 	"shpankids/infra/util/castutil"
 	"shpankids/infra/util/functional"
 	"shpankids/internal/infra/util"
@@ -15,17 +16,34 @@ func (oa *OapiServerApiImpl) GetUserInfo(
 	ctx context.Context,
 	_ openapi.GetUserInfoRequestObject,
 ) (openapi.GetUserInfoResponseObject, error) {
-	userId, s, err := oa.getUserAndSession(ctx)
+	userId, err := oa.userSessionManager(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	user, err := oa.userManager.FindUser(ctx, userId)
+	s, err := oa.sessionManager.Find(ctx, *userId)
+	if err != nil {
+		return nil, err
+	}
+	user, err := oa.userManager.FindUser(ctx, *userId)
 	if err != nil {
 		return nil, err
 	}
+
+	// Users with no family yet are guests, until they create their own family or get invited to one
+	if s == nil {
+		ret := openapi.GetUserInfo200JSONResponse{
+			Email:              openapitypes.Email(*userId),
+			Role:               openapi.Guest,
+			OnboardingRequired: castutil.ValToValPtr(true),
+		}
+		if user != nil {
+			ret.FirstName = castutil.ValToValPtr(user.FirstName)
+			ret.LastName = castutil.ValToValPtr(user.LastName)
+		}
+		return ret, nil
+	}
 	if user == nil {
-		return nil, util.ForbiddenError(fmt.Errorf("%s is not a valid user", userId))
+		return nil, util.ForbiddenError(fmt.Errorf("%s is not a valid user", *userId))
 	}
 
 	f, err := oa.familyManager.GetFamily(ctx, s.FamilyId)
@@ -35,7 +53,7 @@ func (oa *OapiServerApiImpl) GetUserInfo(
 
 	uiUserRole := openapi.Guest
 	fm := functional.FindFirst(f.Members, func(member shpankids.FamilyMemberDto) bool {
-		return member.UserId == userId
+		return member.UserId == *userId
 	})
 	if fm != nil {
 		switch fm.Role {
@@ -47,7 +65,7 @@ func (oa *OapiServerApiImpl) GetUserInfo(
 	}
 
 	return openapi.GetUserInfo200JSONResponse{
-		Email:     openapitypes.Email(userId),
+		Email:     openapitypes.Email(*userId),
 		FirstName: castutil.ValToValPtr(user.FirstName),
 		LastName:  castutil.ValToValPtr(user.LastName),
 		Role:      uiUserRole,
@@ -58,12 +76,7 @@ func (oa *OapiServerApiImpl) GetFamilyInfo(
 	ctx context.Context,
 	_ openapi.GetFamilyInfoRequestObject,
 ) (openapi.GetFamilyInfoResponseObject, error) {
-	usrId, err := oa.userSessionManager(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	s, err := oa.sessionManager.Get(ctx, *usrId)
+	_, s, err := oa.getUserAndSession(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -99,17 +112,23 @@ func (oa *OapiServerApiImpl) GetFamilyInfo(
 	}
 
 	return openapi.GetFamilyInfo200JSONResponse{
-		AdminEmail:        openapitypes.Email(family.OwnerEmail),
-		FamilyDisplayName: family.Name,
-		FamilyUri:         family.Id,
-		Members:           uiFamilyMembers,
+		AdminEmail:              openapitypes.Email(family.OwnerEmail),
+		FamilyDisplayName:       family.Name,
+		FamilyUri:               family.Id,
+		Members:                 uiFamilyMembers,
+		TaskBackEditDays:        family.TaskBackEditDays,
+		AiFamilyDailyCallsLimit: family.AiFamilyDailyCallsLimit,
+		AiUserDailyCallsLimit:   family.AiUserDailyCallsLimit,
 		Tasks: functional.MapSliceWhileFilteringNoErr(familyTasks, func(task shpankids.FamilyTaskDto) *openapi.UIFamilyTask {
 			if task.Status == shpankids.FamilyAssignmentStatusActive {
 				return &openapi.UIFamilyTask{
-					Description: castutil.StrToStrPtr(task.Description),
-					Id:          task.TaskId,
-					MemberIds:   task.MemberIds,
-					Title:       task.Title,
+					Description:      castutil.StrToStrPtr(task.Description),
+					Id:               task.TaskId,
+					MemberIds:        task.MemberIds,
+					Title:            task.Title,
+					Points:           castutil.ValToValPtr(task.Points),
+					Schedule:         toApiTaskSchedule(task.Schedule),
+					RequiresApproval: castutil.ValToValPtr(task.RequiresApproval),
 				}
 			} else {
 				return nil
@@ -117,3 +136,34 @@ func (oa *OapiServerApiImpl) GetFamilyInfo(
 		}),
 	}, nil
 }
+
+func (oa *OapiServerApiImpl) ListUserFamilies(
+	ctx context.Context,
+	_ openapi.ListUserFamiliesRequestObject,
+) (openapi.ListUserFamiliesResponseObject, error) {
+	userId, err := oa.userSessionManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s, err := oa.sessionManager.Find(ctx, *userId)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingUserFamilies{
+		stream: shpanstream.MapStream(
+			oa.familyManager.ListFamiliesForUser(ctx, *userId),
+			func(f *shpankids.FamilyDto) *openapi.UIUserFamily {
+				fm := functional.FindFirst(f.Members, func(member shpankids.FamilyMemberDto) bool {
+					return member.UserId == *userId
+				})
+				return &openapi.UIUserFamily{
+					Id:     f.Id,
+					Name:   f.Name,
+					Role:   openapi.ApiFamilyRole(fm.Role),
+					Active: s != nil && s.FamilyId == f.Id,
+				}
+			},
+		),
+		ctx: ctx,
+	}, nil
+}
@@ -34,6 +34,78 @@ func (s *streamingProblemSets) VisitListUserFamilyProblemSetsResponse(w http.Res
 	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
 }
 
+type streamingProblemSetTemplates struct {
+	stream shpanstream.Stream[openapi.ApiProblemSetTemplate]
+	ctx    context.Context
+}
+
+func (s *streamingProblemSetTemplates) VisitListProblemSetTemplatesResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
+
+type streamingFamilyInvitations struct {
+	stream shpanstream.Stream[openapi.ApiFamilyInvitation]
+	ctx    context.Context
+}
+
+func (s *streamingFamilyInvitations) VisitListFamilyInvitationsResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
+
+type streamingUserFamilies struct {
+	stream shpanstream.Stream[openapi.UIUserFamily]
+	ctx    context.Context
+}
+
+func (s *streamingUserFamilies) VisitListUserFamiliesResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
+
+type streamingPointsLedger struct {
+	stream shpanstream.Stream[openapi.ApiPointsEntry]
+	ctx    context.Context
+}
+
+func (s *streamingPointsLedger) VisitListPointsLedgerResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
+
+type streamingRewards struct {
+	stream shpanstream.Stream[openapi.ApiReward]
+	ctx    context.Context
+}
+
+func (s *streamingRewards) VisitListRewardsResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
+
+type streamingRewardRedemptions struct {
+	stream shpanstream.Stream[openapi.ApiRewardRedemption]
+	ctx    context.Context
+}
+
+func (s *streamingRewardRedemptions) VisitListRewardRedemptionsResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
+
+type streamingAuditLog struct {
+	stream shpanstream.Stream[openapi.ApiAuditEntry]
+	ctx    context.Context
+}
+
+func (s *streamingAuditLog) VisitListAuditLogResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
+
+type streamingTaskApprovals struct {
+	stream shpanstream.Stream[openapi.ApiTaskApproval]
+	ctx    context.Context
+}
+
+func (s *streamingTaskApprovals) VisitListPendingTaskApprovalsResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
+
 type streamingProblemsForEdit struct {
 	stream shpanstream.Stream[openapi.ApiProblemForEdit]
 	ctx    context.Context
@@ -58,3 +130,6 @@ func (s *streamingProblemsForEdit) VisitListProblemSetProblemsResponse(w http.Re
 func (s *streamingProblemsForEdit) VisitGenerateProblemsResponse(w http.ResponseWriter) error {
 	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
 }
+func (s *streamingProblemsForEdit) VisitListProblemSetTemplateProblemsResponse(w http.ResponseWriter) error {
+	return shpanstream.StreamToJsonResponseWriter(s.ctx, w, s.stream)
+}
@@ -52,3 +52,10 @@ func NotFoundError(err error) UserFacingError {
 		httpRet: http.StatusNotFound,
 	}
 }
+
+func TooManyRequestsError(err error) UserFacingError {
+	return userFacingError{
+		error:   err,
+		httpRet: http.StatusTooManyRequests,
+	}
+}
@@ -7,6 +7,10 @@ import (
 	"fmt"
 	"log"
 	"shpankids/app"
+	"shpankids/domain/ai"
+	"shpankids/domain/ai/gemini"
+	"shpankids/domain/ai/openai"
+	"shpankids/domain/ai/stub"
 	firestorekvs "shpankids/infra/database/firestore"
 	"shpankids/infra/database/kvstore"
 	"shpankids/shpankids"
@@ -22,6 +26,7 @@ func main() {
 
 	// get a flag for localdev
 	runtimeEnv := flag.String("runtime-env", "prod", "have a separate flag for local development")
+	aiProviderName := flag.String("ai-provider", "", "gemini, openai or stub, defaults to stub in dev and gemini otherwise")
 	flag.Parse()
 
 	err := shpankids.DetectSecrets()
@@ -42,6 +47,25 @@ func main() {
 		defer fs.Close()
 	}
 
-	log.Fatalf(fmt.Sprintf("%v", app.Start(kvs)))
+	if *aiProviderName == "" {
+		*aiProviderName = "gemini"
+		if *runtimeEnv == "dev" {
+			*aiProviderName = "stub"
+		}
+	}
+	var aiProvider ai.Provider
+	switch *aiProviderName {
+	case "gemini":
+		aiProvider = gemini.NewProvider(shpankids.GetSecrets().Gemini.ApiKey)
+	case "openai":
+		openAiSecret := shpankids.GetSecrets().OpenAi
+		aiProvider = openai.NewProvider(openAiSecret.ApiKey, openAiSecret.BaseUrl, openAiSecret.Model)
+	case "stub":
+		aiProvider = stub.NewProvider()
+	default:
+		log.Fatalf("Unknown ai provider: %s", *aiProviderName)
+	}
+
+	log.Fatalf(fmt.Sprintf("%v", app.Start(kvs, aiProvider)))
 
 }
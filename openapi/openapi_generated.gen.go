@@ -25,10 +25,11 @@ import (
 
 // Defines values for ApiAssignmentStatus.
 const (
-	Blocked    ApiAssignmentStatus = "blocked"
-	Done       ApiAssignmentStatus = "done"
-	Irrelevant ApiAssignmentStatus = "irrelevant"
-	Open       ApiAssignmentStatus = "open"
+	Blocked         ApiAssignmentStatus = "blocked"
+	Done            ApiAssignmentStatus = "done"
+	Irrelevant      ApiAssignmentStatus = "irrelevant"
+	Open            ApiAssignmentStatus = "open"
+	PendingApproval ApiAssignmentStatus = "pendingApproval"
 )
 
 // Defines values for ApiAssignmentType.
@@ -43,6 +44,52 @@ const (
 	Member ApiFamilyRole = "member"
 )
 
+// Defines values for ApiLifecycleStatus.
+const (
+	Active   ApiLifecycleStatus = "active"
+	Archived ApiLifecycleStatus = "archived"
+	Deleted  ApiLifecycleStatus = "deleted"
+)
+
+// Defines values for ApiProblemSetFileFormat.
+const (
+	Csv  ApiProblemSetFileFormat = "csv"
+	Json ApiProblemSetFileFormat = "json"
+)
+
+// Defines values for ApiProblemSetQuotaType.
+const (
+	CorrectAnswers ApiProblemSetQuotaType = "correctAnswers"
+	Problems       ApiProblemSetQuotaType = "problems"
+)
+
+// Defines values for ApiProblemType.
+const (
+	MultipleSelect ApiProblemType = "multipleSelect"
+	Numeric        ApiProblemType = "numeric"
+	Ordering       ApiProblemType = "ordering"
+	ShortText      ApiProblemType = "shortText"
+	SingleChoice   ApiProblemType = "singleChoice"
+)
+
+// Defines values for ApiRedemptionStatus.
+const (
+	Approved ApiRedemptionStatus = "approved"
+	Pending  ApiRedemptionStatus = "pending"
+	Rejected ApiRedemptionStatus = "rejected"
+)
+
+// Defines values for ApiWeekDay.
+const (
+	Friday    ApiWeekDay = "friday"
+	Monday    ApiWeekDay = "monday"
+	Saturday  ApiWeekDay = "saturday"
+	Sunday    ApiWeekDay = "sunday"
+	Thursday  ApiWeekDay = "thursday"
+	Tuesday   ApiWeekDay = "tuesday"
+	Wednesday ApiWeekDay = "wednesday"
+)
+
 // Defines values for UIUserRole.
 const (
 	FamilyAdmin  UIUserRole = "familyAdmin"
@@ -50,14 +97,51 @@ const (
 	Guest        UIUserRole = "guest"
 )
 
+// ApiAddFamilyMemberCommandArgs defines model for ApiAddFamilyMemberCommandArgs.
+type ApiAddFamilyMemberCommandArgs struct {
+	Role   ApiFamilyRole `json:"role"`
+	UserId string        `json:"userId"`
+}
+
+// ApiAiUsage defines model for ApiAiUsage.
+type ApiAiUsage struct {
+	// Calls Number of generate and refine requests
+	Calls          int       `json:"calls"`
+	Date           time.Time `json:"date"`
+	PromptTokens   int       `json:"promptTokens"`
+	ResponseTokens int       `json:"responseTokens"`
+	UserId         string    `json:"userId"`
+}
+
+// ApiAiUsageReport defines model for ApiAiUsageReport.
+type ApiAiUsageReport struct {
+	FamilyDailyCallsLimit int          `json:"familyDailyCallsLimit"`
+	Usage                 []ApiAiUsage `json:"usage"`
+	UserDailyCallsLimit   int          `json:"userDailyCallsLimit"`
+}
+
+// ApiAssignProblemSetTemplateCommandArgs defines model for ApiAssignProblemSetTemplateCommandArgs.
+type ApiAssignProblemSetTemplateCommandArgs struct {
+	// MemberIds All the members the template is assigned to, copies of members left out are archived
+	MemberIds  []string `json:"memberIds"`
+	TemplateId string   `json:"templateId"`
+}
+
 // ApiAssignment defines model for ApiAssignment.
 type ApiAssignment struct {
-	Description *string             `json:"description,omitempty"`
-	ForDate     time.Time           `json:"forDate"`
-	Id          string              `json:"id"`
-	Status      ApiAssignmentStatus `json:"status"`
-	Title       string              `json:"title"`
-	Type        ApiAssignmentType   `json:"type"`
+	Description *string                `json:"description,omitempty"`
+	ForDate     time.Time              `json:"forDate"`
+	Id          string                 `json:"id"`
+	Progress    *ApiAssignmentProgress `json:"progress,omitempty"`
+	Status      ApiAssignmentStatus    `json:"status"`
+	Title       string                 `json:"title"`
+	Type        ApiAssignmentType      `json:"type"`
+}
+
+// ApiAssignmentProgress Progress towards the daily quota of a problem set assignment
+type ApiAssignmentProgress struct {
+	Completed int `json:"completed"`
+	Required  int `json:"required"`
 }
 
 // ApiAssignmentStatus defines model for ApiAssignmentStatus.
@@ -66,16 +150,70 @@ type ApiAssignmentStatus string
 // ApiAssignmentType defines model for ApiAssignmentType.
 type ApiAssignmentType string
 
+// ApiAuditEntry defines model for ApiAuditEntry.
+type ApiAuditEntry struct {
+	Action  string `json:"action"`
+	ActorId string `json:"actorId"`
+	// After JSON of the changed record after the change, missing when it was removed
+	After *string `json:"after,omitempty"`
+	// Before JSON of the changed record before the change, missing when it was created
+	Before   *string   `json:"before,omitempty"`
+	Id       string    `json:"id"`
+	TargetId string    `json:"targetId"`
+	Time     time.Time `json:"time"`
+}
+
+// ApiBadge defines model for ApiBadge.
+type ApiBadge struct {
+	Achieved     bool       `json:"achieved"`
+	AchievedDate *time.Time `json:"achievedDate,omitempty"`
+	Description  string     `json:"description"`
+	Id           string     `json:"id"`
+	Progress     int        `json:"progress"`
+	Target       int        `json:"target"`
+	Title        string     `json:"title"`
+}
+
 // ApiCreateFamilyTaskCommandArgs defines model for ApiCreateFamilyTaskCommandArgs.
 type ApiCreateFamilyTaskCommandArgs struct {
 	Task ApiFamilyTask `json:"task"`
 }
 
+// ApiCreateOwnFamilyCommandArgs defines model for ApiCreateOwnFamilyCommandArgs.
+type ApiCreateOwnFamilyCommandArgs struct {
+	FamilyName string  `json:"familyName"`
+	FirstName  *string `json:"firstName,omitempty"`
+	LastName   *string `json:"lastName,omitempty"`
+	// TimeZone IANA time zone name, e.g. Asia/Jerusalem
+	TimeZone string `json:"timeZone"`
+}
+
 // ApiCreateProblemSetCommandArgs defines model for ApiCreateProblemSetCommandArgs.
 type ApiCreateProblemSetCommandArgs struct {
+	// DailyQuota Number of problems that complete the daily assignment, defaults to 1
+	DailyQuota  *int    `json:"dailyQuota,omitempty"`
 	Description *string `json:"description,omitempty"`
 	ForUserId   string  `json:"forUserId"`
-	Title       string  `json:"title"`
+	// MaxAttempts Number of answers allowed for each problem until it is answered correctly, defaults to 1
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+	// Points Points earned for each correctly solved problem
+	Points    *int                    `json:"points,omitempty"`
+	QuotaType *ApiProblemSetQuotaType `json:"quotaType,omitempty"`
+	Title     string                  `json:"title"`
+}
+
+// ApiCreateProblemSetTemplateCommandArgs defines model for ApiCreateProblemSetTemplateCommandArgs.
+type ApiCreateProblemSetTemplateCommandArgs struct {
+	// DailyQuota Number of problems that complete the daily assignment, defaults to 1
+	DailyQuota  *int    `json:"dailyQuota,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// MaxAttempts Number of answers allowed for each problem until it is answered correctly, defaults to 1
+	MaxAttempts *int     `json:"maxAttempts,omitempty"`
+	MemberIds   []string `json:"memberIds"`
+	// Points Points earned for each correctly solved problem
+	Points    *int                    `json:"points,omitempty"`
+	QuotaType *ApiProblemSetQuotaType `json:"quotaType,omitempty"`
+	Title     string                  `json:"title"`
 }
 
 // ApiCreateProblemsInSetCommandArgs defines model for ApiCreateProblemsInSetCommandArgs.
@@ -85,11 +223,78 @@ type ApiCreateProblemsInSetCommandArgs struct {
 	Problems     []ApiProblemForEdit `json:"problems"`
 }
 
+// ApiCreateProblemsInTemplateCommandArgs defines model for ApiCreateProblemsInTemplateCommandArgs.
+type ApiCreateProblemsInTemplateCommandArgs struct {
+	Problems   []ApiProblemForEdit `json:"problems"`
+	TemplateId string              `json:"templateId"`
+}
+
+// ApiCreateRewardCommandArgs defines model for ApiCreateRewardCommandArgs.
+type ApiCreateRewardCommandArgs struct {
+	Cost        int     `json:"cost"`
+	Description *string `json:"description,omitempty"`
+	Title       string  `json:"title"`
+}
+
+// ApiDecideRewardRedemptionCommandArgs defines model for ApiDecideRewardRedemptionCommandArgs.
+type ApiDecideRewardRedemptionCommandArgs struct {
+	Approve      bool    `json:"approve"`
+	Comment      *string `json:"comment,omitempty"`
+	RedemptionId string  `json:"redemptionId"`
+}
+
+// ApiDecideTaskApprovalCommandArgs defines model for ApiDecideTaskApprovalCommandArgs.
+type ApiDecideTaskApprovalCommandArgs struct {
+	ApprovalId string  `json:"approvalId"`
+	Approve    bool    `json:"approve"`
+	Comment    *string `json:"comment,omitempty"`
+}
+
 // ApiDeleteFamilyTaskCommandArgs defines model for ApiDeleteFamilyTaskCommandArgs.
 type ApiDeleteFamilyTaskCommandArgs struct {
 	TaskId string `json:"taskId"`
 }
 
+// ApiDeleteProblemSetTemplateCommandArgs defines model for ApiDeleteProblemSetTemplateCommandArgs.
+type ApiDeleteProblemSetTemplateCommandArgs struct {
+	TemplateId string `json:"templateId"`
+}
+
+// ApiDeleteRewardCommandArgs defines model for ApiDeleteRewardCommandArgs.
+type ApiDeleteRewardCommandArgs struct {
+	RewardId string `json:"rewardId"`
+}
+
+// ApiDeleteTemplateProblemCommandArgs defines model for ApiDeleteTemplateProblemCommandArgs.
+type ApiDeleteTemplateProblemCommandArgs struct {
+	ProblemId  string `json:"problemId"`
+	TemplateId string `json:"templateId"`
+}
+
+// ApiExportProblemSetCommandArgs defines model for ApiExportProblemSetCommandArgs.
+type ApiExportProblemSetCommandArgs struct {
+	ForUserId    string                  `json:"forUserId"`
+	Format       ApiProblemSetFileFormat `json:"format"`
+	ProblemSetId string                  `json:"problemSetId"`
+}
+
+// ApiExportProblemSetCommandResult defines model for ApiExportProblemSetCommandResult.
+type ApiExportProblemSetCommandResult struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+	FileName    string `json:"fileName"`
+}
+
+// ApiFamilyInvitation defines model for ApiFamilyInvitation.
+type ApiFamilyInvitation struct {
+	Created   time.Time           `json:"created"`
+	Email     openapi_types.Email `json:"email"`
+	FirstName *string             `json:"firstName,omitempty"`
+	InvitedBy string              `json:"invitedBy"`
+	LastName  *string             `json:"lastName,omitempty"`
+	Role      ApiFamilyRole       `json:"role"`
+}
+
 // ApiFamilyRole defines model for ApiFamilyRole.
 type ApiFamilyRole string
 
@@ -97,7 +302,12 @@ type ApiFamilyRole string
 type ApiFamilyTask struct {
 	Description *string  `json:"description,omitempty"`
 	MemberIds   []string `json:"memberIds"`
-	Title       string   `json:"title"`
+	// Points Points earned each time the task is done
+	Points *int `json:"points,omitempty"`
+	// RequiresApproval Marking the task done requires an admin approval
+	RequiresApproval *bool            `json:"requiresApproval,omitempty"`
+	Schedule         *ApiTaskSchedule `json:"schedule,omitempty"`
+	Title            string           `json:"title"`
 }
 
 // ApiGenerateProblemsCommandArgs defines model for ApiGenerateProblemsCommandArgs.
@@ -107,6 +317,35 @@ type ApiGenerateProblemsCommandArgs struct {
 	UserId                string  `json:"userId"`
 }
 
+// ApiImportProblemSetCommandArgs defines model for ApiImportProblemSetCommandArgs.
+type ApiImportProblemSetCommandArgs struct {
+	Content string `json:"content"`
+	// DryRun Only validate the file, reporting the errors without importing
+	DryRun    *bool                   `json:"dryRun,omitempty"`
+	ForUserId string                  `json:"forUserId"`
+	Format    ApiProblemSetFileFormat `json:"format"`
+	// ProblemSetId Problem set to import the problems to, a new problem set is created from a json file when missing
+	ProblemSetId *string `json:"problemSetId,omitempty"`
+}
+
+// ApiImportProblemSetCommandResult defines model for ApiImportProblemSetCommandResult.
+type ApiImportProblemSetCommandResult struct {
+	Errors        []ApiProblemImportError `json:"errors"`
+	ImportedCount int                     `json:"importedCount"`
+	ProblemSetId  *string                 `json:"problemSetId,omitempty"`
+}
+
+// ApiInviteFamilyMemberCommandArgs defines model for ApiInviteFamilyMemberCommandArgs.
+type ApiInviteFamilyMemberCommandArgs struct {
+	Email     openapi_types.Email `json:"email"`
+	FirstName *string             `json:"firstName,omitempty"`
+	LastName  *string             `json:"lastName,omitempty"`
+	Role      ApiFamilyRole       `json:"role"`
+}
+
+// ApiLifecycleStatus defines model for ApiLifecycleStatus.
+type ApiLifecycleStatus string
+
 // ApiLoadProblemForAssignmentCommandArgs defines model for ApiLoadProblemForAssignmentCommandArgs.
 type ApiLoadProblemForAssignmentCommandArgs struct {
 	AssignmentId string    `json:"assignmentId"`
@@ -118,12 +357,41 @@ type ApiLoadProblemForAssignmentCommandResult struct {
 	Problem ApiProblem `json:"problem"`
 }
 
+// ApiNumericAnswer defines model for ApiNumericAnswer.
+type ApiNumericAnswer struct {
+	// Tolerance Maximal allowed distance from the value, defaults to 0
+	Tolerance *float64 `json:"tolerance,omitempty"`
+	Value     float64  `json:"value"`
+}
+
+// ApiPointsBalance defines model for ApiPointsBalance.
+type ApiPointsBalance struct {
+	Balance int `json:"balance"`
+	// Reserved Points reserved by redemptions pending approval
+	Reserved int    `json:"reserved"`
+	UserId   string `json:"userId"`
+}
+
+// ApiPointsEntry defines model for ApiPointsEntry.
+type ApiPointsEntry struct {
+	ForDate time.Time `json:"forDate"`
+	Id      string    `json:"id"`
+	Points  int       `json:"points"`
+	Reason  string    `json:"reason"`
+}
+
 // ApiProblem defines model for ApiProblem.
 type ApiProblem struct {
+	// Answers Choices to select from, or the items to arrange for ordering problems
 	Answers     []ApiProblemAnswer `json:"answers"`
 	Description *string            `json:"description,omitempty"`
-	Id          string             `json:"id"`
-	Title       string             `json:"title"`
+	// HintsCount Number of hints the problem has
+	HintsCount int    `json:"hintsCount"`
+	Id         string `json:"id"`
+	// RevealedHints Hints revealed so far today
+	RevealedHints *[]string      `json:"revealedHints,omitempty"`
+	Title         string         `json:"title"`
+	Type          ApiProblemType `json:"type"`
 }
 
 // ApiProblemAnswer defines model for ApiProblemAnswer.
@@ -143,19 +411,95 @@ type ApiProblemAnswerForEdit struct {
 
 // ApiProblemForEdit defines model for ApiProblemForEdit.
 type ApiProblemForEdit struct {
-	Answers     []ApiProblemAnswerForEdit `json:"answers"`
-	Description *string                   `json:"description,omitempty"`
-	Id          *string                   `json:"id,omitempty"`
-	Title       string                    `json:"title"`
+	// AcceptedAnswers Accepted answer variants of short text problems
+	AcceptedAnswers *[]string `json:"acceptedAnswers,omitempty"`
+	// Answers Choices for choice problems, the items in the correct order for ordering problems, empty otherwise
+	Answers []ApiProblemAnswerForEdit `json:"answers"`
+	// Archived Archived problems were solved or retired, they are not presented as new problems
+	Archived    *bool   `json:"archived,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// Explanation Explanation of the correct answer, shown once the problem is answered
+	Explanation *string `json:"explanation,omitempty"`
+	// Hints Hints revealed one by one on request, in order
+	Hints         *[]string         `json:"hints,omitempty"`
+	Id            *string           `json:"id,omitempty"`
+	NumericAnswer *ApiNumericAnswer `json:"numericAnswer,omitempty"`
+	// Order Position of the problem in the set
+	Order *int            `json:"order,omitempty"`
+	Title string          `json:"title"`
+	Type  *ApiProblemType `json:"type,omitempty"`
+}
+
+// ApiProblemImportError defines model for ApiProblemImportError.
+type ApiProblemImportError struct {
+	Message string `json:"message"`
+	// Row Problem index in a json file, or line in a csv file, 0 when the error is not of a specific problem
+	Row int `json:"row"`
 }
 
 // ApiProblemSet defines model for ApiProblemSet.
 type ApiProblemSet struct {
+	// DailyQuota Number of problems that complete the daily assignment
+	DailyQuota  *int    `json:"dailyQuota,omitempty"`
 	Description *string `json:"description,omitempty"`
 	Id          string  `json:"id"`
-	Title       string  `json:"title"`
+	// MaxAttempts Number of answers allowed for each problem until it is answered correctly
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+	// Points Points earned for each correctly solved problem
+	Points    *int                    `json:"points,omitempty"`
+	QuotaType *ApiProblemSetQuotaType `json:"quotaType,omitempty"`
+	Status    ApiLifecycleStatus      `json:"status"`
+	// TemplateId Set for a member copy of a problem set template, which is only changed through the template
+	TemplateId *string `json:"templateId,omitempty"`
+	Title      string  `json:"title"`
+}
+
+// ApiProblemSetFileFormat defines model for ApiProblemSetFileFormat.
+type ApiProblemSetFileFormat string
+
+// ApiProblemSetProgress defines model for ApiProblemSetProgress.
+type ApiProblemSetProgress struct {
+	Completed int `json:"completed"`
+	// CorrectCount Problems answered correctly, including the ones solved with hints
+	CorrectCount int `json:"correctCount"`
+	// CorrectWithHintsCount Problems answered correctly with the help of hints
+	CorrectWithHintsCount int    `json:"correctWithHintsCount"`
+	ProblemSetId          string `json:"problemSetId"`
+	Required              int    `json:"required"`
+	Title                 string `json:"title"`
+}
+
+// ApiProblemSetQuotaType Whether the daily quota counts answered problems or correct answers only
+type ApiProblemSetQuotaType string
+
+// ApiProblemSetTemplate defines model for ApiProblemSetTemplate.
+type ApiProblemSetTemplate struct {
+	// DailyQuota Number of problems that complete the daily assignment
+	DailyQuota  *int    `json:"dailyQuota,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          string  `json:"id"`
+	// MaxAttempts Number of answers allowed for each problem until it is answered correctly
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+	// MemberIds Family members solving a copy of the template
+	MemberIds []string `json:"memberIds"`
+	// Points Points earned for each correctly solved problem
+	Points    *int                    `json:"points,omitempty"`
+	QuotaType *ApiProblemSetQuotaType `json:"quotaType,omitempty"`
+	Status    ApiLifecycleStatus      `json:"status"`
+	Title     string                  `json:"title"`
+}
+
+// ApiProblemType defines model for ApiProblemType.
+type ApiProblemType string
+
+// ApiRedeemRewardCommandArgs defines model for ApiRedeemRewardCommandArgs.
+type ApiRedeemRewardCommandArgs struct {
+	RewardId string `json:"rewardId"`
 }
 
+// ApiRedemptionStatus defines model for ApiRedemptionStatus.
+type ApiRedemptionStatus string
+
 // ApiRefineProblemsCommandArgs defines model for ApiRefineProblemsCommandArgs.
 type ApiRefineProblemsCommandArgs struct {
 	ProblemSetId string              `json:"problemSetId"`
@@ -164,31 +508,219 @@ type ApiRefineProblemsCommandArgs struct {
 	UserId       string              `json:"userId"`
 }
 
-// ApiSubmitProblemAnswerCommandArgs defines model for ApiSubmitProblemAnswerCommandArgs.
-type ApiSubmitProblemAnswerCommandArgs struct {
-	AnswerId     string `json:"answerId"`
+// ApiRemoveFamilyMemberCommandArgs defines model for ApiRemoveFamilyMemberCommandArgs.
+type ApiRemoveFamilyMemberCommandArgs struct {
+	UserId string `json:"userId"`
+}
+
+// ApiReorderProblemsCommandArgs defines model for ApiReorderProblemsCommandArgs.
+type ApiReorderProblemsCommandArgs struct {
+	ForUserId string `json:"forUserId"`
+	// ProblemIds All active problems of the set, in the new order
+	ProblemIds   []string `json:"problemIds"`
+	ProblemSetId string   `json:"problemSetId"`
+}
+
+// ApiReorderTemplateProblemsCommandArgs defines model for ApiReorderTemplateProblemsCommandArgs.
+type ApiReorderTemplateProblemsCommandArgs struct {
+	// ProblemIds All problems of the template, in the new order
+	ProblemIds []string `json:"problemIds"`
+	TemplateId string   `json:"templateId"`
+}
+
+// ApiRevealProblemHintCommandArgs defines model for ApiRevealProblemHintCommandArgs.
+type ApiRevealProblemHintCommandArgs struct {
 	AssignmentId string `json:"assignmentId"`
 	ProblemId    string `json:"problemId"`
 }
 
+// ApiRevealProblemHintCommandResp defines model for ApiRevealProblemHintCommandResp.
+type ApiRevealProblemHintCommandResp struct {
+	HintsCount int `json:"hintsCount"`
+	// RevealedHints All hints revealed so far, the last one is the newly revealed hint
+	RevealedHints []string `json:"revealedHints"`
+}
+
+// ApiRevokeFamilyInvitationCommandArgs defines model for ApiRevokeFamilyInvitationCommandArgs.
+type ApiRevokeFamilyInvitationCommandArgs struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// ApiReward defines model for ApiReward.
+type ApiReward struct {
+	Cost        int     `json:"cost"`
+	Description *string `json:"description,omitempty"`
+	Id          string  `json:"id"`
+	Title       string  `json:"title"`
+}
+
+// ApiRewardRedemption defines model for ApiRewardRedemption.
+type ApiRewardRedemption struct {
+	Comment     *string             `json:"comment,omitempty"`
+	Cost        int                 `json:"cost"`
+	DecidedAt   *time.Time          `json:"decidedAt,omitempty"`
+	DecidedBy   *string             `json:"decidedBy,omitempty"`
+	Id          string              `json:"id"`
+	Requested   time.Time           `json:"requested"`
+	RewardId    string              `json:"rewardId"`
+	RewardTitle string              `json:"rewardTitle"`
+	Status      ApiRedemptionStatus `json:"status"`
+	UserId      string              `json:"userId"`
+}
+
+// ApiStreak defines model for ApiStreak.
+type ApiStreak struct {
+	Best    int `json:"best"`
+	Current int `json:"current"`
+}
+
+// ApiSubmitProblemAnswerCommandArgs defines model for ApiSubmitProblemAnswerCommandArgs.
+type ApiSubmitProblemAnswerCommandArgs struct {
+	// AnswerId Selected answer of single choice problems
+	AnswerId *string `json:"answerId,omitempty"`
+	// AnswerIds Selected answers of multiple select problems, or all items in the submitted order for ordering problems
+	AnswerIds    *[]string `json:"answerIds,omitempty"`
+	AssignmentId string    `json:"assignmentId"`
+	ProblemId    string    `json:"problemId"`
+	// Value Answer of numeric and short text problems
+	Value *string `json:"value,omitempty"`
+}
+
 // ApiSubmitProblemAnswerCommandResp defines model for ApiSubmitProblemAnswerCommandResp.
 type ApiSubmitProblemAnswerCommandResp struct {
-	CorrectAnswerId string  `json:"correctAnswerId"`
-	Explanation     *string `json:"explanation,omitempty"`
-	IsCorrect       bool    `json:"isCorrect"`
+	// CorrectAnswerId Correct answer of single choice problems, empty for other problem types or while attempts are left
+	CorrectAnswerId string `json:"correctAnswerId"`
+	// CorrectAnswerIds Correct answers of multiple select problems, or the items in the correct order for ordering problems
+	CorrectAnswerIds *[]string `json:"correctAnswerIds,omitempty"`
+	// CorrectValue Correct answer of numeric and short text problems
+	CorrectValue *string `json:"correctValue,omitempty"`
+	Explanation  *string `json:"explanation,omitempty"`
+	IsCorrect    bool    `json:"isCorrect"`
+	// RemainingAttempts Attempts left for a wrong answer, the correct answer and explanation are only revealed when none are left
+	RemainingAttempts int `json:"remainingAttempts"`
+}
+
+// ApiSwitchFamilyCommandArgs defines model for ApiSwitchFamilyCommandArgs.
+type ApiSwitchFamilyCommandArgs struct {
+	FamilyId string `json:"familyId"`
+}
+
+// ApiTaskApproval defines model for ApiTaskApproval.
+type ApiTaskApproval struct {
+	Claimed   time.Time `json:"claimed"`
+	Comment   *string   `json:"comment,omitempty"`
+	ForDate   time.Time `json:"forDate"`
+	Id        string    `json:"id"`
+	TaskId    string    `json:"taskId"`
+	TaskTitle string    `json:"taskTitle"`
+	UserId    string    `json:"userId"`
+}
+
+// ApiTaskSchedule When a task is due, a task without a schedule is due every day
+type ApiTaskSchedule struct {
+	EndDate *time.Time `json:"endDate,omitempty"`
+	// EveryNDays Task is due every N days, counting from the start date (or the task creation date)
+	EveryNDays *int `json:"everyNDays,omitempty"`
+	// ExcludedDates Specific dates the task is not due on (e.g. holidays)
+	ExcludedDates *[]time.Time `json:"excludedDates,omitempty"`
+	StartDate     *time.Time   `json:"startDate,omitempty"`
+	// WeekDays Days of the week the task is due on, all days when empty
+	WeekDays *[]ApiWeekDay `json:"weekDays,omitempty"`
 }
 
 // ApiTaskStats defines model for ApiTaskStats.
 type ApiTaskStats struct {
-	DoneTasksCount  int       `json:"doneTasksCount"`
-	ForDate         time.Time `json:"forDate"`
-	TotalTasksCount int       `json:"totalTasksCount"`
-	UserId          string    `json:"userId"`
+	// ClaimedTasksCount Tasks marked done, including the ones pending approval
+	ClaimedTasksCount int `json:"claimedTasksCount"`
+	// DoneTasksCount Tasks done, not including the ones pending approval
+	DoneTasksCount  int                      `json:"doneTasksCount"`
+	ForDate         time.Time                `json:"forDate"`
+	ProblemSets     *[]ApiProblemSetProgress `json:"problemSets,omitempty"`
+	TotalTasksCount int                      `json:"totalTasksCount"`
+	UserId          string                   `json:"userId"`
+}
+
+// ApiUpdateFamilyMemberRoleCommandArgs defines model for ApiUpdateFamilyMemberRoleCommandArgs.
+type ApiUpdateFamilyMemberRoleCommandArgs struct {
+	Role   ApiFamilyRole `json:"role"`
+	UserId string        `json:"userId"`
+}
+
+// ApiUpdateFamilySettingsCommandArgs defines model for ApiUpdateFamilySettingsCommandArgs.
+type ApiUpdateFamilySettingsCommandArgs struct {
+	// AiFamilyDailyCallsLimit How many AI calls the whole family can make a day, unchanged when missing
+	AiFamilyDailyCallsLimit *int `json:"aiFamilyDailyCallsLimit,omitempty"`
+	// AiUserDailyCallsLimit How many AI calls each family member can make a day, unchanged when missing
+	AiUserDailyCallsLimit *int `json:"aiUserDailyCallsLimit,omitempty"`
+	// TaskBackEditDays How many days back admins can update the task status of family members
+	TaskBackEditDays int `json:"taskBackEditDays"`
 }
 
 // ApiUpdateFamilyTaskCommandArgs defines model for ApiUpdateFamilyTaskCommandArgs.
 type ApiUpdateFamilyTaskCommandArgs struct {
-	Task ApiFamilyTask `json:"task"`
+	Task   ApiFamilyTask `json:"task"`
+	TaskId string        `json:"taskId"`
+}
+
+// ApiUpdateMemberTaskStatusCommandArgs defines model for ApiUpdateMemberTaskStatusCommandArgs.
+type ApiUpdateMemberTaskStatusCommandArgs struct {
+	Comment *string             `json:"comment,omitempty"`
+	ForDate time.Time           `json:"forDate"`
+	Status  ApiAssignmentStatus `json:"status"`
+	TaskId  string              `json:"taskId"`
+	UserId  string              `json:"userId"`
+}
+
+// ApiUpdateProblemCommandArgs defines model for ApiUpdateProblemCommandArgs.
+type ApiUpdateProblemCommandArgs struct {
+	ForUserId    string            `json:"forUserId"`
+	Problem      ApiProblemForEdit `json:"problem"`
+	ProblemId    string            `json:"problemId"`
+	ProblemSetId string            `json:"problemSetId"`
+}
+
+// ApiUpdateProblemSetCommandArgs defines model for ApiUpdateProblemSetCommandArgs.
+type ApiUpdateProblemSetCommandArgs struct {
+	// DailyQuota Number of problems that complete the daily assignment, defaults to 1
+	DailyQuota  *int    `json:"dailyQuota,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ForUserId   string  `json:"forUserId"`
+	// MaxAttempts Number of answers allowed for each problem until it is answered correctly, defaults to 1
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+	// Points Points earned for each correctly solved problem
+	Points       *int                    `json:"points,omitempty"`
+	ProblemSetId string                  `json:"problemSetId"`
+	QuotaType    *ApiProblemSetQuotaType `json:"quotaType,omitempty"`
+	Title        string                  `json:"title"`
+}
+
+// ApiUpdateProblemSetStatusCommandArgs defines model for ApiUpdateProblemSetStatusCommandArgs.
+type ApiUpdateProblemSetStatusCommandArgs struct {
+	ForUserId    string             `json:"forUserId"`
+	ProblemSetId string             `json:"problemSetId"`
+	Status       ApiLifecycleStatus `json:"status"`
+}
+
+// ApiUpdateProblemSetTemplateCommandArgs defines model for ApiUpdateProblemSetTemplateCommandArgs.
+type ApiUpdateProblemSetTemplateCommandArgs struct {
+	// DailyQuota Number of problems that complete the daily assignment, defaults to 1
+	DailyQuota  *int    `json:"dailyQuota,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// MaxAttempts Number of answers allowed for each problem until it is answered correctly, defaults to 1
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+	// Points Points earned for each correctly solved problem
+	Points     *int                    `json:"points,omitempty"`
+	QuotaType  *ApiProblemSetQuotaType `json:"quotaType,omitempty"`
+	TemplateId string                  `json:"templateId"`
+	Title      string                  `json:"title"`
+}
+
+// ApiUpdateProblemStatusCommandArgs defines model for ApiUpdateProblemStatusCommandArgs.
+type ApiUpdateProblemStatusCommandArgs struct {
+	ForUserId    string             `json:"forUserId"`
+	ProblemId    string             `json:"problemId"`
+	ProblemSetId string             `json:"problemSetId"`
+	Status       ApiLifecycleStatus `json:"status"`
 }
 
 // ApiUpdateTaskStatusCommandArgs defines model for ApiUpdateTaskStatusCommandArgs.
@@ -199,23 +731,56 @@ type ApiUpdateTaskStatusCommandArgs struct {
 	TaskId  string              `json:"taskId"`
 }
 
+// ApiUpdateTemplateProblemCommandArgs defines model for ApiUpdateTemplateProblemCommandArgs.
+type ApiUpdateTemplateProblemCommandArgs struct {
+	Problem    ApiProblemForEdit `json:"problem"`
+	ProblemId  string            `json:"problemId"`
+	TemplateId string            `json:"templateId"`
+}
+
+// ApiUserAchievements defines model for ApiUserAchievements.
+type ApiUserAchievements struct {
+	Badges              []ApiBadge `json:"badges"`
+	ProblemsSolvedCount int        `json:"problemsSolvedCount"`
+	ProblemsStreak      ApiStreak  `json:"problemsStreak"`
+	TasksStreak         ApiStreak  `json:"tasksStreak"`
+	UserId              string     `json:"userId"`
+}
+
 // ApiUserProblemSolution defines model for ApiUserProblemSolution.
 type ApiUserProblemSolution struct {
-	Correct              bool      `json:"correct"`
-	CorrectAnswerId      string    `json:"correctAnswerId"`
-	ProblemId            string    `json:"problemId"`
-	ProblemTitle         string    `json:"problemTitle"`
-	SolvedDate           time.Time `json:"solvedDate"`
-	UserProvidedAnswerId string    `json:"userProvidedAnswerId"`
-}
+	// AttemptsCount Number of answers submitted, the user provided answer is the last one
+	AttemptsCount   int    `json:"attemptsCount"`
+	Correct         bool   `json:"correct"`
+	CorrectAnswerId string `json:"correctAnswerId"`
+	// HintsUsed Number of hints revealed before answering, 0 when solved alone
+	HintsUsed int `json:"hintsUsed"`
+	// InProgress Answered wrong with attempts left, the problem is presented again
+	InProgress            bool      `json:"inProgress"`
+	ProblemId             string    `json:"problemId"`
+	ProblemTitle          string    `json:"problemTitle"`
+	SolvedDate            time.Time `json:"solvedDate"`
+	UserProvidedAnswerId  string    `json:"userProvidedAnswerId"`
+	UserProvidedAnswerIds *[]string `json:"userProvidedAnswerIds,omitempty"`
+	UserProvidedValue     *string   `json:"userProvidedValue,omitempty"`
+}
+
+// ApiWeekDay defines model for ApiWeekDay.
+type ApiWeekDay string
 
 // UIFamilyInfo Family info
 type UIFamilyInfo struct {
-	AdminEmail        openapi_types.Email `json:"adminEmail"`
-	FamilyDisplayName string              `json:"familyDisplayName"`
-	FamilyUri         string              `json:"familyUri"`
-	Members           []UIFamilyMember    `json:"members"`
-	Tasks             []UIFamilyTask      `json:"tasks"`
+	AdminEmail openapi_types.Email `json:"adminEmail"`
+	// AiFamilyDailyCallsLimit How many AI calls the whole family can make a day
+	AiFamilyDailyCallsLimit int `json:"aiFamilyDailyCallsLimit"`
+	// AiUserDailyCallsLimit How many AI calls each family member can make a day
+	AiUserDailyCallsLimit int              `json:"aiUserDailyCallsLimit"`
+	FamilyDisplayName     string           `json:"familyDisplayName"`
+	FamilyUri             string           `json:"familyUri"`
+	Members               []UIFamilyMember `json:"members"`
+	// TaskBackEditDays How many days back admins can update the task status of family members
+	TaskBackEditDays int            `json:"taskBackEditDays"`
+	Tasks            []UIFamilyTask `json:"tasks"`
 }
 
 // UIFamilyMember Family member
@@ -231,7 +796,21 @@ type UIFamilyTask struct {
 	Description *string  `json:"description,omitempty"`
 	Id          string   `json:"id"`
 	MemberIds   []string `json:"memberIds"`
-	Title       string   `json:"title"`
+	// Points Points earned each time the task is done
+	Points *int `json:"points,omitempty"`
+	// RequiresApproval Marking the task done requires an admin approval
+	RequiresApproval *bool            `json:"requiresApproval,omitempty"`
+	Schedule         *ApiTaskSchedule `json:"schedule,omitempty"`
+	Title            string           `json:"title"`
+}
+
+// UIUserFamily A family the user is a member of
+type UIUserFamily struct {
+	// Active True for the family currently selected in the user session
+	Active bool          `json:"active"`
+	Id     string        `json:"id"`
+	Name   string        `json:"name"`
+	Role   ApiFamilyRole `json:"role"`
 }
 
 // UIUserInfo User Info
@@ -239,18 +818,68 @@ type UIUserInfo struct {
 	Email     openapi_types.Email `json:"email"`
 	FirstName *string             `json:"firstName,omitempty"`
 	LastName  *string             `json:"lastName,omitempty"`
-	Role      UIUserRole          `json:"role"`
+	// OnboardingRequired True when the user is not part of any family yet and must create one or be invited
+	OnboardingRequired *bool      `json:"onboardingRequired,omitempty"`
+	Role               UIUserRole `json:"role"`
 }
 
 // UIUserRole defines model for UIUserRole.
 type UIUserRole string
 
+// GetAiUsageReportParams defines parameters for GetAiUsageReport.
+type GetAiUsageReportParams struct {
+	// From From date
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To To date
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListAuditLogParams defines parameters for ListAuditLog.
+type ListAuditLogParams struct {
+	// From From date
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To To date
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// ActorId Only list changes made by this user
+	ActorId *string `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// Action Only list changes of this action, e.g. task.create
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetId Only list changes of this target
+	TargetId *string `form:"targetId,omitempty" json:"targetId,omitempty"`
+}
+
+// ListPointsLedgerParams defines parameters for ListPointsLedger.
+type ListPointsLedgerParams struct {
+	// From From date
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To To date
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListProblemSetProblemsParams defines parameters for ListProblemSetProblems.
+type ListProblemSetProblemsParams struct {
+	// IncludeArchived Include archived problems, solved problems are archived
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
 // ListUserFamilyProblemSetsParams defines parameters for ListUserFamilyProblemSets.
 type ListUserFamilyProblemSetsParams struct {
 	// UserId User ID
 	UserId string `form:"userId" json:"userId"`
 }
 
+// ListRewardRedemptionsParams defines parameters for ListRewardRedemptions.
+type ListRewardRedemptionsParams struct {
+	// Status Only list redemptions with this status
+	Status *ApiRedemptionStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // GetStatsParams defines parameters for GetStats.
 type GetStatsParams struct {
 	// From From date
@@ -260,74 +889,272 @@ type GetStatsParams struct {
 	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// AddFamilyMemberJSONRequestBody defines body for AddFamilyMember for application/json ContentType.
+type AddFamilyMemberJSONRequestBody = ApiAddFamilyMemberCommandArgs
+
+// AssignProblemSetTemplateJSONRequestBody defines body for AssignProblemSetTemplate for application/json ContentType.
+type AssignProblemSetTemplateJSONRequestBody = ApiAssignProblemSetTemplateCommandArgs
+
 // CreateFamilyTaskJSONRequestBody defines body for CreateFamilyTask for application/json ContentType.
 type CreateFamilyTaskJSONRequestBody = ApiCreateFamilyTaskCommandArgs
 
+// CreateOwnFamilyJSONRequestBody defines body for CreateOwnFamily for application/json ContentType.
+type CreateOwnFamilyJSONRequestBody = ApiCreateOwnFamilyCommandArgs
+
 // CreateProblemsInSetJSONRequestBody defines body for CreateProblemsInSet for application/json ContentType.
 type CreateProblemsInSetJSONRequestBody = ApiCreateProblemsInSetCommandArgs
 
+// CreateProblemsInTemplateJSONRequestBody defines body for CreateProblemsInTemplate for application/json ContentType.
+type CreateProblemsInTemplateJSONRequestBody = ApiCreateProblemsInTemplateCommandArgs
+
 // CreateProblemSetJSONRequestBody defines body for CreateProblemSet for application/json ContentType.
 type CreateProblemSetJSONRequestBody = ApiCreateProblemSetCommandArgs
 
+// CreateProblemSetTemplateJSONRequestBody defines body for CreateProblemSetTemplate for application/json ContentType.
+type CreateProblemSetTemplateJSONRequestBody = ApiCreateProblemSetTemplateCommandArgs
+
+// CreateRewardJSONRequestBody defines body for CreateReward for application/json ContentType.
+type CreateRewardJSONRequestBody = ApiCreateRewardCommandArgs
+
+// DecideRewardRedemptionJSONRequestBody defines body for DecideRewardRedemption for application/json ContentType.
+type DecideRewardRedemptionJSONRequestBody = ApiDecideRewardRedemptionCommandArgs
+
+// DecideTaskApprovalJSONRequestBody defines body for DecideTaskApproval for application/json ContentType.
+type DecideTaskApprovalJSONRequestBody = ApiDecideTaskApprovalCommandArgs
+
 // DeleteFamilyTaskJSONRequestBody defines body for DeleteFamilyTask for application/json ContentType.
 type DeleteFamilyTaskJSONRequestBody = ApiDeleteFamilyTaskCommandArgs
 
+// DeleteProblemSetTemplateJSONRequestBody defines body for DeleteProblemSetTemplate for application/json ContentType.
+type DeleteProblemSetTemplateJSONRequestBody = ApiDeleteProblemSetTemplateCommandArgs
+
+// DeleteRewardJSONRequestBody defines body for DeleteReward for application/json ContentType.
+type DeleteRewardJSONRequestBody = ApiDeleteRewardCommandArgs
+
+// DeleteTemplateProblemJSONRequestBody defines body for DeleteTemplateProblem for application/json ContentType.
+type DeleteTemplateProblemJSONRequestBody = ApiDeleteTemplateProblemCommandArgs
+
+// ExportProblemSetJSONRequestBody defines body for ExportProblemSet for application/json ContentType.
+type ExportProblemSetJSONRequestBody = ApiExportProblemSetCommandArgs
+
 // GenerateProblemsJSONRequestBody defines body for GenerateProblems for application/json ContentType.
 type GenerateProblemsJSONRequestBody = ApiGenerateProblemsCommandArgs
 
+// ImportProblemSetJSONRequestBody defines body for ImportProblemSet for application/json ContentType.
+type ImportProblemSetJSONRequestBody = ApiImportProblemSetCommandArgs
+
+// InviteFamilyMemberJSONRequestBody defines body for InviteFamilyMember for application/json ContentType.
+type InviteFamilyMemberJSONRequestBody = ApiInviteFamilyMemberCommandArgs
+
 // LoadProblemForAssignmentJSONRequestBody defines body for LoadProblemForAssignment for application/json ContentType.
 type LoadProblemForAssignmentJSONRequestBody = ApiLoadProblemForAssignmentCommandArgs
 
+// RedeemRewardJSONRequestBody defines body for RedeemReward for application/json ContentType.
+type RedeemRewardJSONRequestBody = ApiRedeemRewardCommandArgs
+
 // RefineProblemsJSONRequestBody defines body for RefineProblems for application/json ContentType.
 type RefineProblemsJSONRequestBody = ApiRefineProblemsCommandArgs
 
+// RemoveFamilyMemberJSONRequestBody defines body for RemoveFamilyMember for application/json ContentType.
+type RemoveFamilyMemberJSONRequestBody = ApiRemoveFamilyMemberCommandArgs
+
+// ReorderProblemsJSONRequestBody defines body for ReorderProblems for application/json ContentType.
+type ReorderProblemsJSONRequestBody = ApiReorderProblemsCommandArgs
+
+// ReorderTemplateProblemsJSONRequestBody defines body for ReorderTemplateProblems for application/json ContentType.
+type ReorderTemplateProblemsJSONRequestBody = ApiReorderTemplateProblemsCommandArgs
+
+// RevealProblemHintJSONRequestBody defines body for RevealProblemHint for application/json ContentType.
+type RevealProblemHintJSONRequestBody = ApiRevealProblemHintCommandArgs
+
+// RevokeFamilyInvitationJSONRequestBody defines body for RevokeFamilyInvitation for application/json ContentType.
+type RevokeFamilyInvitationJSONRequestBody = ApiRevokeFamilyInvitationCommandArgs
+
 // SubmitProblemAnswerJSONRequestBody defines body for SubmitProblemAnswer for application/json ContentType.
 type SubmitProblemAnswerJSONRequestBody = ApiSubmitProblemAnswerCommandArgs
 
+// SwitchFamilyJSONRequestBody defines body for SwitchFamily for application/json ContentType.
+type SwitchFamilyJSONRequestBody = ApiSwitchFamilyCommandArgs
+
+// UpdateFamilyMemberRoleJSONRequestBody defines body for UpdateFamilyMemberRole for application/json ContentType.
+type UpdateFamilyMemberRoleJSONRequestBody = ApiUpdateFamilyMemberRoleCommandArgs
+
+// UpdateFamilySettingsJSONRequestBody defines body for UpdateFamilySettings for application/json ContentType.
+type UpdateFamilySettingsJSONRequestBody = ApiUpdateFamilySettingsCommandArgs
+
 // UpdateFamilyTaskJSONRequestBody defines body for UpdateFamilyTask for application/json ContentType.
 type UpdateFamilyTaskJSONRequestBody = ApiUpdateFamilyTaskCommandArgs
 
+// UpdateMemberTaskStatusJSONRequestBody defines body for UpdateMemberTaskStatus for application/json ContentType.
+type UpdateMemberTaskStatusJSONRequestBody = ApiUpdateMemberTaskStatusCommandArgs
+
+// UpdateProblemJSONRequestBody defines body for UpdateProblem for application/json ContentType.
+type UpdateProblemJSONRequestBody = ApiUpdateProblemCommandArgs
+
+// UpdateProblemStatusJSONRequestBody defines body for UpdateProblemStatus for application/json ContentType.
+type UpdateProblemStatusJSONRequestBody = ApiUpdateProblemStatusCommandArgs
+
+// UpdateProblemSetJSONRequestBody defines body for UpdateProblemSet for application/json ContentType.
+type UpdateProblemSetJSONRequestBody = ApiUpdateProblemSetCommandArgs
+
+// UpdateProblemSetStatusJSONRequestBody defines body for UpdateProblemSetStatus for application/json ContentType.
+type UpdateProblemSetStatusJSONRequestBody = ApiUpdateProblemSetStatusCommandArgs
+
+// UpdateProblemSetTemplateJSONRequestBody defines body for UpdateProblemSetTemplate for application/json ContentType.
+type UpdateProblemSetTemplateJSONRequestBody = ApiUpdateProblemSetTemplateCommandArgs
+
 // UpdateTaskStatusJSONRequestBody defines body for UpdateTaskStatus for application/json ContentType.
 type UpdateTaskStatusJSONRequestBody = ApiUpdateTaskStatusCommandArgs
 
+// UpdateTemplateProblemJSONRequestBody defines body for UpdateTemplateProblem for application/json ContentType.
+type UpdateTemplateProblemJSONRequestBody = ApiUpdateTemplateProblemCommandArgs
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /api/ai-usage)
+	GetAiUsageReport(w http.ResponseWriter, r *http.Request, params GetAiUsageReportParams)
+
 	// (GET /api/assignments)
 	ListAssignments(w http.ResponseWriter, r *http.Request)
 
+	// (GET /api/audit-log)
+	ListAuditLog(w http.ResponseWriter, r *http.Request, params ListAuditLogParams)
+
+	// (POST /api/commands/add-family-member)
+	AddFamilyMember(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/assign-problemset-template)
+	AssignProblemSetTemplate(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/create-family-task)
 	CreateFamilyTask(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/create-own-family)
+	CreateOwnFamily(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/create-problems-in-set)
 	CreateProblemsInSet(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/create-problems-in-template)
+	CreateProblemsInTemplate(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/create-problemset)
 	CreateProblemSet(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/create-problemset-template)
+	CreateProblemSetTemplate(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/create-reward)
+	CreateReward(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/decide-reward-redemption)
+	DecideRewardRedemption(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/decide-task-approval)
+	DecideTaskApproval(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/delete-family-task)
 	DeleteFamilyTask(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/delete-problemset-template)
+	DeleteProblemSetTemplate(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/delete-reward)
+	DeleteReward(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/delete-template-problem)
+	DeleteTemplateProblem(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/export-problem-set)
+	ExportProblemSet(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/generate-problems)
 	GenerateProblems(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/import-problem-set)
+	ImportProblemSet(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/invite-family-member)
+	InviteFamilyMember(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/load-problem-for-assignment)
 	LoadProblemForAssignment(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/redeem-reward)
+	RedeemReward(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/refine-problems)
 	RefineProblems(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/remove-family-member)
+	RemoveFamilyMember(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/reorder-problems)
+	ReorderProblems(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/reorder-template-problems)
+	ReorderTemplateProblems(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/reveal-problem-hint)
+	RevealProblemHint(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/revoke-family-invitation)
+	RevokeFamilyInvitation(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/submit-problem-answer)
 	SubmitProblemAnswer(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/switch-family)
+	SwitchFamily(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/update-family-member-role)
+	UpdateFamilyMemberRole(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/update-family-settings)
+	UpdateFamilySettings(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/update-family-task)
 	UpdateFamilyTask(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/update-member-task-status)
+	UpdateMemberTaskStatus(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/update-problem)
+	UpdateProblem(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/update-problem-status)
+	UpdateProblemStatus(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/update-problemset)
+	UpdateProblemSet(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/update-problemset-status)
+	UpdateProblemSetStatus(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/commands/update-problemset-template)
+	UpdateProblemSetTemplate(w http.ResponseWriter, r *http.Request)
+
 	// (POST /api/commands/update-task-status)
 	UpdateTaskStatus(w http.ResponseWriter, r *http.Request)
 
+	// (POST /api/commands/update-template-problem)
+	UpdateTemplateProblem(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/family-invitations)
+	ListFamilyInvitations(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/family-members/{userId}/achievements)
+	GetAchievements(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /api/family-members/{userId}/points)
+	GetPointsBalance(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /api/family-members/{userId}/points-ledger)
+	ListPointsLedger(w http.ResponseWriter, r *http.Request, userId string, params ListPointsLedgerParams)
+
 	// (GET /api/family-members/{userId}/problem-sets/{problemSetId}/problems-for-edit)
-	ListProblemSetProblems(w http.ResponseWriter, r *http.Request, userId string, problemSetId string)
+	ListProblemSetProblems(w http.ResponseWriter, r *http.Request, userId string, problemSetId string, params ListProblemSetProblemsParams)
 
 	// (GET /api/family-members/{userId}/problem-sets/{problemSetId}/problems/{problemId})
 	GetProblem(w http.ResponseWriter, r *http.Request, userId string, problemSetId string, problemId string)
@@ -338,9 +1165,27 @@ type ServerInterface interface {
 	// (GET /api/family-problem-sets/{problemSetId}/{userId}/solutions)
 	ListUserProblemsSolutions(w http.ResponseWriter, r *http.Request, problemSetId string, userId string)
 
+	// (GET /api/problem-set-templates)
+	ListProblemSetTemplates(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/problem-set-templates/{templateId}/problems-for-edit)
+	ListProblemSetTemplateProblems(w http.ResponseWriter, r *http.Request, templateId string)
+
+	// (GET /api/reward-redemptions)
+	ListRewardRedemptions(w http.ResponseWriter, r *http.Request, params ListRewardRedemptionsParams)
+
+	// (GET /api/rewards)
+	ListRewards(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/stats)
 	GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams)
 
+	// (GET /api/task-approvals)
+	ListPendingTaskApprovals(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/ui/families)
+	ListUserFamilies(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/ui/familyInfo)
 	GetFamilyInfo(w http.ResponseWriter, r *http.Request)
 
@@ -357,27 +1202,33 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// ListAssignments operation middleware
-func (siw *ServerInterfaceWrapper) ListAssignments(w http.ResponseWriter, r *http.Request) {
+// GetAiUsageReport operation middleware
+func (siw *ServerInterfaceWrapper) GetAiUsageReport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAssignments(w, r)
-	}))
+	var err error
 
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAiUsageReportParams
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "from" -------------
 
-// CreateFamilyTask operation middleware
-func (siw *ServerInterfaceWrapper) CreateFamilyTask(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateFamilyTask(w, r)
+		siw.Handler.GetAiUsageReport(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -387,12 +1238,12 @@ func (siw *ServerInterfaceWrapper) CreateFamilyTask(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateProblemsInSet operation middleware
-func (siw *ServerInterfaceWrapper) CreateProblemsInSet(w http.ResponseWriter, r *http.Request) {
+// ListAssignments operation middleware
+func (siw *ServerInterfaceWrapper) ListAssignments(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProblemsInSet(w, r)
+		siw.Handler.ListAssignments(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -402,12 +1253,57 @@ func (siw *ServerInterfaceWrapper) CreateProblemsInSet(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateProblemSet operation middleware
-func (siw *ServerInterfaceWrapper) CreateProblemSet(w http.ResponseWriter, r *http.Request) {
+// ListAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditLog(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditLogParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorId", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actorId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "targetId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetId", r.URL.Query(), &params.TargetId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProblemSet(w, r)
+		siw.Handler.ListAuditLog(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -417,12 +1313,12 @@ func (siw *ServerInterfaceWrapper) CreateProblemSet(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteFamilyTask operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFamilyTask(w http.ResponseWriter, r *http.Request) {
+// AddFamilyMember operation middleware
+func (siw *ServerInterfaceWrapper) AddFamilyMember(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteFamilyTask(w, r)
+		siw.Handler.AddFamilyMember(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -432,12 +1328,12 @@ func (siw *ServerInterfaceWrapper) DeleteFamilyTask(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GenerateProblems operation middleware
-func (siw *ServerInterfaceWrapper) GenerateProblems(w http.ResponseWriter, r *http.Request) {
+// AssignProblemSetTemplate operation middleware
+func (siw *ServerInterfaceWrapper) AssignProblemSetTemplate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GenerateProblems(w, r)
+		siw.Handler.AssignProblemSetTemplate(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -447,12 +1343,12 @@ func (siw *ServerInterfaceWrapper) GenerateProblems(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// LoadProblemForAssignment operation middleware
-func (siw *ServerInterfaceWrapper) LoadProblemForAssignment(w http.ResponseWriter, r *http.Request) {
+// CreateFamilyTask operation middleware
+func (siw *ServerInterfaceWrapper) CreateFamilyTask(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LoadProblemForAssignment(w, r)
+		siw.Handler.CreateFamilyTask(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -462,12 +1358,12 @@ func (siw *ServerInterfaceWrapper) LoadProblemForAssignment(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RefineProblems operation middleware
-func (siw *ServerInterfaceWrapper) RefineProblems(w http.ResponseWriter, r *http.Request) {
+// CreateOwnFamily operation middleware
+func (siw *ServerInterfaceWrapper) CreateOwnFamily(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RefineProblems(w, r)
+		siw.Handler.CreateOwnFamily(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -477,12 +1373,12 @@ func (siw *ServerInterfaceWrapper) RefineProblems(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// SubmitProblemAnswer operation middleware
-func (siw *ServerInterfaceWrapper) SubmitProblemAnswer(w http.ResponseWriter, r *http.Request) {
+// CreateProblemsInSet operation middleware
+func (siw *ServerInterfaceWrapper) CreateProblemsInSet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SubmitProblemAnswer(w, r)
+		siw.Handler.CreateProblemsInSet(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -492,12 +1388,12 @@ func (siw *ServerInterfaceWrapper) SubmitProblemAnswer(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateFamilyTask operation middleware
-func (siw *ServerInterfaceWrapper) UpdateFamilyTask(w http.ResponseWriter, r *http.Request) {
+// CreateProblemsInTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateProblemsInTemplate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateFamilyTask(w, r)
+		siw.Handler.CreateProblemsInTemplate(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -507,12 +1403,12 @@ func (siw *ServerInterfaceWrapper) UpdateFamilyTask(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateTaskStatus operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTaskStatus(w http.ResponseWriter, r *http.Request) {
+// CreateProblemSet operation middleware
+func (siw *ServerInterfaceWrapper) CreateProblemSet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTaskStatus(w, r)
+		siw.Handler.CreateProblemSet(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -522,32 +1418,27 @@ func (siw *ServerInterfaceWrapper) UpdateTaskStatus(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListProblemSetProblems operation middleware
-func (siw *ServerInterfaceWrapper) ListProblemSetProblems(w http.ResponseWriter, r *http.Request) {
+// CreateProblemSetTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateProblemSetTemplate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProblemSetTemplate(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	// ------------- Path parameter "problemSetId" -------------
-	var problemSetId string
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "problemSetId", mux.Vars(r)["problemSetId"], &problemSetId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "problemSetId", Err: err})
-		return
-	}
+// CreateReward operation middleware
+func (siw *ServerInterfaceWrapper) CreateReward(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProblemSetProblems(w, r, userId, problemSetId)
+		siw.Handler.CreateReward(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -557,41 +1448,42 @@ func (siw *ServerInterfaceWrapper) ListProblemSetProblems(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetProblem operation middleware
-func (siw *ServerInterfaceWrapper) GetProblem(w http.ResponseWriter, r *http.Request) {
+// DecideRewardRedemption operation middleware
+func (siw *ServerInterfaceWrapper) DecideRewardRedemption(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DecideRewardRedemption(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	// ------------- Path parameter "problemSetId" -------------
-	var problemSetId string
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "problemSetId", mux.Vars(r)["problemSetId"], &problemSetId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "problemSetId", Err: err})
-		return
-	}
+// DecideTaskApproval operation middleware
+func (siw *ServerInterfaceWrapper) DecideTaskApproval(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// ------------- Path parameter "problemId" -------------
-	var problemId string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DecideTaskApproval(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "problemId", mux.Vars(r)["problemId"], &problemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "problemId", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteFamilyTask operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFamilyTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProblem(w, r, userId, problemSetId, problemId)
+		siw.Handler.DeleteFamilyTask(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -601,32 +1493,42 @@ func (siw *ServerInterfaceWrapper) GetProblem(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListUserFamilyProblemSets operation middleware
-func (siw *ServerInterfaceWrapper) ListUserFamilyProblemSets(w http.ResponseWriter, r *http.Request) {
+// DeleteProblemSetTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProblemSetTemplate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProblemSetTemplate(w, r)
+	}))
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUserFamilyProblemSetsParams
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-	// ------------- Required query parameter "userId" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	if paramValue := r.URL.Query().Get("userId"); paramValue != "" {
+// DeleteReward operation middleware
+func (siw *ServerInterfaceWrapper) DeleteReward(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "userId"})
-		return
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteReward(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, true, "userId", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteTemplateProblem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplateProblem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUserFamilyProblemSets(w, r, params)
+		siw.Handler.DeleteTemplateProblem(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -636,32 +1538,27 @@ func (siw *ServerInterfaceWrapper) ListUserFamilyProblemSets(w http.ResponseWrit
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListUserProblemsSolutions operation middleware
-func (siw *ServerInterfaceWrapper) ListUserProblemsSolutions(w http.ResponseWriter, r *http.Request) {
+// ExportProblemSet operation middleware
+func (siw *ServerInterfaceWrapper) ExportProblemSet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "problemSetId" -------------
-	var problemSetId string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportProblemSet(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "problemSetId", mux.Vars(r)["problemSetId"], &problemSetId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "problemSetId", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
-	}
+// GenerateProblems operation middleware
+func (siw *ServerInterfaceWrapper) GenerateProblems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUserProblemsSolutions(w, r, problemSetId, userId)
+		siw.Handler.GenerateProblems(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -671,33 +1568,27 @@ func (siw *ServerInterfaceWrapper) ListUserProblemsSolutions(w http.ResponseWrit
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetStats operation middleware
-func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Request) {
+// ImportProblemSet operation middleware
+func (siw *ServerInterfaceWrapper) ImportProblemSet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetStatsParams
-
-	// ------------- Optional query parameter "from" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportProblemSet(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
-		return
-	}
+// InviteFamilyMember operation middleware
+func (siw *ServerInterfaceWrapper) InviteFamilyMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStats(w, r, params)
+		siw.Handler.InviteFamilyMember(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -707,12 +1598,12 @@ func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetFamilyInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetFamilyInfo(w http.ResponseWriter, r *http.Request) {
+// LoadProblemForAssignment operation middleware
+func (siw *ServerInterfaceWrapper) LoadProblemForAssignment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFamilyInfo(w, r)
+		siw.Handler.LoadProblemForAssignment(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -722,12 +1613,12 @@ func (siw *ServerInterfaceWrapper) GetFamilyInfo(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetUserInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Request) {
+// RedeemReward operation middleware
+func (siw *ServerInterfaceWrapper) RedeemReward(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserInfo(w, r)
+		siw.Handler.RedeemReward(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -737,563 +1628,3238 @@ func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// RefineProblems operation middleware
+func (siw *ServerInterfaceWrapper) RefineProblems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefineProblems(w, r)
+	}))
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// RemoveFamilyMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveFamilyMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveFamilyMember(w, r)
+	}))
 
-type RequiredParamError struct {
-	ParamName string
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// ReorderProblems operation middleware
+func (siw *ServerInterfaceWrapper) ReorderProblems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderProblems(w, r)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// ReorderTemplateProblems operation middleware
+func (siw *ServerInterfaceWrapper) ReorderTemplateProblems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderTemplateProblems(w, r)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{})
-}
+// RevealProblemHint operation middleware
+func (siw *ServerInterfaceWrapper) RevealProblemHint(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GorillaServerOptions struct {
-	BaseURL          string
-	BaseRouter       *mux.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevealProblemHint(w, r)
+	}))
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseRouter: r,
-	})
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
-	r := options.BaseRouter
+// RevokeFamilyInvitation operation middleware
+func (siw *ServerInterfaceWrapper) RevokeFamilyInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	if r == nil {
-		r = mux.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeFamilyInvitation(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	r.HandleFunc(options.BaseURL+"/api/assignments", wrapper.ListAssignments).Methods("GET")
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	r.HandleFunc(options.BaseURL+"/api/commands/create-family-task", wrapper.CreateFamilyTask).Methods("POST")
+// SubmitProblemAnswer operation middleware
+func (siw *ServerInterfaceWrapper) SubmitProblemAnswer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/api/commands/create-problems-in-set", wrapper.CreateProblemsInSet).Methods("POST")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitProblemAnswer(w, r)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/api/commands/create-problemset", wrapper.CreateProblemSet).Methods("POST")
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/api/commands/delete-family-task", wrapper.DeleteFamilyTask).Methods("POST")
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	r.HandleFunc(options.BaseURL+"/api/commands/generate-problems", wrapper.GenerateProblems).Methods("POST")
+// SwitchFamily operation middleware
+func (siw *ServerInterfaceWrapper) SwitchFamily(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/api/commands/load-problem-for-assignment", wrapper.LoadProblemForAssignment).Methods("POST")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SwitchFamily(w, r)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/api/commands/refine-problems", wrapper.RefineProblems).Methods("POST")
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/api/commands/submit-problem-answer", wrapper.SubmitProblemAnswer).Methods("POST")
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	r.HandleFunc(options.BaseURL+"/api/commands/update-family-task", wrapper.UpdateFamilyTask).Methods("POST")
+// UpdateFamilyMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFamilyMemberRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/api/commands/update-task-status", wrapper.UpdateTaskStatus).Methods("POST")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateFamilyMemberRole(w, r)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/api/family-members/{userId}/problem-sets/{problemSetId}/problems-for-edit", wrapper.ListProblemSetProblems).Methods("GET")
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/api/family-members/{userId}/problem-sets/{problemSetId}/problems/{problemId}", wrapper.GetProblem).Methods("GET")
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	r.HandleFunc(options.BaseURL+"/api/family-problem-sets", wrapper.ListUserFamilyProblemSets).Methods("GET")
+// UpdateFamilySettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFamilySettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/api/family-problem-sets/{problemSetId}/{userId}/solutions", wrapper.ListUserProblemsSolutions).Methods("GET")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateFamilySettings(w, r)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/api/stats", wrapper.GetStats).Methods("GET")
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/api/ui/familyInfo", wrapper.GetFamilyInfo).Methods("GET")
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	r.HandleFunc(options.BaseURL+"/api/ui/userInfo", wrapper.GetUserInfo).Methods("GET")
+// UpdateFamilyTask operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFamilyTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return r
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateFamilyTask(w, r)
+	}))
 
-type ListAssignmentsRequestObject struct {
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type ListAssignmentsResponseObject interface {
-	VisitListAssignmentsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ListAssignments200JSONResponse []ApiAssignment
-
-func (response ListAssignments200JSONResponse) VisitListAssignmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// UpdateMemberTaskStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMemberTaskStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMemberTaskStatus(w, r)
+	}))
 
-type CreateFamilyTaskRequestObject struct {
-	Body *CreateFamilyTaskJSONRequestBody
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type CreateFamilyTaskResponseObject interface {
-	VisitCreateFamilyTaskResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateFamilyTask200Response struct {
-}
+// UpdateProblem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProblem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateFamilyTask200Response) VisitCreateFamilyTaskResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProblem(w, r)
+	}))
 
-type CreateProblemsInSetRequestObject struct {
-	Body *CreateProblemsInSetJSONRequestBody
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type CreateProblemsInSetResponseObject interface {
-	VisitCreateProblemsInSetResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateProblemsInSet200Response struct {
-}
+// UpdateProblemStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProblemStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateProblemsInSet200Response) VisitCreateProblemsInSetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProblemStatus(w, r)
+	}))
 
-type CreateProblemSetRequestObject struct {
-	Body *CreateProblemSetJSONRequestBody
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type CreateProblemSetResponseObject interface {
-	VisitCreateProblemSetResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateProblemSet200Response struct {
-}
+// UpdateProblemSet operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProblemSet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CreateProblemSet200Response) VisitCreateProblemSetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProblemSet(w, r)
+	}))
 
-type DeleteFamilyTaskRequestObject struct {
-	Body *DeleteFamilyTaskJSONRequestBody
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type DeleteFamilyTaskResponseObject interface {
-	VisitDeleteFamilyTaskResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteFamilyTask200Response struct {
-}
+// UpdateProblemSetStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProblemSetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response DeleteFamilyTask200Response) VisitDeleteFamilyTaskResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProblemSetStatus(w, r)
+	}))
 
-type GenerateProblemsRequestObject struct {
-	Body *GenerateProblemsJSONRequestBody
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type GenerateProblemsResponseObject interface {
-	VisitGenerateProblemsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GenerateProblems200JSONResponse []ApiProblemForEdit
-
-func (response GenerateProblems200JSONResponse) VisitGenerateProblemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// UpdateProblemSetTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProblemSetTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProblemSetTemplate(w, r)
+	}))
 
-type LoadProblemForAssignmentRequestObject struct {
-	Body *LoadProblemForAssignmentJSONRequestBody
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type LoadProblemForAssignmentResponseObject interface {
-	VisitLoadProblemForAssignmentResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type LoadProblemForAssignment200JSONResponse ApiLoadProblemForAssignmentCommandResult
-
-func (response LoadProblemForAssignment200JSONResponse) VisitLoadProblemForAssignmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// UpdateTaskStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTaskStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTaskStatus(w, r)
+	}))
 
-type RefineProblemsRequestObject struct {
-	Body *RefineProblemsJSONRequestBody
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type RefineProblemsResponseObject interface {
-	VisitRefineProblemsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type RefineProblems200JSONResponse []ApiProblemForEdit
+// UpdateTemplateProblem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTemplateProblem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response RefineProblems200JSONResponse) VisitRefineProblemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTemplateProblem(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type SubmitProblemAnswerRequestObject struct {
-	Body *SubmitProblemAnswerJSONRequestBody
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type SubmitProblemAnswerResponseObject interface {
-	VisitSubmitProblemAnswerResponse(w http.ResponseWriter) error
-}
+// ListFamilyInvitations operation middleware
+func (siw *ServerInterfaceWrapper) ListFamilyInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type SubmitProblemAnswer200JSONResponse ApiSubmitProblemAnswerCommandResp
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFamilyInvitations(w, r)
+	}))
 
-func (response SubmitProblemAnswer200JSONResponse) VisitSubmitProblemAnswerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UpdateFamilyTaskRequestObject struct {
-	Body *UpdateFamilyTaskJSONRequestBody
-}
+// GetAchievements operation middleware
+func (siw *ServerInterfaceWrapper) GetAchievements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type UpdateFamilyTaskResponseObject interface {
-	VisitUpdateFamilyTaskResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type UpdateFamilyTask200Response struct {
-}
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-func (response UpdateFamilyTask200Response) VisitUpdateFamilyTaskResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-type UpdateTaskStatusRequestObject struct {
-	Body *UpdateTaskStatusJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAchievements(w, r, userId)
+	}))
 
-type UpdateTaskStatusResponseObject interface {
-	VisitUpdateTaskStatusResponse(w http.ResponseWriter) error
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type UpdateTaskStatus200Response struct {
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response UpdateTaskStatus200Response) VisitUpdateTaskStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// GetPointsBalance operation middleware
+func (siw *ServerInterfaceWrapper) GetPointsBalance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type ListProblemSetProblemsRequestObject struct {
-	UserId       string `json:"userId"`
-	ProblemSetId string `json:"problemSetId"`
-}
+	var err error
 
-type ListProblemSetProblemsResponseObject interface {
-	VisitListProblemSetProblemsResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-type ListProblemSetProblems200JSONResponse []ApiProblemForEdit
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-func (response ListProblemSetProblems200JSONResponse) VisitListProblemSetProblemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPointsBalance(w, r, userId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-type GetProblemRequestObject struct {
-	UserId       string `json:"userId"`
-	ProblemSetId string `json:"problemSetId"`
-	ProblemId    string `json:"problemId"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetProblemResponseObject interface {
-	VisitGetProblemResponse(w http.ResponseWriter) error
-}
+// ListPointsLedger operation middleware
+func (siw *ServerInterfaceWrapper) ListPointsLedger(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetProblem200JSONResponse ApiProblem
+	var err error
 
-func (response GetProblem200JSONResponse) VisitGetProblemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-type ListUserFamilyProblemSetsRequestObject struct {
-	Params ListUserFamilyProblemSetsParams
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPointsLedgerParams
 
-type ListUserFamilyProblemSetsResponseObject interface {
-	VisitListUserFamilyProblemSetsResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "from" -------------
 
-type ListUserFamilyProblemSets200JSONResponse []ApiProblemSet
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-func (response ListUserFamilyProblemSets200JSONResponse) VisitListUserFamilyProblemSetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "to" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-type ListUserProblemsSolutionsRequestObject struct {
-	ProblemSetId string `json:"problemSetId"`
-	UserId       string `json:"userId"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPointsLedger(w, r, userId, params)
+	}))
 
-type ListUserProblemsSolutionsResponseObject interface {
-	VisitListUserProblemsSolutionsResponse(w http.ResponseWriter) error
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ListUserProblemsSolutions200JSONResponse []ApiUserProblemSolution
+// ListProblemSetProblems operation middleware
+func (siw *ServerInterfaceWrapper) ListProblemSetProblems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response ListUserProblemsSolutions200JSONResponse) VisitListUserProblemsSolutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-type GetStatsRequestObject struct {
-	Params GetStatsParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-type GetStatsResponseObject interface {
-	VisitGetStatsResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "problemSetId" -------------
+	var problemSetId string
 
-type GetStats200JSONResponse []ApiTaskStats
+	err = runtime.BindStyledParameterWithOptions("simple", "problemSetId", mux.Vars(r)["problemSetId"], &problemSetId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "problemSetId", Err: err})
+		return
+	}
 
-func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProblemSetProblemsParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "includeArchived" -------------
 
-type GetFamilyInfoRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeArchived", Err: err})
+		return
+	}
 
-type GetFamilyInfoResponseObject interface {
-	VisitGetFamilyInfoResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProblemSetProblems(w, r, userId, problemSetId, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetFamilyInfo200JSONResponse UIFamilyInfo
+// GetProblem operation middleware
+func (siw *ServerInterfaceWrapper) GetProblem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetFamilyInfo200JSONResponse) VisitGetFamilyInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-type GetUserInfoRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-type GetUserInfoResponseObject interface {
-	VisitGetUserInfoResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "problemSetId" -------------
+	var problemSetId string
 
-type GetUserInfo200JSONResponse UIUserInfo
+	err = runtime.BindStyledParameterWithOptions("simple", "problemSetId", mux.Vars(r)["problemSetId"], &problemSetId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "problemSetId", Err: err})
+		return
+	}
 
-func (response GetUserInfo200JSONResponse) VisitGetUserInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "problemId" -------------
+	var problemId string
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindStyledParameterWithOptions("simple", "problemId", mux.Vars(r)["problemId"], &problemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "problemId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProblem(w, r, userId, problemSetId, problemId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// ListUserFamilyProblemSets operation middleware
+func (siw *ServerInterfaceWrapper) ListUserFamilyProblemSets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// (GET /api/assignments)
-	ListAssignments(ctx context.Context, request ListAssignmentsRequestObject) (ListAssignmentsResponseObject, error)
+	var err error
 
-	// (POST /api/commands/create-family-task)
-	CreateFamilyTask(ctx context.Context, request CreateFamilyTaskRequestObject) (CreateFamilyTaskResponseObject, error)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserFamilyProblemSetsParams
 
-	// (POST /api/commands/create-problems-in-set)
-	CreateProblemsInSet(ctx context.Context, request CreateProblemsInSetRequestObject) (CreateProblemsInSetResponseObject, error)
+	// ------------- Required query parameter "userId" -------------
 
-	// (POST /api/commands/create-problemset)
-	CreateProblemSet(ctx context.Context, request CreateProblemSetRequestObject) (CreateProblemSetResponseObject, error)
+	if paramValue := r.URL.Query().Get("userId"); paramValue != "" {
 
-	// (POST /api/commands/delete-family-task)
-	DeleteFamilyTask(ctx context.Context, request DeleteFamilyTaskRequestObject) (DeleteFamilyTaskResponseObject, error)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "userId"})
+		return
+	}
 
-	// (POST /api/commands/generate-problems)
-	GenerateProblems(ctx context.Context, request GenerateProblemsRequestObject) (GenerateProblemsResponseObject, error)
+	err = runtime.BindQueryParameter("form", true, true, "userId", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-	// (POST /api/commands/load-problem-for-assignment)
-	LoadProblemForAssignment(ctx context.Context, request LoadProblemForAssignmentRequestObject) (LoadProblemForAssignmentResponseObject, error)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserFamilyProblemSets(w, r, params)
+	}))
 
-	// (POST /api/commands/refine-problems)
-	RefineProblems(ctx context.Context, request RefineProblemsRequestObject) (RefineProblemsResponseObject, error)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-	// (POST /api/commands/submit-problem-answer)
-	SubmitProblemAnswer(ctx context.Context, request SubmitProblemAnswerRequestObject) (SubmitProblemAnswerResponseObject, error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	// (POST /api/commands/update-family-task)
-	UpdateFamilyTask(ctx context.Context, request UpdateFamilyTaskRequestObject) (UpdateFamilyTaskResponseObject, error)
+// ListUserProblemsSolutions operation middleware
+func (siw *ServerInterfaceWrapper) ListUserProblemsSolutions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// (POST /api/commands/update-task-status)
-	UpdateTaskStatus(ctx context.Context, request UpdateTaskStatusRequestObject) (UpdateTaskStatusResponseObject, error)
+	var err error
 
-	// (GET /api/family-members/{userId}/problem-sets/{problemSetId}/problems-for-edit)
-	ListProblemSetProblems(ctx context.Context, request ListProblemSetProblemsRequestObject) (ListProblemSetProblemsResponseObject, error)
+	// ------------- Path parameter "problemSetId" -------------
+	var problemSetId string
 
-	// (GET /api/family-members/{userId}/problem-sets/{problemSetId}/problems/{problemId})
-	GetProblem(ctx context.Context, request GetProblemRequestObject) (GetProblemResponseObject, error)
+	err = runtime.BindStyledParameterWithOptions("simple", "problemSetId", mux.Vars(r)["problemSetId"], &problemSetId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "problemSetId", Err: err})
+		return
+	}
 
-	// (GET /api/family-problem-sets)
-	ListUserFamilyProblemSets(ctx context.Context, request ListUserFamilyProblemSetsRequestObject) (ListUserFamilyProblemSetsResponseObject, error)
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-	// (GET /api/family-problem-sets/{problemSetId}/{userId}/solutions)
-	ListUserProblemsSolutions(ctx context.Context, request ListUserProblemsSolutionsRequestObject) (ListUserProblemsSolutionsResponseObject, error)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", mux.Vars(r)["userId"], &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-	// (GET /api/stats)
-	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserProblemsSolutions(w, r, problemSetId, userId)
+	}))
 
-	// (GET /api/ui/familyInfo)
-	GetFamilyInfo(ctx context.Context, request GetFamilyInfoRequestObject) (GetFamilyInfoResponseObject, error)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-	// (GET /api/ui/userInfo)
-	GetUserInfo(ctx context.Context, request GetUserInfoRequestObject) (GetUserInfoResponseObject, error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// ListProblemSetTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListProblemSetTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProblemSetTemplates(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// ListProblemSetTemplateProblems operation middleware
+func (siw *ServerInterfaceWrapper) ListProblemSetTemplateProblems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", mux.Vars(r)["templateId"], &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProblemSetTemplateProblems(w, r, templateId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// ListRewardRedemptions operation middleware
+func (siw *ServerInterfaceWrapper) ListRewardRedemptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRewardRedemptionsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRewardRedemptions(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// ListRewards operation middleware
+func (siw *ServerInterfaceWrapper) ListRewards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRewards(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListAssignments operation middleware
-func (sh *strictHandler) ListAssignments(w http.ResponseWriter, r *http.Request) {
-	var request ListAssignmentsRequestObject
+// GetStats operation middleware
+func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStats(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPendingTaskApprovals operation middleware
+func (siw *ServerInterfaceWrapper) ListPendingTaskApprovals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPendingTaskApprovals(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListUserFamilies operation middleware
+func (siw *ServerInterfaceWrapper) ListUserFamilies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserFamilies(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetFamilyInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetFamilyInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFamilyInfo(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUserInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserInfo(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/api/ai-usage", wrapper.GetAiUsageReport).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/assignments", wrapper.ListAssignments).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/audit-log", wrapper.ListAuditLog).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/add-family-member", wrapper.AddFamilyMember).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/assign-problemset-template", wrapper.AssignProblemSetTemplate).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/create-family-task", wrapper.CreateFamilyTask).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/create-own-family", wrapper.CreateOwnFamily).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/create-problems-in-set", wrapper.CreateProblemsInSet).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/create-problems-in-template", wrapper.CreateProblemsInTemplate).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/create-problemset", wrapper.CreateProblemSet).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/create-problemset-template", wrapper.CreateProblemSetTemplate).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/create-reward", wrapper.CreateReward).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/decide-reward-redemption", wrapper.DecideRewardRedemption).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/decide-task-approval", wrapper.DecideTaskApproval).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/delete-family-task", wrapper.DeleteFamilyTask).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/delete-problemset-template", wrapper.DeleteProblemSetTemplate).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/delete-reward", wrapper.DeleteReward).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/delete-template-problem", wrapper.DeleteTemplateProblem).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/export-problem-set", wrapper.ExportProblemSet).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/generate-problems", wrapper.GenerateProblems).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/import-problem-set", wrapper.ImportProblemSet).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/invite-family-member", wrapper.InviteFamilyMember).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/load-problem-for-assignment", wrapper.LoadProblemForAssignment).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/redeem-reward", wrapper.RedeemReward).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/refine-problems", wrapper.RefineProblems).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/remove-family-member", wrapper.RemoveFamilyMember).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/reorder-problems", wrapper.ReorderProblems).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/reorder-template-problems", wrapper.ReorderTemplateProblems).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/reveal-problem-hint", wrapper.RevealProblemHint).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/revoke-family-invitation", wrapper.RevokeFamilyInvitation).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/submit-problem-answer", wrapper.SubmitProblemAnswer).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/switch-family", wrapper.SwitchFamily).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-family-member-role", wrapper.UpdateFamilyMemberRole).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-family-settings", wrapper.UpdateFamilySettings).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-family-task", wrapper.UpdateFamilyTask).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-member-task-status", wrapper.UpdateMemberTaskStatus).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-problem", wrapper.UpdateProblem).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-problem-status", wrapper.UpdateProblemStatus).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-problemset", wrapper.UpdateProblemSet).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-problemset-status", wrapper.UpdateProblemSetStatus).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-problemset-template", wrapper.UpdateProblemSetTemplate).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-task-status", wrapper.UpdateTaskStatus).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/commands/update-template-problem", wrapper.UpdateTemplateProblem).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api/family-invitations", wrapper.ListFamilyInvitations).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/family-members/{userId}/achievements", wrapper.GetAchievements).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/family-members/{userId}/points", wrapper.GetPointsBalance).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/family-members/{userId}/points-ledger", wrapper.ListPointsLedger).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/family-members/{userId}/problem-sets/{problemSetId}/problems-for-edit", wrapper.ListProblemSetProblems).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/family-members/{userId}/problem-sets/{problemSetId}/problems/{problemId}", wrapper.GetProblem).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/family-problem-sets", wrapper.ListUserFamilyProblemSets).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/family-problem-sets/{problemSetId}/{userId}/solutions", wrapper.ListUserProblemsSolutions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/problem-set-templates", wrapper.ListProblemSetTemplates).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/problem-set-templates/{templateId}/problems-for-edit", wrapper.ListProblemSetTemplateProblems).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/reward-redemptions", wrapper.ListRewardRedemptions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/rewards", wrapper.ListRewards).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/stats", wrapper.GetStats).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/task-approvals", wrapper.ListPendingTaskApprovals).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/ui/families", wrapper.ListUserFamilies).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/ui/familyInfo", wrapper.GetFamilyInfo).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/ui/userInfo", wrapper.GetUserInfo).Methods("GET")
+
+	return r
+}
+
+type GetAiUsageReportRequestObject struct {
+	Params GetAiUsageReportParams
+}
+
+type GetAiUsageReportResponseObject interface {
+	VisitGetAiUsageReportResponse(w http.ResponseWriter) error
+}
+
+type GetAiUsageReport200JSONResponse ApiAiUsageReport
+
+func (response GetAiUsageReport200JSONResponse) VisitGetAiUsageReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssignmentsRequestObject struct {
+}
+
+type ListAssignmentsResponseObject interface {
+	VisitListAssignmentsResponse(w http.ResponseWriter) error
+}
+
+type ListAssignments200JSONResponse []ApiAssignment
+
+func (response ListAssignments200JSONResponse) VisitListAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditLogRequestObject struct {
+	Params ListAuditLogParams
+}
+
+type ListAuditLogResponseObject interface {
+	VisitListAuditLogResponse(w http.ResponseWriter) error
+}
+
+type ListAuditLog200JSONResponse []ApiAuditEntry
+
+func (response ListAuditLog200JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddFamilyMemberRequestObject struct {
+	Body *AddFamilyMemberJSONRequestBody
+}
+
+type AddFamilyMemberResponseObject interface {
+	VisitAddFamilyMemberResponse(w http.ResponseWriter) error
+}
+
+type AddFamilyMember200Response struct {
+}
+
+func (response AddFamilyMember200Response) VisitAddFamilyMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AssignProblemSetTemplateRequestObject struct {
+	Body *AssignProblemSetTemplateJSONRequestBody
+}
+
+type AssignProblemSetTemplateResponseObject interface {
+	VisitAssignProblemSetTemplateResponse(w http.ResponseWriter) error
+}
+
+type AssignProblemSetTemplate200Response struct {
+}
+
+func (response AssignProblemSetTemplate200Response) VisitAssignProblemSetTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateFamilyTaskRequestObject struct {
+	Body *CreateFamilyTaskJSONRequestBody
+}
+
+type CreateFamilyTaskResponseObject interface {
+	VisitCreateFamilyTaskResponse(w http.ResponseWriter) error
+}
+
+type CreateFamilyTask200Response struct {
+}
+
+func (response CreateFamilyTask200Response) VisitCreateFamilyTaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateOwnFamilyRequestObject struct {
+	Body *CreateOwnFamilyJSONRequestBody
+}
+
+type CreateOwnFamilyResponseObject interface {
+	VisitCreateOwnFamilyResponse(w http.ResponseWriter) error
+}
+
+type CreateOwnFamily200Response struct {
+}
+
+func (response CreateOwnFamily200Response) VisitCreateOwnFamilyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateProblemsInSetRequestObject struct {
+	Body *CreateProblemsInSetJSONRequestBody
+}
+
+type CreateProblemsInSetResponseObject interface {
+	VisitCreateProblemsInSetResponse(w http.ResponseWriter) error
+}
+
+type CreateProblemsInSet200Response struct {
+}
+
+func (response CreateProblemsInSet200Response) VisitCreateProblemsInSetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateProblemsInTemplateRequestObject struct {
+	Body *CreateProblemsInTemplateJSONRequestBody
+}
+
+type CreateProblemsInTemplateResponseObject interface {
+	VisitCreateProblemsInTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateProblemsInTemplate200Response struct {
+}
+
+func (response CreateProblemsInTemplate200Response) VisitCreateProblemsInTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateProblemSetRequestObject struct {
+	Body *CreateProblemSetJSONRequestBody
+}
+
+type CreateProblemSetResponseObject interface {
+	VisitCreateProblemSetResponse(w http.ResponseWriter) error
+}
+
+type CreateProblemSet200Response struct {
+}
+
+func (response CreateProblemSet200Response) VisitCreateProblemSetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateProblemSetTemplateRequestObject struct {
+	Body *CreateProblemSetTemplateJSONRequestBody
+}
+
+type CreateProblemSetTemplateResponseObject interface {
+	VisitCreateProblemSetTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateProblemSetTemplate200Response struct {
+}
+
+func (response CreateProblemSetTemplate200Response) VisitCreateProblemSetTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type CreateRewardRequestObject struct {
+	Body *CreateRewardJSONRequestBody
+}
+
+type CreateRewardResponseObject interface {
+	VisitCreateRewardResponse(w http.ResponseWriter) error
+}
+
+type CreateReward200Response struct {
+}
+
+func (response CreateReward200Response) VisitCreateRewardResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DecideRewardRedemptionRequestObject struct {
+	Body *DecideRewardRedemptionJSONRequestBody
+}
+
+type DecideRewardRedemptionResponseObject interface {
+	VisitDecideRewardRedemptionResponse(w http.ResponseWriter) error
+}
+
+type DecideRewardRedemption200Response struct {
+}
+
+func (response DecideRewardRedemption200Response) VisitDecideRewardRedemptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DecideTaskApprovalRequestObject struct {
+	Body *DecideTaskApprovalJSONRequestBody
+}
+
+type DecideTaskApprovalResponseObject interface {
+	VisitDecideTaskApprovalResponse(w http.ResponseWriter) error
+}
+
+type DecideTaskApproval200Response struct {
+}
+
+func (response DecideTaskApproval200Response) VisitDecideTaskApprovalResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteFamilyTaskRequestObject struct {
+	Body *DeleteFamilyTaskJSONRequestBody
+}
+
+type DeleteFamilyTaskResponseObject interface {
+	VisitDeleteFamilyTaskResponse(w http.ResponseWriter) error
+}
+
+type DeleteFamilyTask200Response struct {
+}
+
+func (response DeleteFamilyTask200Response) VisitDeleteFamilyTaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProblemSetTemplateRequestObject struct {
+	Body *DeleteProblemSetTemplateJSONRequestBody
+}
+
+type DeleteProblemSetTemplateResponseObject interface {
+	VisitDeleteProblemSetTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteProblemSetTemplate200Response struct {
+}
+
+func (response DeleteProblemSetTemplate200Response) VisitDeleteProblemSetTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteRewardRequestObject struct {
+	Body *DeleteRewardJSONRequestBody
+}
+
+type DeleteRewardResponseObject interface {
+	VisitDeleteRewardResponse(w http.ResponseWriter) error
+}
+
+type DeleteReward200Response struct {
+}
+
+func (response DeleteReward200Response) VisitDeleteRewardResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteTemplateProblemRequestObject struct {
+	Body *DeleteTemplateProblemJSONRequestBody
+}
+
+type DeleteTemplateProblemResponseObject interface {
+	VisitDeleteTemplateProblemResponse(w http.ResponseWriter) error
+}
+
+type DeleteTemplateProblem200Response struct {
+}
+
+func (response DeleteTemplateProblem200Response) VisitDeleteTemplateProblemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ExportProblemSetRequestObject struct {
+	Body *ExportProblemSetJSONRequestBody
+}
+
+type ExportProblemSetResponseObject interface {
+	VisitExportProblemSetResponse(w http.ResponseWriter) error
+}
+
+type ExportProblemSet200JSONResponse ApiExportProblemSetCommandResult
+
+func (response ExportProblemSet200JSONResponse) VisitExportProblemSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateProblemsRequestObject struct {
+	Body *GenerateProblemsJSONRequestBody
+}
+
+type GenerateProblemsResponseObject interface {
+	VisitGenerateProblemsResponse(w http.ResponseWriter) error
+}
+
+type GenerateProblems200JSONResponse []ApiProblemForEdit
+
+func (response GenerateProblems200JSONResponse) VisitGenerateProblemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportProblemSetRequestObject struct {
+	Body *ImportProblemSetJSONRequestBody
+}
+
+type ImportProblemSetResponseObject interface {
+	VisitImportProblemSetResponse(w http.ResponseWriter) error
+}
+
+type ImportProblemSet200JSONResponse ApiImportProblemSetCommandResult
+
+func (response ImportProblemSet200JSONResponse) VisitImportProblemSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteFamilyMemberRequestObject struct {
+	Body *InviteFamilyMemberJSONRequestBody
+}
+
+type InviteFamilyMemberResponseObject interface {
+	VisitInviteFamilyMemberResponse(w http.ResponseWriter) error
+}
+
+type InviteFamilyMember200Response struct {
+}
+
+func (response InviteFamilyMember200Response) VisitInviteFamilyMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type LoadProblemForAssignmentRequestObject struct {
+	Body *LoadProblemForAssignmentJSONRequestBody
+}
+
+type LoadProblemForAssignmentResponseObject interface {
+	VisitLoadProblemForAssignmentResponse(w http.ResponseWriter) error
+}
+
+type LoadProblemForAssignment200JSONResponse ApiLoadProblemForAssignmentCommandResult
+
+func (response LoadProblemForAssignment200JSONResponse) VisitLoadProblemForAssignmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemRewardRequestObject struct {
+	Body *RedeemRewardJSONRequestBody
+}
+
+type RedeemRewardResponseObject interface {
+	VisitRedeemRewardResponse(w http.ResponseWriter) error
+}
+
+type RedeemReward200Response struct {
+}
+
+func (response RedeemReward200Response) VisitRedeemRewardResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RefineProblemsRequestObject struct {
+	Body *RefineProblemsJSONRequestBody
+}
+
+type RefineProblemsResponseObject interface {
+	VisitRefineProblemsResponse(w http.ResponseWriter) error
+}
+
+type RefineProblems200JSONResponse []ApiProblemForEdit
+
+func (response RefineProblems200JSONResponse) VisitRefineProblemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveFamilyMemberRequestObject struct {
+	Body *RemoveFamilyMemberJSONRequestBody
+}
+
+type RemoveFamilyMemberResponseObject interface {
+	VisitRemoveFamilyMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveFamilyMember200Response struct {
+}
+
+func (response RemoveFamilyMember200Response) VisitRemoveFamilyMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReorderProblemsRequestObject struct {
+	Body *ReorderProblemsJSONRequestBody
+}
+
+type ReorderProblemsResponseObject interface {
+	VisitReorderProblemsResponse(w http.ResponseWriter) error
+}
+
+type ReorderProblems200Response struct {
+}
+
+func (response ReorderProblems200Response) VisitReorderProblemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReorderTemplateProblemsRequestObject struct {
+	Body *ReorderTemplateProblemsJSONRequestBody
+}
+
+type ReorderTemplateProblemsResponseObject interface {
+	VisitReorderTemplateProblemsResponse(w http.ResponseWriter) error
+}
+
+type ReorderTemplateProblems200Response struct {
+}
+
+func (response ReorderTemplateProblems200Response) VisitReorderTemplateProblemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RevealProblemHintRequestObject struct {
+	Body *RevealProblemHintJSONRequestBody
+}
+
+type RevealProblemHintResponseObject interface {
+	VisitRevealProblemHintResponse(w http.ResponseWriter) error
+}
+
+type RevealProblemHint200JSONResponse ApiRevealProblemHintCommandResp
+
+func (response RevealProblemHint200JSONResponse) VisitRevealProblemHintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeFamilyInvitationRequestObject struct {
+	Body *RevokeFamilyInvitationJSONRequestBody
+}
+
+type RevokeFamilyInvitationResponseObject interface {
+	VisitRevokeFamilyInvitationResponse(w http.ResponseWriter) error
+}
+
+type RevokeFamilyInvitation200Response struct {
+}
+
+func (response RevokeFamilyInvitation200Response) VisitRevokeFamilyInvitationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type SubmitProblemAnswerRequestObject struct {
+	Body *SubmitProblemAnswerJSONRequestBody
+}
+
+type SubmitProblemAnswerResponseObject interface {
+	VisitSubmitProblemAnswerResponse(w http.ResponseWriter) error
+}
+
+type SubmitProblemAnswer200JSONResponse ApiSubmitProblemAnswerCommandResp
+
+func (response SubmitProblemAnswer200JSONResponse) VisitSubmitProblemAnswerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SwitchFamilyRequestObject struct {
+	Body *SwitchFamilyJSONRequestBody
+}
+
+type SwitchFamilyResponseObject interface {
+	VisitSwitchFamilyResponse(w http.ResponseWriter) error
+}
+
+type SwitchFamily200Response struct {
+}
+
+func (response SwitchFamily200Response) VisitSwitchFamilyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateFamilyMemberRoleRequestObject struct {
+	Body *UpdateFamilyMemberRoleJSONRequestBody
+}
+
+type UpdateFamilyMemberRoleResponseObject interface {
+	VisitUpdateFamilyMemberRoleResponse(w http.ResponseWriter) error
+}
+
+type UpdateFamilyMemberRole200Response struct {
+}
+
+func (response UpdateFamilyMemberRole200Response) VisitUpdateFamilyMemberRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateFamilySettingsRequestObject struct {
+	Body *UpdateFamilySettingsJSONRequestBody
+}
+
+type UpdateFamilySettingsResponseObject interface {
+	VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateFamilySettings200Response struct {
+}
+
+func (response UpdateFamilySettings200Response) VisitUpdateFamilySettingsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateFamilyTaskRequestObject struct {
+	Body *UpdateFamilyTaskJSONRequestBody
+}
+
+type UpdateFamilyTaskResponseObject interface {
+	VisitUpdateFamilyTaskResponse(w http.ResponseWriter) error
+}
+
+type UpdateFamilyTask200Response struct {
+}
+
+func (response UpdateFamilyTask200Response) VisitUpdateFamilyTaskResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateMemberTaskStatusRequestObject struct {
+	Body *UpdateMemberTaskStatusJSONRequestBody
+}
+
+type UpdateMemberTaskStatusResponseObject interface {
+	VisitUpdateMemberTaskStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateMemberTaskStatus200Response struct {
+}
+
+func (response UpdateMemberTaskStatus200Response) VisitUpdateMemberTaskStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateProblemRequestObject struct {
+	Body *UpdateProblemJSONRequestBody
+}
+
+type UpdateProblemResponseObject interface {
+	VisitUpdateProblemResponse(w http.ResponseWriter) error
+}
+
+type UpdateProblem200Response struct {
+}
+
+func (response UpdateProblem200Response) VisitUpdateProblemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateProblemStatusRequestObject struct {
+	Body *UpdateProblemStatusJSONRequestBody
+}
+
+type UpdateProblemStatusResponseObject interface {
+	VisitUpdateProblemStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateProblemStatus200Response struct {
+}
+
+func (response UpdateProblemStatus200Response) VisitUpdateProblemStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateProblemSetRequestObject struct {
+	Body *UpdateProblemSetJSONRequestBody
+}
+
+type UpdateProblemSetResponseObject interface {
+	VisitUpdateProblemSetResponse(w http.ResponseWriter) error
+}
+
+type UpdateProblemSet200Response struct {
+}
+
+func (response UpdateProblemSet200Response) VisitUpdateProblemSetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateProblemSetStatusRequestObject struct {
+	Body *UpdateProblemSetStatusJSONRequestBody
+}
+
+type UpdateProblemSetStatusResponseObject interface {
+	VisitUpdateProblemSetStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateProblemSetStatus200Response struct {
+}
+
+func (response UpdateProblemSetStatus200Response) VisitUpdateProblemSetStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateProblemSetTemplateRequestObject struct {
+	Body *UpdateProblemSetTemplateJSONRequestBody
+}
+
+type UpdateProblemSetTemplateResponseObject interface {
+	VisitUpdateProblemSetTemplateResponse(w http.ResponseWriter) error
+}
+
+type UpdateProblemSetTemplate200Response struct {
+}
+
+func (response UpdateProblemSetTemplate200Response) VisitUpdateProblemSetTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateTaskStatusRequestObject struct {
+	Body *UpdateTaskStatusJSONRequestBody
+}
+
+type UpdateTaskStatusResponseObject interface {
+	VisitUpdateTaskStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateTaskStatus200Response struct {
+}
+
+func (response UpdateTaskStatus200Response) VisitUpdateTaskStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateTemplateProblemRequestObject struct {
+	Body *UpdateTemplateProblemJSONRequestBody
+}
+
+type UpdateTemplateProblemResponseObject interface {
+	VisitUpdateTemplateProblemResponse(w http.ResponseWriter) error
+}
+
+type UpdateTemplateProblem200Response struct {
+}
+
+func (response UpdateTemplateProblem200Response) VisitUpdateTemplateProblemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ListFamilyInvitationsRequestObject struct {
+}
+
+type ListFamilyInvitationsResponseObject interface {
+	VisitListFamilyInvitationsResponse(w http.ResponseWriter) error
+}
+
+type ListFamilyInvitations200JSONResponse []ApiFamilyInvitation
+
+func (response ListFamilyInvitations200JSONResponse) VisitListFamilyInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAchievementsRequestObject struct {
+	UserId string `json:"userId"`
+}
+
+type GetAchievementsResponseObject interface {
+	VisitGetAchievementsResponse(w http.ResponseWriter) error
+}
+
+type GetAchievements200JSONResponse ApiUserAchievements
+
+func (response GetAchievements200JSONResponse) VisitGetAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPointsBalanceRequestObject struct {
+	UserId string `json:"userId"`
+}
+
+type GetPointsBalanceResponseObject interface {
+	VisitGetPointsBalanceResponse(w http.ResponseWriter) error
+}
+
+type GetPointsBalance200JSONResponse ApiPointsBalance
+
+func (response GetPointsBalance200JSONResponse) VisitGetPointsBalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPointsLedgerRequestObject struct {
+	UserId string `json:"userId"`
+	Params ListPointsLedgerParams
+}
+
+type ListPointsLedgerResponseObject interface {
+	VisitListPointsLedgerResponse(w http.ResponseWriter) error
+}
+
+type ListPointsLedger200JSONResponse []ApiPointsEntry
+
+func (response ListPointsLedger200JSONResponse) VisitListPointsLedgerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProblemSetProblemsRequestObject struct {
+	UserId       string `json:"userId"`
+	ProblemSetId string `json:"problemSetId"`
+	Params       ListProblemSetProblemsParams
+}
+
+type ListProblemSetProblemsResponseObject interface {
+	VisitListProblemSetProblemsResponse(w http.ResponseWriter) error
+}
+
+type ListProblemSetProblems200JSONResponse []ApiProblemForEdit
+
+func (response ListProblemSetProblems200JSONResponse) VisitListProblemSetProblemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProblemRequestObject struct {
+	UserId       string `json:"userId"`
+	ProblemSetId string `json:"problemSetId"`
+	ProblemId    string `json:"problemId"`
+}
+
+type GetProblemResponseObject interface {
+	VisitGetProblemResponse(w http.ResponseWriter) error
+}
+
+type GetProblem200JSONResponse ApiProblem
+
+func (response GetProblem200JSONResponse) VisitGetProblemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserFamilyProblemSetsRequestObject struct {
+	Params ListUserFamilyProblemSetsParams
+}
+
+type ListUserFamilyProblemSetsResponseObject interface {
+	VisitListUserFamilyProblemSetsResponse(w http.ResponseWriter) error
+}
+
+type ListUserFamilyProblemSets200JSONResponse []ApiProblemSet
+
+func (response ListUserFamilyProblemSets200JSONResponse) VisitListUserFamilyProblemSetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserProblemsSolutionsRequestObject struct {
+	ProblemSetId string `json:"problemSetId"`
+	UserId       string `json:"userId"`
+}
+
+type ListUserProblemsSolutionsResponseObject interface {
+	VisitListUserProblemsSolutionsResponse(w http.ResponseWriter) error
+}
+
+type ListUserProblemsSolutions200JSONResponse []ApiUserProblemSolution
+
+func (response ListUserProblemsSolutions200JSONResponse) VisitListUserProblemsSolutionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProblemSetTemplatesRequestObject struct {
+}
+
+type ListProblemSetTemplatesResponseObject interface {
+	VisitListProblemSetTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListProblemSetTemplates200JSONResponse []ApiProblemSetTemplate
+
+func (response ListProblemSetTemplates200JSONResponse) VisitListProblemSetTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProblemSetTemplateProblemsRequestObject struct {
+	TemplateId string `json:"templateId"`
+}
+
+type ListProblemSetTemplateProblemsResponseObject interface {
+	VisitListProblemSetTemplateProblemsResponse(w http.ResponseWriter) error
+}
+
+type ListProblemSetTemplateProblems200JSONResponse []ApiProblemForEdit
+
+func (response ListProblemSetTemplateProblems200JSONResponse) VisitListProblemSetTemplateProblemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRewardRedemptionsRequestObject struct {
+	Params ListRewardRedemptionsParams
+}
+
+type ListRewardRedemptionsResponseObject interface {
+	VisitListRewardRedemptionsResponse(w http.ResponseWriter) error
+}
+
+type ListRewardRedemptions200JSONResponse []ApiRewardRedemption
+
+func (response ListRewardRedemptions200JSONResponse) VisitListRewardRedemptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRewardsRequestObject struct {
+}
+
+type ListRewardsResponseObject interface {
+	VisitListRewardsResponse(w http.ResponseWriter) error
+}
+
+type ListRewards200JSONResponse []ApiReward
+
+func (response ListRewards200JSONResponse) VisitListRewardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsRequestObject struct {
+	Params GetStatsParams
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse []ApiTaskStats
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPendingTaskApprovalsRequestObject struct {
+}
+
+type ListPendingTaskApprovalsResponseObject interface {
+	VisitListPendingTaskApprovalsResponse(w http.ResponseWriter) error
+}
+
+type ListPendingTaskApprovals200JSONResponse []ApiTaskApproval
+
+func (response ListPendingTaskApprovals200JSONResponse) VisitListPendingTaskApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserFamiliesRequestObject struct {
+}
+
+type ListUserFamiliesResponseObject interface {
+	VisitListUserFamiliesResponse(w http.ResponseWriter) error
+}
+
+type ListUserFamilies200JSONResponse []UIUserFamily
+
+func (response ListUserFamilies200JSONResponse) VisitListUserFamiliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFamilyInfoRequestObject struct {
+}
+
+type GetFamilyInfoResponseObject interface {
+	VisitGetFamilyInfoResponse(w http.ResponseWriter) error
+}
+
+type GetFamilyInfo200JSONResponse UIFamilyInfo
+
+func (response GetFamilyInfo200JSONResponse) VisitGetFamilyInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserInfoRequestObject struct {
+}
+
+type GetUserInfoResponseObject interface {
+	VisitGetUserInfoResponse(w http.ResponseWriter) error
+}
+
+type GetUserInfo200JSONResponse UIUserInfo
+
+func (response GetUserInfo200JSONResponse) VisitGetUserInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /api/ai-usage)
+	GetAiUsageReport(ctx context.Context, request GetAiUsageReportRequestObject) (GetAiUsageReportResponseObject, error)
+
+	// (GET /api/assignments)
+	ListAssignments(ctx context.Context, request ListAssignmentsRequestObject) (ListAssignmentsResponseObject, error)
+
+	// (GET /api/audit-log)
+	ListAuditLog(ctx context.Context, request ListAuditLogRequestObject) (ListAuditLogResponseObject, error)
+
+	// (POST /api/commands/add-family-member)
+	AddFamilyMember(ctx context.Context, request AddFamilyMemberRequestObject) (AddFamilyMemberResponseObject, error)
+
+	// (POST /api/commands/assign-problemset-template)
+	AssignProblemSetTemplate(ctx context.Context, request AssignProblemSetTemplateRequestObject) (AssignProblemSetTemplateResponseObject, error)
+
+	// (POST /api/commands/create-family-task)
+	CreateFamilyTask(ctx context.Context, request CreateFamilyTaskRequestObject) (CreateFamilyTaskResponseObject, error)
+
+	// (POST /api/commands/create-own-family)
+	CreateOwnFamily(ctx context.Context, request CreateOwnFamilyRequestObject) (CreateOwnFamilyResponseObject, error)
+
+	// (POST /api/commands/create-problems-in-set)
+	CreateProblemsInSet(ctx context.Context, request CreateProblemsInSetRequestObject) (CreateProblemsInSetResponseObject, error)
+
+	// (POST /api/commands/create-problems-in-template)
+	CreateProblemsInTemplate(ctx context.Context, request CreateProblemsInTemplateRequestObject) (CreateProblemsInTemplateResponseObject, error)
+
+	// (POST /api/commands/create-problemset)
+	CreateProblemSet(ctx context.Context, request CreateProblemSetRequestObject) (CreateProblemSetResponseObject, error)
+
+	// (POST /api/commands/create-problemset-template)
+	CreateProblemSetTemplate(ctx context.Context, request CreateProblemSetTemplateRequestObject) (CreateProblemSetTemplateResponseObject, error)
+
+	// (POST /api/commands/create-reward)
+	CreateReward(ctx context.Context, request CreateRewardRequestObject) (CreateRewardResponseObject, error)
+
+	// (POST /api/commands/decide-reward-redemption)
+	DecideRewardRedemption(ctx context.Context, request DecideRewardRedemptionRequestObject) (DecideRewardRedemptionResponseObject, error)
+
+	// (POST /api/commands/decide-task-approval)
+	DecideTaskApproval(ctx context.Context, request DecideTaskApprovalRequestObject) (DecideTaskApprovalResponseObject, error)
+
+	// (POST /api/commands/delete-family-task)
+	DeleteFamilyTask(ctx context.Context, request DeleteFamilyTaskRequestObject) (DeleteFamilyTaskResponseObject, error)
+
+	// (POST /api/commands/delete-problemset-template)
+	DeleteProblemSetTemplate(ctx context.Context, request DeleteProblemSetTemplateRequestObject) (DeleteProblemSetTemplateResponseObject, error)
+
+	// (POST /api/commands/delete-reward)
+	DeleteReward(ctx context.Context, request DeleteRewardRequestObject) (DeleteRewardResponseObject, error)
+
+	// (POST /api/commands/delete-template-problem)
+	DeleteTemplateProblem(ctx context.Context, request DeleteTemplateProblemRequestObject) (DeleteTemplateProblemResponseObject, error)
+
+	// (POST /api/commands/export-problem-set)
+	ExportProblemSet(ctx context.Context, request ExportProblemSetRequestObject) (ExportProblemSetResponseObject, error)
+
+	// (POST /api/commands/generate-problems)
+	GenerateProblems(ctx context.Context, request GenerateProblemsRequestObject) (GenerateProblemsResponseObject, error)
+
+	// (POST /api/commands/import-problem-set)
+	ImportProblemSet(ctx context.Context, request ImportProblemSetRequestObject) (ImportProblemSetResponseObject, error)
+
+	// (POST /api/commands/invite-family-member)
+	InviteFamilyMember(ctx context.Context, request InviteFamilyMemberRequestObject) (InviteFamilyMemberResponseObject, error)
+
+	// (POST /api/commands/load-problem-for-assignment)
+	LoadProblemForAssignment(ctx context.Context, request LoadProblemForAssignmentRequestObject) (LoadProblemForAssignmentResponseObject, error)
+
+	// (POST /api/commands/redeem-reward)
+	RedeemReward(ctx context.Context, request RedeemRewardRequestObject) (RedeemRewardResponseObject, error)
+
+	// (POST /api/commands/refine-problems)
+	RefineProblems(ctx context.Context, request RefineProblemsRequestObject) (RefineProblemsResponseObject, error)
+
+	// (POST /api/commands/remove-family-member)
+	RemoveFamilyMember(ctx context.Context, request RemoveFamilyMemberRequestObject) (RemoveFamilyMemberResponseObject, error)
+
+	// (POST /api/commands/reorder-problems)
+	ReorderProblems(ctx context.Context, request ReorderProblemsRequestObject) (ReorderProblemsResponseObject, error)
+
+	// (POST /api/commands/reorder-template-problems)
+	ReorderTemplateProblems(ctx context.Context, request ReorderTemplateProblemsRequestObject) (ReorderTemplateProblemsResponseObject, error)
+
+	// (POST /api/commands/reveal-problem-hint)
+	RevealProblemHint(ctx context.Context, request RevealProblemHintRequestObject) (RevealProblemHintResponseObject, error)
+
+	// (POST /api/commands/revoke-family-invitation)
+	RevokeFamilyInvitation(ctx context.Context, request RevokeFamilyInvitationRequestObject) (RevokeFamilyInvitationResponseObject, error)
+
+	// (POST /api/commands/submit-problem-answer)
+	SubmitProblemAnswer(ctx context.Context, request SubmitProblemAnswerRequestObject) (SubmitProblemAnswerResponseObject, error)
+
+	// (POST /api/commands/switch-family)
+	SwitchFamily(ctx context.Context, request SwitchFamilyRequestObject) (SwitchFamilyResponseObject, error)
+
+	// (POST /api/commands/update-family-member-role)
+	UpdateFamilyMemberRole(ctx context.Context, request UpdateFamilyMemberRoleRequestObject) (UpdateFamilyMemberRoleResponseObject, error)
+
+	// (POST /api/commands/update-family-settings)
+	UpdateFamilySettings(ctx context.Context, request UpdateFamilySettingsRequestObject) (UpdateFamilySettingsResponseObject, error)
+
+	// (POST /api/commands/update-family-task)
+	UpdateFamilyTask(ctx context.Context, request UpdateFamilyTaskRequestObject) (UpdateFamilyTaskResponseObject, error)
+
+	// (POST /api/commands/update-member-task-status)
+	UpdateMemberTaskStatus(ctx context.Context, request UpdateMemberTaskStatusRequestObject) (UpdateMemberTaskStatusResponseObject, error)
+
+	// (POST /api/commands/update-problem)
+	UpdateProblem(ctx context.Context, request UpdateProblemRequestObject) (UpdateProblemResponseObject, error)
+
+	// (POST /api/commands/update-problem-status)
+	UpdateProblemStatus(ctx context.Context, request UpdateProblemStatusRequestObject) (UpdateProblemStatusResponseObject, error)
+
+	// (POST /api/commands/update-problemset)
+	UpdateProblemSet(ctx context.Context, request UpdateProblemSetRequestObject) (UpdateProblemSetResponseObject, error)
+
+	// (POST /api/commands/update-problemset-status)
+	UpdateProblemSetStatus(ctx context.Context, request UpdateProblemSetStatusRequestObject) (UpdateProblemSetStatusResponseObject, error)
+
+	// (POST /api/commands/update-problemset-template)
+	UpdateProblemSetTemplate(ctx context.Context, request UpdateProblemSetTemplateRequestObject) (UpdateProblemSetTemplateResponseObject, error)
+
+	// (POST /api/commands/update-task-status)
+	UpdateTaskStatus(ctx context.Context, request UpdateTaskStatusRequestObject) (UpdateTaskStatusResponseObject, error)
+
+	// (POST /api/commands/update-template-problem)
+	UpdateTemplateProblem(ctx context.Context, request UpdateTemplateProblemRequestObject) (UpdateTemplateProblemResponseObject, error)
+
+	// (GET /api/family-invitations)
+	ListFamilyInvitations(ctx context.Context, request ListFamilyInvitationsRequestObject) (ListFamilyInvitationsResponseObject, error)
+
+	// (GET /api/family-members/{userId}/achievements)
+	GetAchievements(ctx context.Context, request GetAchievementsRequestObject) (GetAchievementsResponseObject, error)
+
+	// (GET /api/family-members/{userId}/points)
+	GetPointsBalance(ctx context.Context, request GetPointsBalanceRequestObject) (GetPointsBalanceResponseObject, error)
+
+	// (GET /api/family-members/{userId}/points-ledger)
+	ListPointsLedger(ctx context.Context, request ListPointsLedgerRequestObject) (ListPointsLedgerResponseObject, error)
+
+	// (GET /api/family-members/{userId}/problem-sets/{problemSetId}/problems-for-edit)
+	ListProblemSetProblems(ctx context.Context, request ListProblemSetProblemsRequestObject) (ListProblemSetProblemsResponseObject, error)
+
+	// (GET /api/family-members/{userId}/problem-sets/{problemSetId}/problems/{problemId})
+	GetProblem(ctx context.Context, request GetProblemRequestObject) (GetProblemResponseObject, error)
+
+	// (GET /api/family-problem-sets)
+	ListUserFamilyProblemSets(ctx context.Context, request ListUserFamilyProblemSetsRequestObject) (ListUserFamilyProblemSetsResponseObject, error)
+
+	// (GET /api/family-problem-sets/{problemSetId}/{userId}/solutions)
+	ListUserProblemsSolutions(ctx context.Context, request ListUserProblemsSolutionsRequestObject) (ListUserProblemsSolutionsResponseObject, error)
+
+	// (GET /api/problem-set-templates)
+	ListProblemSetTemplates(ctx context.Context, request ListProblemSetTemplatesRequestObject) (ListProblemSetTemplatesResponseObject, error)
+
+	// (GET /api/problem-set-templates/{templateId}/problems-for-edit)
+	ListProblemSetTemplateProblems(ctx context.Context, request ListProblemSetTemplateProblemsRequestObject) (ListProblemSetTemplateProblemsResponseObject, error)
+
+	// (GET /api/reward-redemptions)
+	ListRewardRedemptions(ctx context.Context, request ListRewardRedemptionsRequestObject) (ListRewardRedemptionsResponseObject, error)
+
+	// (GET /api/rewards)
+	ListRewards(ctx context.Context, request ListRewardsRequestObject) (ListRewardsResponseObject, error)
+
+	// (GET /api/stats)
+	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+
+	// (GET /api/task-approvals)
+	ListPendingTaskApprovals(ctx context.Context, request ListPendingTaskApprovalsRequestObject) (ListPendingTaskApprovalsResponseObject, error)
+
+	// (GET /api/ui/families)
+	ListUserFamilies(ctx context.Context, request ListUserFamiliesRequestObject) (ListUserFamiliesResponseObject, error)
+
+	// (GET /api/ui/familyInfo)
+	GetFamilyInfo(ctx context.Context, request GetFamilyInfoRequestObject) (GetFamilyInfoResponseObject, error)
+
+	// (GET /api/ui/userInfo)
+	GetUserInfo(ctx context.Context, request GetUserInfoRequestObject) (GetUserInfoResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAiUsageReport operation middleware
+func (sh *strictHandler) GetAiUsageReport(w http.ResponseWriter, r *http.Request, params GetAiUsageReportParams) {
+	var request GetAiUsageReportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAiUsageReport(ctx, request.(GetAiUsageReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAiUsageReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAiUsageReportResponseObject); ok {
+		if err := validResponse.VisitGetAiUsageReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAssignments operation middleware
+func (sh *strictHandler) ListAssignments(w http.ResponseWriter, r *http.Request) {
+	var request ListAssignmentsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssignments(ctx, request.(ListAssignmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssignments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssignmentsResponseObject); ok {
+		if err := validResponse.VisitListAssignmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAuditLog operation middleware
+func (sh *strictHandler) ListAuditLog(w http.ResponseWriter, r *http.Request, params ListAuditLogParams) {
+	var request ListAuditLogRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditLog(ctx, request.(ListAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditLog")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditLogResponseObject); ok {
+		if err := validResponse.VisitListAuditLogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddFamilyMember operation middleware
+func (sh *strictHandler) AddFamilyMember(w http.ResponseWriter, r *http.Request) {
+	var request AddFamilyMemberRequestObject
+
+	var body AddFamilyMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddFamilyMember(ctx, request.(AddFamilyMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddFamilyMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddFamilyMemberResponseObject); ok {
+		if err := validResponse.VisitAddFamilyMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AssignProblemSetTemplate operation middleware
+func (sh *strictHandler) AssignProblemSetTemplate(w http.ResponseWriter, r *http.Request) {
+	var request AssignProblemSetTemplateRequestObject
+
+	var body AssignProblemSetTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AssignProblemSetTemplate(ctx, request.(AssignProblemSetTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssignProblemSetTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AssignProblemSetTemplateResponseObject); ok {
+		if err := validResponse.VisitAssignProblemSetTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateFamilyTask operation middleware
+func (sh *strictHandler) CreateFamilyTask(w http.ResponseWriter, r *http.Request) {
+	var request CreateFamilyTaskRequestObject
+
+	var body CreateFamilyTaskJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateFamilyTask(ctx, request.(CreateFamilyTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateFamilyTask")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateFamilyTaskResponseObject); ok {
+		if err := validResponse.VisitCreateFamilyTaskResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOwnFamily operation middleware
+func (sh *strictHandler) CreateOwnFamily(w http.ResponseWriter, r *http.Request) {
+	var request CreateOwnFamilyRequestObject
+
+	var body CreateOwnFamilyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOwnFamily(ctx, request.(CreateOwnFamilyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOwnFamily")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOwnFamilyResponseObject); ok {
+		if err := validResponse.VisitCreateOwnFamilyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateProblemsInSet operation middleware
+func (sh *strictHandler) CreateProblemsInSet(w http.ResponseWriter, r *http.Request) {
+	var request CreateProblemsInSetRequestObject
+
+	var body CreateProblemsInSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProblemsInSet(ctx, request.(CreateProblemsInSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProblemsInSet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProblemsInSetResponseObject); ok {
+		if err := validResponse.VisitCreateProblemsInSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateProblemsInTemplate operation middleware
+func (sh *strictHandler) CreateProblemsInTemplate(w http.ResponseWriter, r *http.Request) {
+	var request CreateProblemsInTemplateRequestObject
+
+	var body CreateProblemsInTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProblemsInTemplate(ctx, request.(CreateProblemsInTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProblemsInTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProblemsInTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateProblemsInTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateProblemSet operation middleware
+func (sh *strictHandler) CreateProblemSet(w http.ResponseWriter, r *http.Request) {
+	var request CreateProblemSetRequestObject
+
+	var body CreateProblemSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProblemSet(ctx, request.(CreateProblemSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProblemSet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProblemSetResponseObject); ok {
+		if err := validResponse.VisitCreateProblemSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateProblemSetTemplate operation middleware
+func (sh *strictHandler) CreateProblemSetTemplate(w http.ResponseWriter, r *http.Request) {
+	var request CreateProblemSetTemplateRequestObject
+
+	var body CreateProblemSetTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProblemSetTemplate(ctx, request.(CreateProblemSetTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProblemSetTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProblemSetTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateProblemSetTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateReward operation middleware
+func (sh *strictHandler) CreateReward(w http.ResponseWriter, r *http.Request) {
+	var request CreateRewardRequestObject
+
+	var body CreateRewardJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateReward(ctx, request.(CreateRewardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateReward")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRewardResponseObject); ok {
+		if err := validResponse.VisitCreateRewardResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DecideRewardRedemption operation middleware
+func (sh *strictHandler) DecideRewardRedemption(w http.ResponseWriter, r *http.Request) {
+	var request DecideRewardRedemptionRequestObject
+
+	var body DecideRewardRedemptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DecideRewardRedemption(ctx, request.(DecideRewardRedemptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DecideRewardRedemption")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DecideRewardRedemptionResponseObject); ok {
+		if err := validResponse.VisitDecideRewardRedemptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DecideTaskApproval operation middleware
+func (sh *strictHandler) DecideTaskApproval(w http.ResponseWriter, r *http.Request) {
+	var request DecideTaskApprovalRequestObject
+
+	var body DecideTaskApprovalJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DecideTaskApproval(ctx, request.(DecideTaskApprovalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DecideTaskApproval")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DecideTaskApprovalResponseObject); ok {
+		if err := validResponse.VisitDecideTaskApprovalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteFamilyTask operation middleware
+func (sh *strictHandler) DeleteFamilyTask(w http.ResponseWriter, r *http.Request) {
+	var request DeleteFamilyTaskRequestObject
+
+	var body DeleteFamilyTaskJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFamilyTask(ctx, request.(DeleteFamilyTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFamilyTask")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteFamilyTaskResponseObject); ok {
+		if err := validResponse.VisitDeleteFamilyTaskResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProblemSetTemplate operation middleware
+func (sh *strictHandler) DeleteProblemSetTemplate(w http.ResponseWriter, r *http.Request) {
+	var request DeleteProblemSetTemplateRequestObject
+
+	var body DeleteProblemSetTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProblemSetTemplate(ctx, request.(DeleteProblemSetTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProblemSetTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProblemSetTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteProblemSetTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteReward operation middleware
+func (sh *strictHandler) DeleteReward(w http.ResponseWriter, r *http.Request) {
+	var request DeleteRewardRequestObject
+
+	var body DeleteRewardJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteReward(ctx, request.(DeleteRewardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteReward")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRewardResponseObject); ok {
+		if err := validResponse.VisitDeleteRewardResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTemplateProblem operation middleware
+func (sh *strictHandler) DeleteTemplateProblem(w http.ResponseWriter, r *http.Request) {
+	var request DeleteTemplateProblemRequestObject
+
+	var body DeleteTemplateProblemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTemplateProblem(ctx, request.(DeleteTemplateProblemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTemplateProblem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTemplateProblemResponseObject); ok {
+		if err := validResponse.VisitDeleteTemplateProblemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportProblemSet operation middleware
+func (sh *strictHandler) ExportProblemSet(w http.ResponseWriter, r *http.Request) {
+	var request ExportProblemSetRequestObject
+
+	var body ExportProblemSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportProblemSet(ctx, request.(ExportProblemSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportProblemSet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportProblemSetResponseObject); ok {
+		if err := validResponse.VisitExportProblemSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GenerateProblems operation middleware
+func (sh *strictHandler) GenerateProblems(w http.ResponseWriter, r *http.Request) {
+	var request GenerateProblemsRequestObject
+
+	var body GenerateProblemsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GenerateProblems(ctx, request.(GenerateProblemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GenerateProblems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GenerateProblemsResponseObject); ok {
+		if err := validResponse.VisitGenerateProblemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportProblemSet operation middleware
+func (sh *strictHandler) ImportProblemSet(w http.ResponseWriter, r *http.Request) {
+	var request ImportProblemSetRequestObject
+
+	var body ImportProblemSetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportProblemSet(ctx, request.(ImportProblemSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportProblemSet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportProblemSetResponseObject); ok {
+		if err := validResponse.VisitImportProblemSetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// InviteFamilyMember operation middleware
+func (sh *strictHandler) InviteFamilyMember(w http.ResponseWriter, r *http.Request) {
+	var request InviteFamilyMemberRequestObject
+
+	var body InviteFamilyMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InviteFamilyMember(ctx, request.(InviteFamilyMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InviteFamilyMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InviteFamilyMemberResponseObject); ok {
+		if err := validResponse.VisitInviteFamilyMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LoadProblemForAssignment operation middleware
+func (sh *strictHandler) LoadProblemForAssignment(w http.ResponseWriter, r *http.Request) {
+	var request LoadProblemForAssignmentRequestObject
+
+	var body LoadProblemForAssignmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LoadProblemForAssignment(ctx, request.(LoadProblemForAssignmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoadProblemForAssignment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoadProblemForAssignmentResponseObject); ok {
+		if err := validResponse.VisitLoadProblemForAssignmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RedeemReward operation middleware
+func (sh *strictHandler) RedeemReward(w http.ResponseWriter, r *http.Request) {
+	var request RedeemRewardRequestObject
+
+	var body RedeemRewardJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RedeemReward(ctx, request.(RedeemRewardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RedeemReward")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RedeemRewardResponseObject); ok {
+		if err := validResponse.VisitRedeemRewardResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RefineProblems operation middleware
+func (sh *strictHandler) RefineProblems(w http.ResponseWriter, r *http.Request) {
+	var request RefineProblemsRequestObject
+
+	var body RefineProblemsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RefineProblems(ctx, request.(RefineProblemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefineProblems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefineProblemsResponseObject); ok {
+		if err := validResponse.VisitRefineProblemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveFamilyMember operation middleware
+func (sh *strictHandler) RemoveFamilyMember(w http.ResponseWriter, r *http.Request) {
+	var request RemoveFamilyMemberRequestObject
+
+	var body RemoveFamilyMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveFamilyMember(ctx, request.(RemoveFamilyMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveFamilyMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveFamilyMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveFamilyMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReorderProblems operation middleware
+func (sh *strictHandler) ReorderProblems(w http.ResponseWriter, r *http.Request) {
+	var request ReorderProblemsRequestObject
+
+	var body ReorderProblemsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReorderProblems(ctx, request.(ReorderProblemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReorderProblems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReorderProblemsResponseObject); ok {
+		if err := validResponse.VisitReorderProblemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReorderTemplateProblems operation middleware
+func (sh *strictHandler) ReorderTemplateProblems(w http.ResponseWriter, r *http.Request) {
+	var request ReorderTemplateProblemsRequestObject
+
+	var body ReorderTemplateProblemsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReorderTemplateProblems(ctx, request.(ReorderTemplateProblemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReorderTemplateProblems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReorderTemplateProblemsResponseObject); ok {
+		if err := validResponse.VisitReorderTemplateProblemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevealProblemHint operation middleware
+func (sh *strictHandler) RevealProblemHint(w http.ResponseWriter, r *http.Request) {
+	var request RevealProblemHintRequestObject
+
+	var body RevealProblemHintJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevealProblemHint(ctx, request.(RevealProblemHintRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevealProblemHint")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevealProblemHintResponseObject); ok {
+		if err := validResponse.VisitRevealProblemHintResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeFamilyInvitation operation middleware
+func (sh *strictHandler) RevokeFamilyInvitation(w http.ResponseWriter, r *http.Request) {
+	var request RevokeFamilyInvitationRequestObject
+
+	var body RevokeFamilyInvitationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeFamilyInvitation(ctx, request.(RevokeFamilyInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeFamilyInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeFamilyInvitationResponseObject); ok {
+		if err := validResponse.VisitRevokeFamilyInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SubmitProblemAnswer operation middleware
+func (sh *strictHandler) SubmitProblemAnswer(w http.ResponseWriter, r *http.Request) {
+	var request SubmitProblemAnswerRequestObject
+
+	var body SubmitProblemAnswerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SubmitProblemAnswer(ctx, request.(SubmitProblemAnswerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SubmitProblemAnswer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SubmitProblemAnswerResponseObject); ok {
+		if err := validResponse.VisitSubmitProblemAnswerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SwitchFamily operation middleware
+func (sh *strictHandler) SwitchFamily(w http.ResponseWriter, r *http.Request) {
+	var request SwitchFamilyRequestObject
+
+	var body SwitchFamilyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SwitchFamily(ctx, request.(SwitchFamilyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SwitchFamily")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SwitchFamilyResponseObject); ok {
+		if err := validResponse.VisitSwitchFamilyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateFamilyMemberRole operation middleware
+func (sh *strictHandler) UpdateFamilyMemberRole(w http.ResponseWriter, r *http.Request) {
+	var request UpdateFamilyMemberRoleRequestObject
+
+	var body UpdateFamilyMemberRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateFamilyMemberRole(ctx, request.(UpdateFamilyMemberRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateFamilyMemberRole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateFamilyMemberRoleResponseObject); ok {
+		if err := validResponse.VisitUpdateFamilyMemberRoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateFamilySettings operation middleware
+func (sh *strictHandler) UpdateFamilySettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateFamilySettingsRequestObject
+
+	var body UpdateFamilySettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateFamilySettings(ctx, request.(UpdateFamilySettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateFamilySettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateFamilySettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateFamilySettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateFamilyTask operation middleware
+func (sh *strictHandler) UpdateFamilyTask(w http.ResponseWriter, r *http.Request) {
+	var request UpdateFamilyTaskRequestObject
+
+	var body UpdateFamilyTaskJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAssignments(ctx, request.(ListAssignmentsRequestObject))
+		return sh.ssi.UpdateFamilyTask(ctx, request.(UpdateFamilyTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAssignments")
+		handler = middleware(handler, "UpdateFamilyTask")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAssignmentsResponseObject); ok {
-		if err := validResponse.VisitListAssignmentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateFamilyTaskResponseObject); ok {
+		if err := validResponse.VisitUpdateFamilyTaskResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1301,11 +4867,11 @@ func (sh *strictHandler) ListAssignments(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// CreateFamilyTask operation middleware
-func (sh *strictHandler) CreateFamilyTask(w http.ResponseWriter, r *http.Request) {
-	var request CreateFamilyTaskRequestObject
+// UpdateMemberTaskStatus operation middleware
+func (sh *strictHandler) UpdateMemberTaskStatus(w http.ResponseWriter, r *http.Request) {
+	var request UpdateMemberTaskStatusRequestObject
 
-	var body CreateFamilyTaskJSONRequestBody
+	var body UpdateMemberTaskStatusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1313,18 +4879,18 @@ func (sh *strictHandler) CreateFamilyTask(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateFamilyTask(ctx, request.(CreateFamilyTaskRequestObject))
+		return sh.ssi.UpdateMemberTaskStatus(ctx, request.(UpdateMemberTaskStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateFamilyTask")
+		handler = middleware(handler, "UpdateMemberTaskStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateFamilyTaskResponseObject); ok {
-		if err := validResponse.VisitCreateFamilyTaskResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateMemberTaskStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateMemberTaskStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1332,11 +4898,11 @@ func (sh *strictHandler) CreateFamilyTask(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CreateProblemsInSet operation middleware
-func (sh *strictHandler) CreateProblemsInSet(w http.ResponseWriter, r *http.Request) {
-	var request CreateProblemsInSetRequestObject
+// UpdateProblem operation middleware
+func (sh *strictHandler) UpdateProblem(w http.ResponseWriter, r *http.Request) {
+	var request UpdateProblemRequestObject
 
-	var body CreateProblemsInSetJSONRequestBody
+	var body UpdateProblemJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1344,18 +4910,18 @@ func (sh *strictHandler) CreateProblemsInSet(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateProblemsInSet(ctx, request.(CreateProblemsInSetRequestObject))
+		return sh.ssi.UpdateProblem(ctx, request.(UpdateProblemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateProblemsInSet")
+		handler = middleware(handler, "UpdateProblem")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateProblemsInSetResponseObject); ok {
-		if err := validResponse.VisitCreateProblemsInSetResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateProblemResponseObject); ok {
+		if err := validResponse.VisitUpdateProblemResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1363,11 +4929,11 @@ func (sh *strictHandler) CreateProblemsInSet(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// CreateProblemSet operation middleware
-func (sh *strictHandler) CreateProblemSet(w http.ResponseWriter, r *http.Request) {
-	var request CreateProblemSetRequestObject
+// UpdateProblemStatus operation middleware
+func (sh *strictHandler) UpdateProblemStatus(w http.ResponseWriter, r *http.Request) {
+	var request UpdateProblemStatusRequestObject
 
-	var body CreateProblemSetJSONRequestBody
+	var body UpdateProblemStatusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1375,18 +4941,18 @@ func (sh *strictHandler) CreateProblemSet(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateProblemSet(ctx, request.(CreateProblemSetRequestObject))
+		return sh.ssi.UpdateProblemStatus(ctx, request.(UpdateProblemStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateProblemSet")
+		handler = middleware(handler, "UpdateProblemStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateProblemSetResponseObject); ok {
-		if err := validResponse.VisitCreateProblemSetResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateProblemStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateProblemStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1394,11 +4960,11 @@ func (sh *strictHandler) CreateProblemSet(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteFamilyTask operation middleware
-func (sh *strictHandler) DeleteFamilyTask(w http.ResponseWriter, r *http.Request) {
-	var request DeleteFamilyTaskRequestObject
+// UpdateProblemSet operation middleware
+func (sh *strictHandler) UpdateProblemSet(w http.ResponseWriter, r *http.Request) {
+	var request UpdateProblemSetRequestObject
 
-	var body DeleteFamilyTaskJSONRequestBody
+	var body UpdateProblemSetJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1406,18 +4972,18 @@ func (sh *strictHandler) DeleteFamilyTask(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFamilyTask(ctx, request.(DeleteFamilyTaskRequestObject))
+		return sh.ssi.UpdateProblemSet(ctx, request.(UpdateProblemSetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFamilyTask")
+		handler = middleware(handler, "UpdateProblemSet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteFamilyTaskResponseObject); ok {
-		if err := validResponse.VisitDeleteFamilyTaskResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateProblemSetResponseObject); ok {
+		if err := validResponse.VisitUpdateProblemSetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1425,11 +4991,11 @@ func (sh *strictHandler) DeleteFamilyTask(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GenerateProblems operation middleware
-func (sh *strictHandler) GenerateProblems(w http.ResponseWriter, r *http.Request) {
-	var request GenerateProblemsRequestObject
+// UpdateProblemSetStatus operation middleware
+func (sh *strictHandler) UpdateProblemSetStatus(w http.ResponseWriter, r *http.Request) {
+	var request UpdateProblemSetStatusRequestObject
 
-	var body GenerateProblemsJSONRequestBody
+	var body UpdateProblemSetStatusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1437,18 +5003,18 @@ func (sh *strictHandler) GenerateProblems(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GenerateProblems(ctx, request.(GenerateProblemsRequestObject))
+		return sh.ssi.UpdateProblemSetStatus(ctx, request.(UpdateProblemSetStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GenerateProblems")
+		handler = middleware(handler, "UpdateProblemSetStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GenerateProblemsResponseObject); ok {
-		if err := validResponse.VisitGenerateProblemsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateProblemSetStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateProblemSetStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1456,11 +5022,11 @@ func (sh *strictHandler) GenerateProblems(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// LoadProblemForAssignment operation middleware
-func (sh *strictHandler) LoadProblemForAssignment(w http.ResponseWriter, r *http.Request) {
-	var request LoadProblemForAssignmentRequestObject
+// UpdateProblemSetTemplate operation middleware
+func (sh *strictHandler) UpdateProblemSetTemplate(w http.ResponseWriter, r *http.Request) {
+	var request UpdateProblemSetTemplateRequestObject
 
-	var body LoadProblemForAssignmentJSONRequestBody
+	var body UpdateProblemSetTemplateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1468,18 +5034,18 @@ func (sh *strictHandler) LoadProblemForAssignment(w http.ResponseWriter, r *http
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.LoadProblemForAssignment(ctx, request.(LoadProblemForAssignmentRequestObject))
+		return sh.ssi.UpdateProblemSetTemplate(ctx, request.(UpdateProblemSetTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LoadProblemForAssignment")
+		handler = middleware(handler, "UpdateProblemSetTemplate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LoadProblemForAssignmentResponseObject); ok {
-		if err := validResponse.VisitLoadProblemForAssignmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateProblemSetTemplateResponseObject); ok {
+		if err := validResponse.VisitUpdateProblemSetTemplateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1487,11 +5053,11 @@ func (sh *strictHandler) LoadProblemForAssignment(w http.ResponseWriter, r *http
 	}
 }
 
-// RefineProblems operation middleware
-func (sh *strictHandler) RefineProblems(w http.ResponseWriter, r *http.Request) {
-	var request RefineProblemsRequestObject
+// UpdateTaskStatus operation middleware
+func (sh *strictHandler) UpdateTaskStatus(w http.ResponseWriter, r *http.Request) {
+	var request UpdateTaskStatusRequestObject
 
-	var body RefineProblemsJSONRequestBody
+	var body UpdateTaskStatusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1499,18 +5065,18 @@ func (sh *strictHandler) RefineProblems(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RefineProblems(ctx, request.(RefineProblemsRequestObject))
+		return sh.ssi.UpdateTaskStatus(ctx, request.(UpdateTaskStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RefineProblems")
+		handler = middleware(handler, "UpdateTaskStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RefineProblemsResponseObject); ok {
-		if err := validResponse.VisitRefineProblemsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTaskStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateTaskStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1518,11 +5084,11 @@ func (sh *strictHandler) RefineProblems(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// SubmitProblemAnswer operation middleware
-func (sh *strictHandler) SubmitProblemAnswer(w http.ResponseWriter, r *http.Request) {
-	var request SubmitProblemAnswerRequestObject
+// UpdateTemplateProblem operation middleware
+func (sh *strictHandler) UpdateTemplateProblem(w http.ResponseWriter, r *http.Request) {
+	var request UpdateTemplateProblemRequestObject
 
-	var body SubmitProblemAnswerJSONRequestBody
+	var body UpdateTemplateProblemJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1530,18 +5096,18 @@ func (sh *strictHandler) SubmitProblemAnswer(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SubmitProblemAnswer(ctx, request.(SubmitProblemAnswerRequestObject))
+		return sh.ssi.UpdateTemplateProblem(ctx, request.(UpdateTemplateProblemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SubmitProblemAnswer")
+		handler = middleware(handler, "UpdateTemplateProblem")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SubmitProblemAnswerResponseObject); ok {
-		if err := validResponse.VisitSubmitProblemAnswerResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTemplateProblemResponseObject); ok {
+		if err := validResponse.VisitUpdateTemplateProblemResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1549,30 +5115,49 @@ func (sh *strictHandler) SubmitProblemAnswer(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// UpdateFamilyTask operation middleware
-func (sh *strictHandler) UpdateFamilyTask(w http.ResponseWriter, r *http.Request) {
-	var request UpdateFamilyTaskRequestObject
+// ListFamilyInvitations operation middleware
+func (sh *strictHandler) ListFamilyInvitations(w http.ResponseWriter, r *http.Request) {
+	var request ListFamilyInvitationsRequestObject
 
-	var body UpdateFamilyTaskJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFamilyInvitations(ctx, request.(ListFamilyInvitationsRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFamilyInvitations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFamilyInvitationsResponseObject); ok {
+		if err := validResponse.VisitListFamilyInvitationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAchievements operation middleware
+func (sh *strictHandler) GetAchievements(w http.ResponseWriter, r *http.Request, userId string) {
+	var request GetAchievementsRequestObject
+
+	request.UserId = userId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateFamilyTask(ctx, request.(UpdateFamilyTaskRequestObject))
+		return sh.ssi.GetAchievements(ctx, request.(GetAchievementsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateFamilyTask")
+		handler = middleware(handler, "GetAchievements")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateFamilyTaskResponseObject); ok {
-		if err := validResponse.VisitUpdateFamilyTaskResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAchievementsResponseObject); ok {
+		if err := validResponse.VisitGetAchievementsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1580,30 +5165,53 @@ func (sh *strictHandler) UpdateFamilyTask(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// UpdateTaskStatus operation middleware
-func (sh *strictHandler) UpdateTaskStatus(w http.ResponseWriter, r *http.Request) {
-	var request UpdateTaskStatusRequestObject
+// GetPointsBalance operation middleware
+func (sh *strictHandler) GetPointsBalance(w http.ResponseWriter, r *http.Request, userId string) {
+	var request GetPointsBalanceRequestObject
 
-	var body UpdateTaskStatusJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPointsBalance(ctx, request.(GetPointsBalanceRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPointsBalance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPointsBalanceResponseObject); ok {
+		if err := validResponse.VisitGetPointsBalanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPointsLedger operation middleware
+func (sh *strictHandler) ListPointsLedger(w http.ResponseWriter, r *http.Request, userId string, params ListPointsLedgerParams) {
+	var request ListPointsLedgerRequestObject
+
+	request.UserId = userId
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateTaskStatus(ctx, request.(UpdateTaskStatusRequestObject))
+		return sh.ssi.ListPointsLedger(ctx, request.(ListPointsLedgerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateTaskStatus")
+		handler = middleware(handler, "ListPointsLedger")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateTaskStatusResponseObject); ok {
-		if err := validResponse.VisitUpdateTaskStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPointsLedgerResponseObject); ok {
+		if err := validResponse.VisitListPointsLedgerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1612,12 +5220,14 @@ func (sh *strictHandler) UpdateTaskStatus(w http.ResponseWriter, r *http.Request
 }
 
 // ListProblemSetProblems operation middleware
-func (sh *strictHandler) ListProblemSetProblems(w http.ResponseWriter, r *http.Request, userId string, problemSetId string) {
+func (sh *strictHandler) ListProblemSetProblems(w http.ResponseWriter, r *http.Request, userId string, problemSetId string, params ListProblemSetProblemsParams) {
 	var request ListProblemSetProblemsRequestObject
 
 	request.UserId = userId
 	request.ProblemSetId = problemSetId
 
+	request.Params = params
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.ListProblemSetProblems(ctx, request.(ListProblemSetProblemsRequestObject))
 	}
@@ -1719,6 +5329,106 @@ func (sh *strictHandler) ListUserProblemsSolutions(w http.ResponseWriter, r *htt
 	}
 }
 
+// ListProblemSetTemplates operation middleware
+func (sh *strictHandler) ListProblemSetTemplates(w http.ResponseWriter, r *http.Request) {
+	var request ListProblemSetTemplatesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProblemSetTemplates(ctx, request.(ListProblemSetTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProblemSetTemplates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProblemSetTemplatesResponseObject); ok {
+		if err := validResponse.VisitListProblemSetTemplatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProblemSetTemplateProblems operation middleware
+func (sh *strictHandler) ListProblemSetTemplateProblems(w http.ResponseWriter, r *http.Request, templateId string) {
+	var request ListProblemSetTemplateProblemsRequestObject
+
+	request.TemplateId = templateId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProblemSetTemplateProblems(ctx, request.(ListProblemSetTemplateProblemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProblemSetTemplateProblems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProblemSetTemplateProblemsResponseObject); ok {
+		if err := validResponse.VisitListProblemSetTemplateProblemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRewardRedemptions operation middleware
+func (sh *strictHandler) ListRewardRedemptions(w http.ResponseWriter, r *http.Request, params ListRewardRedemptionsParams) {
+	var request ListRewardRedemptionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRewardRedemptions(ctx, request.(ListRewardRedemptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRewardRedemptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRewardRedemptionsResponseObject); ok {
+		if err := validResponse.VisitListRewardRedemptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRewards operation middleware
+func (sh *strictHandler) ListRewards(w http.ResponseWriter, r *http.Request) {
+	var request ListRewardsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRewards(ctx, request.(ListRewardsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRewards")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRewardsResponseObject); ok {
+		if err := validResponse.VisitListRewardsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetStats operation middleware
 func (sh *strictHandler) GetStats(w http.ResponseWriter, r *http.Request, params GetStatsParams) {
 	var request GetStatsRequestObject
@@ -1745,6 +5455,54 @@ func (sh *strictHandler) GetStats(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
+// ListPendingTaskApprovals operation middleware
+func (sh *strictHandler) ListPendingTaskApprovals(w http.ResponseWriter, r *http.Request) {
+	var request ListPendingTaskApprovalsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPendingTaskApprovals(ctx, request.(ListPendingTaskApprovalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPendingTaskApprovals")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPendingTaskApprovalsResponseObject); ok {
+		if err := validResponse.VisitListPendingTaskApprovalsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUserFamilies operation middleware
+func (sh *strictHandler) ListUserFamilies(w http.ResponseWriter, r *http.Request) {
+	var request ListUserFamiliesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserFamilies(ctx, request.(ListUserFamiliesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserFamilies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUserFamiliesResponseObject); ok {
+		if err := validResponse.VisitListUserFamiliesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetFamilyInfo operation middleware
 func (sh *strictHandler) GetFamilyInfo(w http.ResponseWriter, r *http.Request) {
 	var request GetFamilyInfoRequestObject
@@ -1796,36 +5554,115 @@ func (sh *strictHandler) GetUserInfo(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaS3PbOBL+KyzsHqkou3vTTWsnKVUyMyk/TqkcYLGlICYBBgA9cbn036fwIMEHAJKW",
-	"5ElSc7MJsh9fv9DdekJbVpSMApUCrZ6Q2H6BAus/1yVZC0H2tAAq1YOSsxK4JKCPMxBbTkpJGFX/yscS",
-	"0AoJyQndo0OKdoxfYgnqbMd4gSVaoQxLWEhSAEqHH5DMS0dILCvN8d8cdmiF/rV0Ii+tvMuOsNfmk0OK",
-	"JJE5eMmaBzOI3qgPDocUcfhWEQ4ZWn1SMltSNS+neCP650ZZdvcVtlKx98m7ekJAq0LRZSVQlKKMUUXn",
-	"Lmfbe1CsCOeQwwOmskXVKTUUuEVTYnGPUmXFuxyKawiSuOCAJbzFBckfb7C4v2BFgWm25nsxdANNdRxH",
-	"R22AoaYQwMiI8rEROSrKBI+8FcA3fj8LuUpfWmdlS2yK6GJDx6SPS+esFn9BkyLS/jFiFCvcW8bfZES6",
-	"qECYc/w4UL0jQ4vjBDAuIYdZLuXV0uM4YZaG2RXLO0GAs4KoyCqguAMeioCWt872MkN5k3UtEcg/NdKz",
-	"3c9xCaj/DijwlgNGEcdZRpRCOL+CbxUIeQPf5fP8sAr5cNyZqqj7fGA4c87qUlxcqea1gKgzK1RPgQ55",
-	"R+x5GlyBqHJPkbUoTY/lEM4huT46Bj30qPgT+HPSyVp/6fPxsdAhR2VmU4ttfNTix9W2os6O8ZMJOkW8",
-	"Oj2fSkoiLhjnipk7vWMsB0yfkYgctbguQS2OdbRg9Tq3v81zNXXf+uH87Ap2hE4rEX/LBUTpoyQMlqPj",
-	"qk2HfEuDAFrX1V1BZMf54gVIvxKAa7Q6WWmmaNcrRe7L1MkwW6crEOVQp60J9nVMNfhe5pjisFfHElDf",
-	"h5t30wHvgErq2qY6Kl+HwCioY3HBKtpmT6iEvSlas/tWySTOx6hOdVTXPDY+2hN6yDCAw22Z/ShtnBGl",
-	"NkwVzzRbVtQTh+OHCsdND+Y1ItNbf9Us1XWB5VUdKd5Q81fpKXEYyyDN6U1wQiJY/gDZPLgro9gDySCL",
-	"yObPzu3kdWOLa0uIAPUhFs0TL/y3G+PEG7pjgxqMzFlC1GE66JIKQt8UmOQdQEA/8YCx07QuiShz/Pg7",
-	"Lvwwm7duOYl0k9OLaq3db6a/9TWaKnHMpmdCfmRA4FRJ22D5kHCa1RLFbGW1CVnLNvN9e8EMUxEuZNBE",
-	"OY4ccjthmJQ59TiiD1stlROixdIyiKHzvDlF4G553vFFp0eLzzBuN3qk5I1SdZJsfDH649jcyB81eMSy",
-	"zbet2ZWJorWdYO3akZGifQXCN9FVvIkF0RoJXX8pMX1PMpGsP25Qih6ACwPs61f/UfxZCRSXBK3Q/16p",
-	"RykqsfyiAV7ikizdhVM/25vepmujnAiZrFsvarJcXwtVUUAfiJDdcw6iZFQYS/739WtTBam0twFcljnZ",
-	"agLLr8K4tUF7Ts/R2mkM81m/aUR/vNfWk1jdVD4hoZC7J8pl1WMNxtZcZsRyqye+C2OYRX2jKpnwoGOm",
-	"w4lNYDdmNN/Fpz+GR8aJQMj/s+xxFjYjkMQG/oeD8d6hZY4Gqu63FoQuhG2PY2DVfWpCaKLaaT9gnYn7",
-	"uTELjvdfALbpiI2j9XJQvQBOmV42TItDs5iIxmF/d3E+oGJbknMAtbc7gkV7duPHqV4nJPWryY7x+p9E",
-	"ePyrv4A4H2yxVUcYtpMXltFt2lHFJWc4q+202DG+wN3dvNdqHxjORELhu2xMpczW+nRQlAN7ivNZb8pu",
-	"5wRWPE4Ku5850oZm2jgh2sxcdnqsdee457NVeF78y8SZ0APRJtKw2xB5LWXmp41x7Ot9+3imrOcz0siY",
-	"+vyxNDJTPtJAlZ4lTqvuZu4Yre79Ken57BKbx56julugFEILNwaNAqXESuz80w+Um+GeGyj/tPiEQFkP",
-	"snOo5ZMZuB+WdegLkGL51N4cNWdCV2Cw+8xw+9u6f7vuRSVz9alqz309sbsotxJ6iTkuQOpR4Kc+rzab",
-	"zSVSDT9a6ZYdpYjqIUZ/A+bGEZJXkLaMMxjg+IcvATatzdpUBp9/5rpxjBM1zzfZIehHe/C6kee6Ld3R",
-	"L+wtaUidEVVe2Ckn/1rnCKdr+9jkPCTs7T+5e0wMHW8SUmYzpcqlo9E81LP1twr448+UGq7hxGkhlgWa",
-	"VCHsBnDEhs1r/W5AWVLRCtqxLiPXDaN/6skJnMa3xD2N94j61wteb9CXNPUKEZJsha8SmJ8/jFj5LWdF",
-	"kpnNqi9ed5ypWuKAmfYjyYG4LMZEsvksXsrA7ockpzFrVeeFeqnlNe87kIkAIQijNkHX2+iBmVuL7DOW",
-	"q87CfFzz201X5aq1xQsqnLP9HrKEUJ3Jggo3G8Gzqttwmaqse/BU+3V3xXb4fPgrAAD//3ei6R9pMwAA",
+	"H4sIAAAAAAACA+1dW5PbNrL+K6w5+7BbxfE4e97yJl+ymV2v48zlpOps5QEjQhJiilQIcsY6rvnvpxs3",
+	"giQAgpKoeL1+ScYiSABfN7ob3Y3G54tlud2VBS1qfvH95wu+3NAtEX8udmyRZT+QLcv3/6TbB1q9Lrdb",
+	"UmSLai0a7KpyR6uaUfGvqswp/v9PFV1dfH/xX1fth6/UV6/gk/J7N9j4Ob1oOK2uM3yt3u/g9QteV6xY",
+	"XzzDs4r+3rCKwtN/6Xap7OXXVDcvH36jyxq/hKNl95ys6XBoS5Ln4o+M8mXFdjUrC3j5fYOTSspVsqYF",
+	"rUhNE5hdAuNnBU2we8oBFdMZK2q6phX2lkFj/OCqrLakhmf4w2XNtrRtrqeS4mi2u/qu/EgLbs3V+l5F",
+	"OWDFaahNLFZibGkLmZx9bxSDLsOY3tBdWdVDZFeCmm8I/Oc1dvOObVntG76iDavplkdwiibnsxkZqSqy",
+	"11BEdNoDxj1Y99f0eH2wcM7WxYeqfMjp9pbWd3S7ywH34ArZikV0nTlYcZHnSb2hiWzCxd+1+mbCeEJE",
+	"fzRL6jJNluUOvoh8q5vndFUnZVMnpAIerpYb9kiR9AbqAUv2EdWdxTCY1Ta1JhWEagvUHSLSAcExSlhe",
+	"byatNJY5vwO9roHfo7jODPeDfgk+wGtSN9Nev5WvILaslqLRQ4UJH73DF/r0YJmGQvfVImeGPkqdDxZG",
+	"XebUT4D5nkiVSe7McMEkvzdlTZATSbKTiyHhtFbsKmie9mUxzDCnNc18glDPa3Q5t1+yfh+d5q2hJC2a",
+	"LX4HRlfAJzLAHf73kJfLj3LxVBXN6SORk6BFBkRb7GA2jyS3+mmpOaSU1UtN+EcJhhIZ3k80GavfFnW1",
+	"H64XsvQuFXhUutUDPFvVgN+Arn+//ek90g7JudyQYk1R+S3LKkvEG9aDNNkymFqxTp42tEhYnTwRDo23",
+	"pRQ0gy4fKHAgndSnfGW002VFSe3u1LP6a1Ktae3BRkiSSPniXHeypYY/1TSyuvXw5CuSuYwVAtKbPnZW",
+	"wEMJVg8pJJnl02licUzORojN4VKV8/M884g8N4JSZNmDNF+3BtFO3ofoa8Ea0sK8g/UW1MZiQcbaq/i1",
+	"oR7EH4ND+empkO8HRyLtkvdk61YSK1bx2vs0J4GHyA//i3JtsA6vF+8XCT5O/g+eJwV8IU3oi/WLZMEZ",
+	"ufo7rcD+AUE1ugiswVv9BVFpzaYgLELD/IwKJmS6K4GKSonUidYJlopqdVGaZHRFmrxGTZZ8h8YLK9gW",
+	"5fN3TiN/3Dq599nk8G3yaVGjqVQHtx6k4E9owoHpWT6BMISPJhQY3ejTpqhZjsIPzUDRGFqBwASpWef7",
+	"qXPalaxwDeiD+B16rgp7EKafhJc5rDw9KuemSBgDd3EmTcsDP5u34uWGZeUoCkQyXJSd/sUz3pfJWp3t",
+	"TfzO4+viyNHtUIcj+XUxJgXDQqY1J8MN+JSNtxrcD2X1FkzRIc16U++Mwepx6vIEMKKW5xxTOmIDbIYT",
+	"nOMNxa1TcF7LknusqTF5MJFHRUee0b6hS5ap0d7QDGUM9BocNxF7Iuq2V4Eceus/GHZlvh8Deqd1anoN",
+	"zgPNNr1li5gDyX0bqMOm2JuB1UnE+FGbTDBlo/hWtgt2OVFXHrhsgkOIWCuVaBLHNqplsEs9VzX7GPnj",
+	"208eKUf8I337CX2wkcZzWG3oXeMEpfgDy+kP8rVxvRPWEO3YzEimTfmGcjBLXBIUZKZH2Khn2hhw7LJy",
+	"6tlH9Xc8umX3o+ZfvsnIxXxdPLKaaFHeG79ybUTv64FMLO80l7+kU3eRDEdFs1f76XvMA+I+PUD1mMWX",
+	"7LGkBpEgpDdqBNrVRjIwVI0t5vOzWRv7yX7pWU1dYeaKrbmIAsAA0T5X7kmvx5Qb1+Tg8/8k1Uf0opnP",
+	"4bcS/R5Y/olALNH6qe3G0nJIyqyJIjViequbz2BC/01F67TdGNbsGRh8gAPJb2RA745+qg+zpWMDcD1x",
+	"1wRN4OvtBKkeEnBZtb9piiH1fypgrwRUZShJBA+gAEuB/tix5gtaVSVsFZ9YvcE4Etuqh05eOLNyGUQj",
+	"TJwB9qVypGIO7Y68TBOSFPSpE5RgxnecrKpyCy1+42Uh0JAOZuVtHnd5DfXXqPD3kNmnySQ5DtjhyH7e",
+	"4usuCSTRomBcNYVnszFNtXc/mOqB+1AQoj06peBk6u2cCswz9XdsRZf7ZU6HASiMGDyKIEIbuc2oDG15",
+	"lNe7kmTtnraNOoVFoWnmX7lT4gr97Y39+fZjPjzCM/AtDO3tiV4QPunsG9f7ZksrtlwId5hjvwMkrkix",
+	"pC41+4ltSW7cbRnjNbaU0gYFFEjhhnbdai9bGQJYl81DbgFdCFceDky82aWLp21vtvJFz1yl3fGK5HpC",
+	"3bk+tA+cGSu0UmEqpzWjGyQP+6Tdu/NERVId1sYBuS4myUWP1RpYcNKeAOvJEg6MpedCjnCnXekKjrVx",
+	"fPVJ875veu0C6a1+6Q0e0uv1pmRLKriRg9RZ1oJh06SUAWChgPAhKpFiTYUrtqwyioNOLG/fRE2lVphD",
+	"SY1Z3xsEwmgwn/dbtLLtgmRD3MlcHhpW9JGSnGY/uo32HxWXy0YJL5MVAcTKjOynZd4cmx2i8IxODdGM",
+	"0AEyzE0+aXhgXHl6dDhmeNq5e6pRMoBGxBrcbr+Ju5r2a+G5eGdBlku6A5Ng4VvHC9VAhXJA21SMII/C",
+	"YuAbYSTD1se5YkeZdFR4oFBYir9NB6klPmB3KfI6JARSfLgFSZqgptgnJbSvnhinh0qWgK/f2FlDDNWT",
+	"di+BQTEdaoLxVrRGyoq57UW6XVEipqB0CoE9t/cd3Ll9GuNH+mkHysz4iLojfNs+NHk0ClVJpBSJ/QQP",
+	"0fSwxZ8V5HNpsk2UmEPPASh0/B8MQOXIpkhfQclJTOVZd0XfAhuhetdigw/IkTjsEs5s2Awukje5yDaJ",
+	"T2U5iXzuy+SwbLA3d47sUq4zbB1bnCf/RpoVGf2EKFgbYqH9c0yDFr8v+aP6+aXcKhuXAXIVrgCRAsh3",
+	"dMlWbBmIyfa98zCw1Aw9PPtbWp8hVn9xSECe/ZEpIBf/hnke0fm0/W3zIMLSnR70IyZFVGo05krvh/mp",
+	"+hMpcDOD6QOuJbrJdEZivanKZr3ppGG7ZOYhaW7hfFynT8zyFeAKRW8Tf/S5Btov2Bm9k5JwFTd4TGzt",
+	"dnWmjLBimTeZ9ioCPbnmKHQtSqPcyVTqG79Aqx8D9n2gc9kDdruh+c5sAdzLY8zXG8pDjqZ7zxHcxt8d",
+	"ics91H2AjLLNz/bi7GL3y4aiUTVI317ily1EjbREm65jW8hlgm4+xY6WnaNaLgaazMOdOuT6TabHyvTA",
+	"+RHpnDTnQXDJCR+LkX89SfbVpGcdpUgOl94RUSrb7LMEOEYYciq3TfiZJq8ZcO6t8LxcGOsXu8I9m4hW",
+	"KZMWB+dZVpiqQ7dnTZxos4OGHm3l42vTXKSow7f9Pu0bce4uKqj3h6S94QxwhN4A4nHxwc7nx9PKbsTZ",
+	"i+hQykSHqrdTwYhRNIpKXPQehpMxEUsVrfQmLdUbNtxnT990nixzxZpBGK1ebhGPSy7y4NIHpLVkj0Ll",
+	"+KylAAroOlCTR0vmuBhVKPsqHJAaTbDyjfSG8t1wpF0vtMvNH3QfIzE3LheydJthxFJ4WRjXZM33bVN8",
+	"cwKBB6LdHlqMHxigKT/SfgbTaYK3zmCqdxyolE6ZO3syF3U4r7afUevck239yWv+6WGea7aopxwGE694",
+	"cr2Yfz9E+aTktICpoR/eeX1r0XbdwAyZoIhZT/2q8XZHp9C3TL8WCw+xb+uKEkda2QP1kXHZgNlcxBxi",
+	"1y1T+TXfCJqHLas7/vCw2BVN3B6VXFhtOqqAwQRhwvbd/S4W0J/lo9+Vx9mVMazjkG1MAF06ed4NJnAx",
+	"x1r45b3hhGlRjmOUjxWk70l6A5yy7UWVCXdE5rQKzc8FbpXW2cK7mOF1xxvg5wUdxBEkET4Hve3FYQrH",
+	"wtMG866I2kqLSApWMXBxUW9YfGxc47x0SGBqEiep7/2PmyOGME7mjEGUaGr4skI9W+CRdq83Qz+R5SWk",
+	"W/WpKtGvoCJNw+iTmIE1NEFY4Vs1tosIIBRo2wyJ7pN67WQG7HDhmotvQTyxermJPpIbo0NMS0+X9hEU",
+	"x4rLCWjPCUo1ZCWcKnnFe5xEPvIr7cP0rurO/rid96IhCuB7a+UmD1yeGL8ySdSYfqX+qVNdSaJTm1WL",
+	"BBi12icyj6Nn0xYTD96LT71/Q/aO5XXXDkp1+R475al0yKLsMZljYHuATBAZvH9W8ktMQiS04jLDR39x",
+	"OtnoJ/TIy4oBLj2sY3b4Bd5JOce4Hg4OPv9ncTR8U2IW8Z7/xZaFcUD0BaSY0DQsnyj96EYSf9UbYmzV",
+	"TZwXM0iF/YCDl/JH6KcJqQW/yM6dWysvW4LByL1rHlv4ohziWbIl1UfMI4TROAMrUZl0+PZ4V7IPJPih",
+	"/UwWPq1r5RAvnR3ecvkzyprk3WkfnmTYyiIjs3qopg6iDgfhkWD3u4x0k6IxyfgLr7VmDxpogdJq5ByG",
+	"Go2jbFcv4aR8As4v9sniOhGly+SyBtlDE6ls4ecCmnwEAwJXdJo0hQ7cuvP4LZoTdu+uHTY2CBHdWNlh",
+	"lmOGgcLpFVl+RE+zW6aZEQiZ9QBt5SkdLrptduY0h5Bzcm+KMrAzRD5uWQ1GEkHwOWqrpFNPsabB+ity",
+	"uHI5aVnc8JHDNSc0rY4rGOY3wCYvYoNWbEUwiVzMQdgoD/9BgZfwPvv0Lvz27yhUvlWu+coq14zGE89X",
+	"SMSTMzJaSqPPoBEC78jSIoeH3uNX6ARR9a26z3904ahQADM+Wd8ObQZPHnRZ70Rr7UteibaujFmVX4/R",
+	"5TM/pxlUB1QYmcF0OkF1knFTCVhmISs1bnVZ8f7BwmxNJ+36Za1MfzoHvxVSZvxYM29jcyNdqoaKMw55",
+	"7yBbXXc0GLF7rqkGM0AMLaXKvHGHnnUUZvRMndY5JvQmQwA4ARTwjxhX1oEAlbigExlCSb++ukqDaJT7",
+	"0ArMMRs/CWjiD6rOrBwmfMccalCaiuS+4bLCXyR5oTWsjJCIXGRiB1DS/mEc69DQmoiCJUMMohRDIIou",
+	"ZjRNiDaSYwQtg9i7Gk4siGJ/wsTKouxjWxDpmIE1W880XMGjpQkstcyU9pZEh/SelaY9xHauZ1PIKMK2",
+	"VH/UDeXyLzDZCv13vWkq9eeqYvIPDiqlwj9d2ZL31zoVZ1V6k4EZPkwH5VDAzHsbX1dhPrfdmd1zbqe1",
+	"nBvjoOgCBXhFq/uKBWoBxWszTTvpm3I6r/8456DSQZMnY3x4obSzFsfU5kMXGVpY9YgcsPi508dIrqXb",
+	"o0c4tX4YEfw3K1HSDsLqMuDq7xD4VCerv5XPmqF8VicVMXw64f4aF4ckrMOS0WLCmHboQNBStVwNdYqs",
+	"XzMMLVaNrBYhik4pHSDT19AvoBPAVAqO6ImDglX13wcQ+o4Lz7doBKSFtUJSPVc/qm6VjE+Sa5dCPo8A",
+	"KYuHklQYxb2xDrY5yGVO92rCi+PtmHwgzP+9puMeb/kosmTbgHkvy2rJQ+EV2NeJKuHnJGMMWSSUh9Va",
+	"st61TDE57IWqDLiyJX56sW66qZRmhQmbX9JTrcKL282OFP9gGU8WH67h3UdQUhK/ly++E1DvaEF2YCtc",
+	"/PcL/AkITuqNoPUV/H5F2KW5Ckndn9Clw99obfkZwcIRzXV+Q1eFCxr0zvPxVJoFmF11f/da5ZRIGaWO",
+	"7yEDEl14FzvsXvOEQ66AlWph1/xroBExK0XdMsXwh98bWu31Uvn+ArNWLlJ1lVh8MakBP5ahTupyehe/",
+	"trdeCXr89eXLXlU9kOE5Wwporn5TVXraTuIurFIgCu7pleL7h8wXIej0ge0BstJHhkIaf5bcYTxT3Msg",
+	"OYM1t7Aa9gn6Dhp0nx817egLu9oDl0NT8CAw8Cqcy7xce6HAmdrLQryRwBtBhhf4YMt35fqrZfbUWQhS",
+	"8I5MU8AEo0xU86g3IOhR4Hu6by+3acdwQHdCgqEtIW7IUdeNoEX1QioQf+/SJDhJ5+ZeGSfO+sqeUGe/",
+	"nms5tRdBnWY5LaXPmV+RLLuUC+Zya3Y9O3XGpGcMZllr+9WltdYGq6p3WWR7UOJVme1PKmf9t1I+Pz8/",
+	"u8lzBFpCrF1q7yutL2v76LoTtltaO7V1oouuYIM79wV/Q2A9lw3OiHDE9YZzQC3lgOZNnUXkhljeq5Co",
+	"7fmdvF6tC1z/Pqj5AAvdPDUjUOVTocAaxUnWw9X8SAqylqUZhY++XK/lRkxY/eIQgsiUFwV26zRptw+i",
+	"hawTDFsDnyjo3X41N+7Oa7ZmhF2LgktWXHJVlSiEvSlaAgjfCuXjgqtzJ8zckHkvoDkTbOMi1IGdW3yO",
+	"wjm/vIy5x+YMwMaz4jgbno8Hz8+AUSrcyE2nyrb0dcLx9AbJh971MLxnZsvzq/GqPawdRlg27BtLGMqo",
+	"RF0TU9mJVYkpg+sCVx0PnxnQYZmVOUCUx7QViJdV9+S421aXtVZkmcrfxJk8c3ZDQWx9po+g++an+bAc",
+	"v2lqRlTRtLwk9rG8aERFCMA6miMzBNtwQBsFcOHbOQ44M7a+26/mwRXzI+Msd3nlUtBy719/NSdU/ou2",
+	"ZgRqkipSgPlUkXIBt9fCs5p7tZDvlq+5ET6/FlJIj2khA26llYcLsLk1i+/msxmB0Xx3aaUbRvKfuj8l",
+	"yhp3XrA2N5CBbMs5EKXinjINZHhPKO8066GH5fXlTTQy3xxMHwyjqctVRIbHANj+5WjzYRq6ec4P58y9",
+	"qxtCjnSFrtVdUpd2xTg34fS1U+3ZAIxtW2VlHYG17kVV81EodCXWCSh0sptjj6KVXA5xq0yWym69B0pi",
+	"ySLWuNqKhH5iXJyytxdiWcm1KMqoFUNp1r/HaT6Shi4Gm3/Rhe+rOpaQIj0gNgQhL40CmgjnJFDnt1Kl",
+	"jKgN48M+0VkBPWINrpuakVzBq63mUDp5STKzGEAUXVoFb71g4rVLeEVBW1xGFnaxa+X2gqWei5rmgzLm",
+	"cqv5V0DcBVVHrgTp3xi1URew5QTGV94QbaqmyhkiMj047PRreelDLyONDjcBdrnY+ejoK0o7x2KQtVMj",
+	"tLisMhuvw7tVaecEy1f99qvR35UoWRsr9mWB2zb4bGrheGJOw4K4cxIrVHx3Hv4WBcmiGFwWMUOoxgJP",
+	"vXq+cwLmLRw8J1r9Le4BsEXucj21fmdHNFRceB5k8RSVMTtEHdoApthY1a8FiwNbdy7jSNXZLLDsMqHF",
+	"KrrEaWXtXQ5WWTlQf42+08hO+XYQo1fId04yBKobz2+kBEsWHy2useavFtese2+5j96lOG+jow4rfRLJ",
+	"vOsglaOw8Kz0GilkPMeqkeclzaoh7XV67uwh0dyYJap5HzpHdc/5cBspKDs/p4/UMj2S12W6y2guza3K",
+	"ikG5JAvUKw5XydndRJohxazykzOSylPkcg7GlkfNuibdpc7wd2MIJNyWtYipZVT8RbqR3wFs7gJp8wE4",
+	"XpBtfii5qqnmx/G+PeWn4DPvhAC8bRudAT5Xabj5wQuHHxVwofBjv9jZecA6R/hRAaVWqgiDt1UzRhmt",
+	"d5y0t3CDOffugmxzAxsqADcjvKMRNYVpu7nwbNA6ZWbmButMwbIuRqPsp65kTfFC8RoLNwjF0QtIxsB3",
+	"Ho7zlgSaH8pgQERxXCj/r19O61xQzZ//N8DpNFwXA+J5uc5V9+08gI5nsQxk3hFZLL7Kb+fD+VxZLArp",
+	"KZpaFBY3fOcC7nwK+OyqNz6rxaWDI719zpJes0N5lpyWgbtn5ByqdvVYL4ycE8PTl30HzNnOqA48TaeJ",
+	"cXQ2wPzqsyzp9XxFenXQgge+uSj0Jc90y6peDiPbeXrb7mXkPKusRPBGH4DEs+nt+UfrLiR94r6uGjrn",
+	"acgx1u8XkzstfdqSIkHKKHfzA8kJBljjqCLLkryS73xlZOnObQ6aXOY0W0snafjkt6KNbJ7AQCsWt3Dw",
+	"C3Ie72RXZyZR+q2uwtTwtKDWKY9Ee3mwzTGDX+2yqOYZFzk3NJP1yfwK0jYoPthZB/iqvB/AwZf2LRc6",
+	"khjkTrsbH5P2yrsewaqzr4VrcQ0JXhEl9mGZdX9Xt6CwzH7RzTxMLS81oYu21WAopkLN+bh5jmSLYxja",
+	"/A4/enl6TZ0s7VSA5tF/Eufq6YxM5QvT54pURzGdzWPRMlGXPsAszsCGoS1Y9sG6N2iaxu4JhT/Aqpp+",
+	"ydFpxUJIChhRwVWd4BEammb9FDqkpDP8qen4oa1j3OjN31csIc7FNK5Sz6fhHottjH9lhDussKTLo8JH",
+	"rB673ZlXnHH6zIjd1ee2uPpRBqVxnx5gWTpy1aJXoenXt046xeO/WAF7WttrcFSbh3evgzPZukwfBzFK",
+	"h/WT7Q+7SNs/Sz1K0bZKlvVpnXrHeGJuvHapT/NwUj5496rus9F7cKz9lBTn0eXpdHs/9c4m8FTy/2lw",
+	"4PqmSycKdzprAM9bLblrqyCvyvxWd/I4mraXjp6GrJ0qCfFcXvdvLxUe7SfC5J22eNzIUTPZoapkaMEu",
+	"Y3C25dGp03AaNBtlhjMaCyVTF/L26nMNyjEHtkvsTCZUp6T0dLzurx1AmesNvL55VS66zTNelS7pYl2W",
+	"MOM2unMpw/QpN1bxaO+E+5zgmbApRD3rdE0vsZNtf/isxWm3nPLzr8//D7LJ4+aRxAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
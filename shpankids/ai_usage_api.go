@@ -0,0 +1,27 @@
+package shpankids
+
+import (
+	"context"
+	"shpankids/infra/database/datekvs"
+)
+
+// AiUsageDto is the AI usage of a family member in a single day, a call is a single request to the AI provider
+type AiUsageDto struct {
+	Date           datekvs.Date
+	UserId         string
+	Calls          int
+	PromptTokens   int
+	ResponseTokens int
+}
+
+type AiUsageReportDto struct {
+	FamilyDailyCallsLimit int
+	UserDailyCallsLimit   int
+	Usage                 []AiUsageDto
+}
+
+type AiUsageManager interface {
+	// GetAiUsageReport returns the daily AI usage of the family members between from and to (inclusive), days are
+	// UTC days, the same days the quotas are counted by. Admin only
+	GetAiUsageReport(ctx context.Context, familyId string, from datekvs.Date, to datekvs.Date) (*AiUsageReportDto, error)
+}
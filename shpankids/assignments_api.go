@@ -14,6 +14,9 @@ const (
 	StatusDone       AssignmentStatus = "done"
 	StatusBlocked    AssignmentStatus = "blocked"
 	StatusIrrelevant AssignmentStatus = "irrelevant"
+
+	// StatusPendingApproval is set instead of StatusDone for tasks that require approval, until an admin approves it
+	StatusPendingApproval AssignmentStatus = "pendingApproval"
 )
 
 type AssignmentType string
@@ -30,17 +33,97 @@ type Assignment struct {
 	Title       string
 	Status      AssignmentStatus
 	Description string
+
+	// Progress towards the daily quota, only set for problem set assignments
+	Progress *AssignmentProgressDto
+}
+
+type AssignmentProgressDto struct {
+	Completed int
+	Required  int
+}
+
+type ProblemSetProgressDto struct {
+	ProblemSetId string
+	Title        string
+	Completed    int
+	Required     int
+
+	// CorrectCount includes CorrectWithHintsCount, problems solved with the help of hints
+	CorrectCount          int
+	CorrectWithHintsCount int
 }
 
 type TaskStats struct {
 	UserId          string
 	ForDate         time.Time
 	TotalTasksCount int
-	DoneTasksCount  int
+
+	// DoneTasksCount counts approved tasks only, ClaimedTasksCount also counts tasks still pending approval
+	DoneTasksCount    int
+	ClaimedTasksCount int
+
+	// ProblemSets holds the daily quota progress of each problem set active on the date
+	ProblemSets []ProblemSetProgressDto
+}
+
+// TaskApprovalDto is a done mark on a task that requires approval, waiting for an admin decision
+type TaskApprovalDto struct {
+	ApprovalId string
+	UserId     string
+	TaskId     string
+	TaskTitle  string
+	ForDate    datekvs.Date
+	Comment    string
+	Claimed    time.Time
+}
+
+// StreakDto counts consecutive successful days, Current is still ongoing (today does not break it until it's over)
+type StreakDto struct {
+	Current int
+	Best    int
+}
+
+// BadgeDto is an achievement a user earns once Progress reaches Target
+type BadgeDto struct {
+	BadgeId     string
+	Title       string
+	Description string
+	Target      int
+	Progress    int
+	AchievedOn  *datekvs.Date
+}
+
+type AchievementsDto struct {
+	UserId string
+
+	// TasksStreak counts days all assigned tasks were done, days with no tasks don't break the streak
+	TasksStreak StreakDto
+
+	// ProblemsStreak counts consecutive days with at least one solved problem
+	ProblemsStreak      StreakDto
+	ProblemsSolvedCount int
+	Badges              []BadgeDto
 }
 
 type AssignmentManager interface {
 	ListAssignmentsForToday(ctx context.Context) shpanstream.Stream[Assignment]
 	GetTaskStats(ctx context.Context, fromDate datekvs.Date, toDate datekvs.Date) shpanstream.Stream[TaskStats]
 	UpdateTaskStatus(ctx context.Context, forDay time.Time, taskId string, status AssignmentStatus, comment string) error
+
+	// UpdateMemberTaskStatus lets a family admin update the task status of a member, for today or a day within the
+	// family task back edit window
+	UpdateMemberTaskStatus(
+		ctx context.Context,
+		memberId string,
+		forDay time.Time,
+		taskId string,
+		status AssignmentStatus,
+		comment string,
+	) error
+	GetAchievements(ctx context.Context, userId string) (*AchievementsDto, error)
+
+	// ListPendingTaskApprovals streams the done marks waiting for approval in the session family, admin only
+	ListPendingTaskApprovals(ctx context.Context) shpanstream.Stream[TaskApprovalDto]
+	DecideTaskApproval(ctx context.Context, approvalId string, approve bool, comment string) error
 }
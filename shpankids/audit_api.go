@@ -0,0 +1,72 @@
+package shpankids
+
+import (
+	"context"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/shpanstream"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionFamilyCreate             AuditAction = "family.create"
+	AuditActionFamilyUpdateSettings     AuditAction = "family.updateSettings"
+	AuditActionMemberAdd                AuditAction = "member.add"
+	AuditActionMemberUpdateRole         AuditAction = "member.updateRole"
+	AuditActionMemberRemove             AuditAction = "member.remove"
+	AuditActionInvitationCreate         AuditAction = "invitation.create"
+	AuditActionInvitationRevoke         AuditAction = "invitation.revoke"
+	AuditActionInvitationAccept         AuditAction = "invitation.accept"
+	AuditActionTaskCreate               AuditAction = "task.create"
+	AuditActionTaskUpdate               AuditAction = "task.update"
+	AuditActionTaskDelete               AuditAction = "task.delete"
+	AuditActionTaskStatusUpdate         AuditAction = "taskStatus.update"
+	AuditActionTaskApprovalDecide       AuditAction = "taskApproval.decide"
+	AuditActionProblemSetCreate         AuditAction = "problemSet.create"
+	AuditActionProblemSetUpdate         AuditAction = "problemSet.update"
+	AuditActionProblemSetStatusUpdate   AuditAction = "problemSetStatus.update"
+	AuditActionProblemsCreate           AuditAction = "problems.create"
+	AuditActionProblemsReorder          AuditAction = "problems.reorder"
+	AuditActionProblemUpdate            AuditAction = "problem.update"
+	AuditActionProblemStatusUpdate      AuditAction = "problemStatus.update"
+	AuditActionProblemAnswerSubmit      AuditAction = "problemAnswer.submit"
+	AuditActionProblemHintReveal        AuditAction = "problemHint.reveal"
+	AuditActionProblemSetTemplateCreate AuditAction = "problemSetTemplate.create"
+	AuditActionProblemSetTemplateUpdate AuditAction = "problemSetTemplate.update"
+	AuditActionProblemSetTemplateAssign AuditAction = "problemSetTemplate.assign"
+	AuditActionProblemSetTemplateDelete AuditAction = "problemSetTemplate.delete"
+	AuditActionTemplateProblemsCreate   AuditAction = "templateProblems.create"
+	AuditActionTemplateProblemUpdate    AuditAction = "templateProblem.update"
+	AuditActionTemplateProblemDelete    AuditAction = "templateProblem.delete"
+	AuditActionTemplateProblemsReorder  AuditAction = "templateProblems.reorder"
+)
+
+// AuditEntryDto records a single change made in a family, Before and After hold the JSON of the changed record
+type AuditEntryDto struct {
+	EntryId  string
+	Time     time.Time
+	ActorId  string
+	Action   AuditAction
+	TargetId string
+	Before   string
+	After    string
+}
+
+// AuditFilterDto narrows down the audit log, empty fields match everything
+type AuditFilterDto struct {
+	ActorId  string
+	Action   AuditAction
+	TargetId string
+}
+
+type AuditManager interface {
+	// ListAuditLog streams the family audit log between from and to (inclusive) in chronological order, admin only
+	ListAuditLog(
+		ctx context.Context,
+		familyId string,
+		from datekvs.Date,
+		to datekvs.Date,
+		filter AuditFilterDto,
+	) shpanstream.Stream[AuditEntryDto]
+}
@@ -14,6 +14,21 @@ type FamilyDto struct {
 	OwnerEmail string
 	CreatedOn  time.Time
 	Members    []FamilyMemberDto
+
+	// TaskBackEditDays is how many days back admins can update the task status of family members
+	TaskBackEditDays int
+
+	// AiFamilyDailyCallsLimit and AiUserDailyCallsLimit are the daily quotas of AI calls of the whole family and of
+	// each member
+	AiFamilyDailyCallsLimit int
+	AiUserDailyCallsLimit   int
+}
+
+// FamilySettingsDto holds the family settings admins can change
+type FamilySettingsDto struct {
+	TaskBackEditDays        int
+	AiFamilyDailyCallsLimit int
+	AiUserDailyCallsLimit   int
 }
 
 type Role string
@@ -28,6 +43,17 @@ type FamilyMemberDto struct {
 	Role   Role
 }
 
+// FamilyInvitationDto is a pending invitation for Email to join a family, accepted on the user's next login
+type FamilyInvitationDto struct {
+	FamilyId  string
+	Email     string
+	Role      Role
+	FirstName string
+	LastName  string
+	InvitedBy string
+	Created   time.Time
+}
+
 type FamilyTaskDto struct {
 	TaskId      string
 	Title       string
@@ -36,6 +62,40 @@ type FamilyTaskDto struct {
 	Status      FamilyAssignmentStatus
 	Created     time.Time
 	StatusDate  time.Time
+
+	// Points earned by a member each time the task is done
+	Points int
+
+	// RequiresApproval means a member marking the task done needs an admin to approve it
+	RequiresApproval bool
+
+	// Schedule determines on which dates the task is due, nil means every day
+	Schedule *TaskScheduleDto
+
+	// History holds previous revisions of the task, ordered from oldest to newest
+	History []FamilyTaskRevisionDto
+}
+
+// FamilyTaskRevisionDto is a previous version of a family task, that was in effect until ValidUntil
+type FamilyTaskRevisionDto struct {
+	Title       string
+	Description string
+	MemberIds   []string
+	Schedule    *TaskScheduleDto
+	ValidUntil  time.Time
+}
+
+// TaskScheduleDto limits the dates a task is due on, all the set conditions must hold for the task to be due
+type TaskScheduleDto struct {
+	// WeekDays the task is due on, every day of the week when empty
+	WeekDays []time.Weekday
+
+	// EveryNDays repeats the task every N days counting from StartDate (or the task creation date)
+	EveryNDays int
+
+	StartDate     *datekvs.Date
+	EndDate       *datekvs.Date
+	ExcludedDates []datekvs.Date
 }
 
 type CreateProblemAnswerDto struct {
@@ -51,22 +111,70 @@ type ProblemAnswerDto struct {
 	Correct     bool
 }
 
+type ProblemType string
+
+const (
+	// ProblemTypeSingleChoice has a single correct answer out of the answers
+	ProblemTypeSingleChoice ProblemType = "singleChoice"
+	// ProblemTypeMultipleSelect requires selecting exactly all the correct answers
+	ProblemTypeMultipleSelect ProblemType = "multipleSelect"
+	// ProblemTypeNumeric is answered with a free number, correct within the tolerance
+	ProblemTypeNumeric ProblemType = "numeric"
+	// ProblemTypeShortText is answered with a free text, matching one of the accepted answers
+	ProblemTypeShortText ProblemType = "shortText"
+	// ProblemTypeOrdering requires arranging the answers, which are kept in the correct order
+	ProblemTypeOrdering ProblemType = "ordering"
+)
+
+type NumericAnswerDto struct {
+	Value     float64
+	Tolerance float64
+}
+
 type FamilyProblemDto struct {
 	ProblemId   string
+	Type        ProblemType
 	Title       string
 	Description string
 	Created     time.Time
 	Hints       []string
 	Explanation string
-	Answers     []ProblemAnswerDto
+
+	// Answers are the choices of choice problems, or the items in the correct order for ordering problems
+	Answers         []ProblemAnswerDto
+	NumericAnswer   *NumericAnswerDto
+	AcceptedAnswers []string
+
+	// Order is the position of the problem in the set, Archived problems are solved or retired by an admin
+	Order    int
+	Archived bool
 }
 
 type CreateProblemDto struct {
-	Title       string
-	Description string
-	Hints       []string
-	Explanation string
-	Answers     []CreateProblemAnswerDto
+	// Type defaults to ProblemTypeSingleChoice when empty
+	Type            ProblemType
+	Title           string
+	Description     string
+	Hints           []string
+	Explanation     string
+	Answers         []CreateProblemAnswerDto
+	NumericAnswer   *NumericAnswerDto
+	AcceptedAnswers []string
+}
+
+// ProblemAnswerSubmissionDto is the user answer to a problem, AnswerIds for choice and ordering problems
+// (in the submitted order), Value for numeric and short text problems
+type ProblemAnswerSubmissionDto struct {
+	AnswerIds []string
+	Value     string
+}
+
+type ProblemAnswerResultDto struct {
+	Correct bool
+	Problem FamilyProblemDto
+
+	// RemainingAttempts is 0 once the problem is done, either answered correctly or out of attempts
+	RemainingAttempts int
 }
 
 type FamilyProblemSetDto struct {
@@ -76,18 +184,105 @@ type FamilyProblemSetDto struct {
 	Created      time.Time
 	Status       FamilyAssignmentStatus
 	StatusDate   time.Time
+
+	// Points earned for each correctly solved problem in the set
+	Points int
+
+	// DailyQuota is the number of problems (or correct answers, per QuotaType) that complete the daily assignment
+	DailyQuota int
+	QuotaType  ProblemSetQuotaType
+
+	// MaxAttempts is the number of answers allowed for each problem until it is answered correctly
+	MaxAttempts int
+
+	// TemplateId is set when the problem set is a member copy of a problem set template
+	TemplateId string
 }
 
+// ProblemSetTemplateDto is a family level problem set assigned to several members, ProblemSetId is the template id.
+// Each member solves an own copy of the template, kept in sync with it
+type ProblemSetTemplateDto struct {
+	FamilyProblemSetDto
+	MemberIds []string
+}
+
+type ProblemSetQuotaType string
+
+const (
+	ProblemSetQuotaTypeProblems       ProblemSetQuotaType = "problems"
+	ProblemSetQuotaTypeCorrectAnswers ProblemSetQuotaType = "correctAnswers"
+)
+
+const (
+	DefaultProblemSetDailyQuota  = 1
+	DefaultProblemSetMaxAttempts = 1
+)
+
 type ProblemSolutionDto struct {
-	ProblemId        string
-	Correct          bool
-	SelectedAnswerId string
+	ProblemId         string
+	Correct           bool
+	SelectedAnswerId  string
+	SelectedAnswerIds []string
+	Value             string
+	HintsUsed         int
+	AttemptsCount     int
+
+	// InProgress solutions were answered wrong and have attempts left, they are not final yet
+	InProgress bool
+}
+
+// ProblemHintsDto holds the hints revealed so far for a problem, out of HintsCount hints the problem has
+type ProblemHintsDto struct {
+	RevealedHints []string
+	HintsCount    int
 }
 
 type CreateProblemSetDto struct {
 	ProblemSetId string
 	Title        string
 	Description  string
+	Points       int
+
+	// DailyQuota and QuotaType are optional, defaulting to a single solved problem a day
+	DailyQuota int
+	QuotaType  ProblemSetQuotaType
+
+	// MaxAttempts is optional, defaulting to a single attempt per problem
+	MaxAttempts int
+}
+
+type ProblemSetFileFormat string
+
+const (
+	// ProblemSetFileFormatJson is a versioned document holding the problem set settings and its problems
+	ProblemSetFileFormatJson ProblemSetFileFormat = "json"
+	// ProblemSetFileFormatCsv holds the problems only, a row per problem below a header row
+	ProblemSetFileFormatCsv ProblemSetFileFormat = "csv"
+)
+
+type ProblemSetImportDto struct {
+	// ProblemSetId is the problem set the problems are added to. When empty, a new problem set is created from
+	// the settings in the JSON document, CSV files can only be imported to an existing problem set
+	ProblemSetId string
+	Format       ProblemSetFileFormat
+	Data         []byte
+
+	// DryRun validates the file without importing it
+	DryRun bool
+}
+
+// ProblemSetImportResultDto reports an import, nothing is imported when there are errors
+type ProblemSetImportResultDto struct {
+	ProblemSetId  string
+	ImportedCount int
+	Errors        []ProblemImportErrorDto
+}
+
+// ProblemImportErrorDto is a problem found in the imported file. Row is the 1 based problem index in a JSON
+// document or the line number in a CSV file (the header is line 1), 0 for errors of the whole file
+type ProblemImportErrorDto struct {
+	Row     int
+	Message string
 }
 
 type FamilyAssignmentStatus string
@@ -95,20 +290,83 @@ type FamilyAssignmentStatus string
 const (
 	FamilyAssignmentStatusActive  FamilyAssignmentStatus = "active"
 	FamilyAssignmentStatusDeleted FamilyAssignmentStatus = "deleted"
+
+	// FamilyAssignmentStatusArchived is used by problem sets and problems, which are kept aside and can be restored
+	FamilyAssignmentStatusArchived FamilyAssignmentStatus = "archived"
 )
 
 type FamilyManager interface {
 	CreateFamily(ctx context.Context, familyId string, familyName string, memberUserIds []string, adminUserIds []string) error
 	GetFamily(ctx context.Context, familyId string) (*FamilyDto, error)
 	FindFamily(ctx context.Context, familyId string) (*FamilyDto, error)
+	ListFamiliesForUser(ctx context.Context, userId string) shpanstream.Stream[FamilyDto]
+	UpdateFamilySettings(ctx context.Context, familyId string, settings FamilySettingsDto) error
+
+	AddFamilyMember(ctx context.Context, familyId string, userId string, role Role) error
+	UpdateFamilyMemberRole(ctx context.Context, familyId string, userId string, role Role) error
+	RemoveFamilyMember(ctx context.Context, familyId string, userId string) error
+
+	InviteFamilyMember(ctx context.Context, familyId string, invitation FamilyInvitationDto) error
+	RevokeFamilyInvitation(ctx context.Context, familyId string, email string) error
+	ListFamilyInvitations(ctx context.Context, familyId string) shpanstream.Stream[FamilyInvitationDto]
+	ListUserInvitations(ctx context.Context, email string) shpanstream.Stream[FamilyInvitationDto]
+	AcceptFamilyInvitation(ctx context.Context, familyId string) error
 
 	CreateFamilyTask(ctx context.Context, familyId string, familyTask FamilyTaskDto) error
+	UpdateFamilyTask(ctx context.Context, familyId string, familyTask FamilyTaskDto) error
 	ListFamilyTasks(ctx context.Context, familyId string) shpanstream.Stream[FamilyTaskDto]
 	DeleteFamilyTask(ctx context.Context, familyId string, familyTaskId string) error
 
 	CreateProblemsInSet(ctx context.Context, familyId string, forUserId string, problemSetId string, familyProblem []CreateProblemDto) error
 	CreateProblemSet(ctx context.Context, familyId string, forUserId string, familyProblemSet CreateProblemSetDto) error
 	ListProblemSetsForUser(ctx context.Context, familyId string, userId string) shpanstream.Stream[FamilyProblemSetDto]
+	UpdateProblemSet(ctx context.Context, familyId string, forUserId string, familyProblemSet CreateProblemSetDto) error
+
+	// SetProblemSetStatus archives, restores or deletes a problem set, deleted problem sets can't be restored
+	SetProblemSetStatus(ctx context.Context, familyId string, forUserId string, problemSetId string, status FamilyAssignmentStatus) error
+
+	UpdateProblem(
+		ctx context.Context,
+		familyId string,
+		forUserId string,
+		problemSetId string,
+		problemId string,
+		familyProblem CreateProblemDto,
+	) error
+
+	// SetProblemStatus archives, restores or deletes a single problem of a problem set
+	SetProblemStatus(
+		ctx context.Context,
+		familyId string,
+		forUserId string,
+		problemSetId string,
+		problemId string,
+		status FamilyAssignmentStatus,
+	) error
+
+	// ReorderProblems sets the order of the active problems of a problem set, all of them must be listed
+	ReorderProblems(ctx context.Context, familyId string, forUserId string, problemSetId string, problemIds []string) error
+
+	// ExportProblemSet writes the problem set, including archived problems, in a file format that can be imported back
+	ExportProblemSet(ctx context.Context, familyId string, userId string, problemSetId string, format ProblemSetFileFormat) ([]byte, error)
+
+	// ImportProblemSet imports problems from a file, validated the same as problems created by CreateProblemsInSet
+	ImportProblemSet(ctx context.Context, familyId string, forUserId string, problemSetImport ProblemSetImportDto) (*ProblemSetImportResultDto, error)
+
+	// CreateProblemSetTemplate creates a family level problem set, and a copy of it for each of the members
+	CreateProblemSetTemplate(ctx context.Context, familyId string, template CreateProblemSetDto, memberIds []string) error
+	UpdateProblemSetTemplate(ctx context.Context, familyId string, template CreateProblemSetDto) error
+
+	// AssignProblemSetTemplate sets the members of the template, copies of unassigned members are archived with their progress
+	AssignProblemSetTemplate(ctx context.Context, familyId string, templateId string, memberIds []string) error
+	DeleteProblemSetTemplate(ctx context.Context, familyId string, templateId string) error
+	ListProblemSetTemplates(ctx context.Context, familyId string) shpanstream.Stream[ProblemSetTemplateDto]
+
+	ListProblemsForTemplate(ctx context.Context, familyId string, templateId string) shpanstream.Stream[FamilyProblemDto]
+	CreateProblemsInTemplate(ctx context.Context, familyId string, templateId string, problems []CreateProblemDto) error
+	UpdateTemplateProblem(ctx context.Context, familyId string, templateId string, problemId string, problem CreateProblemDto) error
+	DeleteTemplateProblem(ctx context.Context, familyId string, templateId string, problemId string) error
+	ReorderTemplateProblems(ctx context.Context, familyId string, templateId string, problemIds []string) error
 
 	ListProblemsForProblemSet(
 		ctx context.Context,
@@ -118,6 +376,15 @@ type FamilyManager interface {
 		includingArchived bool,
 	) shpanstream.Stream[FamilyProblemDto]
 
+	// GetNextProblem picks the problem to present to the user, problems due for a spaced repetition review first
+	GetNextProblem(
+		ctx context.Context,
+		familyId string,
+		userId string,
+		problemSetId string,
+		forDate datekvs.Date,
+	) (*FamilyProblemDto, error)
+
 	GenerateNewProblems(
 		ctx context.Context,
 		familyId string,
@@ -142,8 +409,26 @@ type FamilyManager interface {
 		problemSetId string,
 		problemId string,
 		forDate datekvs.Date,
-		answerId string,
-	) (bool, string, *FamilyProblemDto, error)
+		submission ProblemAnswerSubmissionDto,
+	) (*ProblemAnswerResultDto, error)
+
+	// RevealNextProblemHint reveals another hint of the problem, hints used are recorded with the solution.
+	// Revealed hints are kept until the problem is answered, regardless of the day it is answered on
+	RevealNextProblemHint(
+		ctx context.Context,
+		familyId string,
+		userId string,
+		problemSetId string,
+		problemId string,
+	) (*ProblemHintsDto, error)
+
+	ListRevealedProblemHints(
+		ctx context.Context,
+		familyId string,
+		userId string,
+		problemSetId string,
+		problemId string,
+	) (*ProblemHintsDto, error)
 
 	ListProblemSetSolutionsForDate(
 		ctx context.Context,
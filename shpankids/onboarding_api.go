@@ -0,0 +1,16 @@
+package shpankids
+
+import (
+	"context"
+	"time"
+)
+
+// OnboardingManager takes care of setting up users logging in to the app
+type OnboardingManager interface {
+	// OnLogin is called once the user in ctx logged in, accepting the user's pending family invitations
+	OnLogin(ctx context.Context) error
+
+	// CreateOwnFamily creates a new family with the user as its admin and switches the user to it, onboarding users
+	// with no family. Returns the id of the new family
+	CreateOwnFamily(ctx context.Context, familyName string, location *time.Location, firstName string, lastName string) (string, error)
+}
@@ -0,0 +1,71 @@
+package shpankids
+
+import (
+	"context"
+	"shpankids/infra/database/datekvs"
+	"shpankids/infra/shpanstream"
+	"time"
+)
+
+// PointsEntryDto is a single change to a user's points balance, positive when points are earned
+type PointsEntryDto struct {
+	EntryId string
+	ForDate datekvs.Date
+	Points  int
+	Reason  string
+	Created time.Time
+}
+
+type PointsBalanceDto struct {
+	UserId string
+	// Balance is the sum of all the user's ledger entries
+	Balance int
+	// Reserved is the cost of the user's redemptions pending approval
+	Reserved int
+}
+
+type RewardDto struct {
+	RewardId    string
+	Title       string
+	Description string
+	Cost        int
+	Status      FamilyAssignmentStatus
+	Created     time.Time
+}
+
+type RedemptionStatus string
+
+const (
+	RedemptionStatusPending  RedemptionStatus = "pending"
+	RedemptionStatusApproved RedemptionStatus = "approved"
+	RedemptionStatusRejected RedemptionStatus = "rejected"
+)
+
+type RedemptionDto struct {
+	RedemptionId string
+	UserId       string
+	RewardId     string
+	RewardTitle  string
+	Cost         int
+	Status       RedemptionStatus
+	Requested    time.Time
+	DecidedBy    string
+	DecidedAt    time.Time
+	Comment      string
+}
+
+type PointsManager interface {
+	GetBalance(ctx context.Context, familyId string, userId string) (*PointsBalanceDto, error)
+
+	// ListLedger streams the user's ledger entries between from and to (inclusive)
+	ListLedger(ctx context.Context, familyId string, userId string, from datekvs.Date, to datekvs.Date) shpanstream.Stream[PointsEntryDto]
+
+	CreateReward(ctx context.Context, familyId string, reward RewardDto) error
+	DeleteReward(ctx context.Context, familyId string, rewardId string) error
+	ListRewards(ctx context.Context, familyId string) shpanstream.Stream[RewardDto]
+
+	// RequestRedemption asks to redeem a reward for the logged-in user, the points are spent once an admin approves
+	RequestRedemption(ctx context.Context, familyId string, rewardId string) (string, error)
+	ListRedemptions(ctx context.Context, familyId string, status *RedemptionStatus) shpanstream.Stream[RedemptionDto]
+	DecideRedemption(ctx context.Context, familyId string, redemptionId string, approve bool, comment string) error
+}
@@ -16,9 +16,17 @@ type GeminiSecret struct {
 	ApiKey string `json:"apiKey"`
 }
 
+// OpenAiSecret configures an OpenAI compatible service, BaseUrl and Model default to OpenAI when empty
+type OpenAiSecret struct {
+	ApiKey  string `json:"apiKey"`
+	BaseUrl string `json:"baseUrl"`
+	Model   string `json:"model"`
+}
+
 type Secrets struct {
 	OAuth  OAuthSecret  `json:"oAuth"`
 	Gemini GeminiSecret `json:"gemini"`
+	OpenAi OpenAiSecret `json:"openAi"`
 }
 
 var secrets Secrets
@@ -6,11 +6,13 @@ import (
 )
 
 type Session struct {
+	// FamilyId is the active family of the user, out of the families the user is a member of
 	FamilyId string
 	Location *time.Location
 }
 
 type SessionManager interface {
 	Get(ctx context.Context, userId string) (*Session, error)
+	Find(ctx context.Context, userId string) (*Session, error)
 	Set(ctx context.Context, userId string, session Session) error
 }
@@ -29,6 +29,10 @@ const ProblemEditor: React.FC<ProblemEditorProps> = (props) => {
                 <input dir="auto" type="text" value={props.problem.description} onChange={
                     (e) => props.onChanges({...props.problem, description: e.target.value})
                 }/>
+                <label>Explanation</label>
+                <input dir="auto" type="text" value={props.problem.explanation} onChange={
+                    (e) => props.onChanges({...props.problem, explanation: e.target.value})
+                }/>
                 <label>Answers</label>
                 <div>
 
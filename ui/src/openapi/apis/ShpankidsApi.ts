@@ -15,84 +15,274 @@
 
 import * as runtime from '../runtime';
 import type {
+  ApiAddFamilyMemberCommandArgs,
+  ApiAiUsageReport,
+  ApiAssignProblemSetTemplateCommandArgs,
   ApiAssignment,
+  ApiAuditEntry,
   ApiCreateFamilyTaskCommandArgs,
+  ApiCreateOwnFamilyCommandArgs,
   ApiCreateProblemSetCommandArgs,
+  ApiCreateProblemSetTemplateCommandArgs,
   ApiCreateProblemsInSetCommandArgs,
+  ApiCreateProblemsInTemplateCommandArgs,
+  ApiCreateRewardCommandArgs,
+  ApiDecideRewardRedemptionCommandArgs,
+  ApiDecideTaskApprovalCommandArgs,
   ApiDeleteFamilyTaskCommandArgs,
+  ApiDeleteProblemSetTemplateCommandArgs,
+  ApiDeleteRewardCommandArgs,
+  ApiDeleteTemplateProblemCommandArgs,
+  ApiExportProblemSetCommandArgs,
+  ApiExportProblemSetCommandResult,
+  ApiFamilyInvitation,
   ApiGenerateProblemsCommandArgs,
+  ApiImportProblemSetCommandArgs,
+  ApiImportProblemSetCommandResult,
+  ApiInviteFamilyMemberCommandArgs,
   ApiLoadProblemForAssignmentCommandArgs,
   ApiLoadProblemForAssignmentCommandResult,
+  ApiPointsBalance,
+  ApiPointsEntry,
   ApiProblem,
   ApiProblemForEdit,
   ApiProblemSet,
+  ApiProblemSetTemplate,
+  ApiRedeemRewardCommandArgs,
   ApiRefineProblemsCommandArgs,
+  ApiRemoveFamilyMemberCommandArgs,
+  ApiReorderProblemsCommandArgs,
+  ApiReorderTemplateProblemsCommandArgs,
+  ApiRevealProblemHintCommandArgs,
+  ApiRevealProblemHintCommandResp,
+  ApiRevokeFamilyInvitationCommandArgs,
+  ApiReward,
+  ApiRewardRedemption,
   ApiSubmitProblemAnswerCommandArgs,
   ApiSubmitProblemAnswerCommandResp,
+  ApiSwitchFamilyCommandArgs,
+  ApiTaskApproval,
   ApiTaskStats,
+  ApiUpdateFamilyMemberRoleCommandArgs,
+  ApiUpdateFamilySettingsCommandArgs,
   ApiUpdateFamilyTaskCommandArgs,
+  ApiUpdateMemberTaskStatusCommandArgs,
+  ApiUpdateProblemCommandArgs,
+  ApiUpdateProblemSetCommandArgs,
+  ApiUpdateProblemSetStatusCommandArgs,
+  ApiUpdateProblemSetTemplateCommandArgs,
+  ApiUpdateProblemStatusCommandArgs,
   ApiUpdateTaskStatusCommandArgs,
+  ApiUpdateTemplateProblemCommandArgs,
+  ApiUserAchievements,
   ApiUserProblemSolution,
 } from '../models/index';
 import {
+    ApiAddFamilyMemberCommandArgsFromJSON,
+    ApiAddFamilyMemberCommandArgsToJSON,
+    ApiAiUsageReportFromJSON,
+    ApiAiUsageReportToJSON,
+    ApiAssignProblemSetTemplateCommandArgsFromJSON,
+    ApiAssignProblemSetTemplateCommandArgsToJSON,
     ApiAssignmentFromJSON,
     ApiAssignmentToJSON,
+    ApiAuditEntryFromJSON,
+    ApiAuditEntryToJSON,
     ApiCreateFamilyTaskCommandArgsFromJSON,
     ApiCreateFamilyTaskCommandArgsToJSON,
+    ApiCreateOwnFamilyCommandArgsFromJSON,
+    ApiCreateOwnFamilyCommandArgsToJSON,
     ApiCreateProblemSetCommandArgsFromJSON,
     ApiCreateProblemSetCommandArgsToJSON,
+    ApiCreateProblemSetTemplateCommandArgsFromJSON,
+    ApiCreateProblemSetTemplateCommandArgsToJSON,
     ApiCreateProblemsInSetCommandArgsFromJSON,
     ApiCreateProblemsInSetCommandArgsToJSON,
+    ApiCreateProblemsInTemplateCommandArgsFromJSON,
+    ApiCreateProblemsInTemplateCommandArgsToJSON,
+    ApiCreateRewardCommandArgsFromJSON,
+    ApiCreateRewardCommandArgsToJSON,
+    ApiDecideRewardRedemptionCommandArgsFromJSON,
+    ApiDecideRewardRedemptionCommandArgsToJSON,
+    ApiDecideTaskApprovalCommandArgsFromJSON,
+    ApiDecideTaskApprovalCommandArgsToJSON,
     ApiDeleteFamilyTaskCommandArgsFromJSON,
     ApiDeleteFamilyTaskCommandArgsToJSON,
+    ApiDeleteProblemSetTemplateCommandArgsFromJSON,
+    ApiDeleteProblemSetTemplateCommandArgsToJSON,
+    ApiDeleteRewardCommandArgsFromJSON,
+    ApiDeleteRewardCommandArgsToJSON,
+    ApiDeleteTemplateProblemCommandArgsFromJSON,
+    ApiDeleteTemplateProblemCommandArgsToJSON,
+    ApiExportProblemSetCommandArgsFromJSON,
+    ApiExportProblemSetCommandArgsToJSON,
+    ApiExportProblemSetCommandResultFromJSON,
+    ApiExportProblemSetCommandResultToJSON,
+    ApiFamilyInvitationFromJSON,
+    ApiFamilyInvitationToJSON,
     ApiGenerateProblemsCommandArgsFromJSON,
     ApiGenerateProblemsCommandArgsToJSON,
+    ApiImportProblemSetCommandArgsFromJSON,
+    ApiImportProblemSetCommandArgsToJSON,
+    ApiImportProblemSetCommandResultFromJSON,
+    ApiImportProblemSetCommandResultToJSON,
+    ApiInviteFamilyMemberCommandArgsFromJSON,
+    ApiInviteFamilyMemberCommandArgsToJSON,
     ApiLoadProblemForAssignmentCommandArgsFromJSON,
     ApiLoadProblemForAssignmentCommandArgsToJSON,
     ApiLoadProblemForAssignmentCommandResultFromJSON,
     ApiLoadProblemForAssignmentCommandResultToJSON,
+    ApiPointsBalanceFromJSON,
+    ApiPointsBalanceToJSON,
+    ApiPointsEntryFromJSON,
+    ApiPointsEntryToJSON,
     ApiProblemFromJSON,
     ApiProblemToJSON,
     ApiProblemForEditFromJSON,
     ApiProblemForEditToJSON,
     ApiProblemSetFromJSON,
     ApiProblemSetToJSON,
+    ApiProblemSetTemplateFromJSON,
+    ApiProblemSetTemplateToJSON,
+    ApiRedeemRewardCommandArgsFromJSON,
+    ApiRedeemRewardCommandArgsToJSON,
     ApiRefineProblemsCommandArgsFromJSON,
     ApiRefineProblemsCommandArgsToJSON,
+    ApiRemoveFamilyMemberCommandArgsFromJSON,
+    ApiRemoveFamilyMemberCommandArgsToJSON,
+    ApiReorderProblemsCommandArgsFromJSON,
+    ApiReorderProblemsCommandArgsToJSON,
+    ApiReorderTemplateProblemsCommandArgsFromJSON,
+    ApiReorderTemplateProblemsCommandArgsToJSON,
+    ApiRevealProblemHintCommandArgsFromJSON,
+    ApiRevealProblemHintCommandArgsToJSON,
+    ApiRevealProblemHintCommandRespFromJSON,
+    ApiRevealProblemHintCommandRespToJSON,
+    ApiRevokeFamilyInvitationCommandArgsFromJSON,
+    ApiRevokeFamilyInvitationCommandArgsToJSON,
+    ApiRewardFromJSON,
+    ApiRewardToJSON,
+    ApiRewardRedemptionFromJSON,
+    ApiRewardRedemptionToJSON,
     ApiSubmitProblemAnswerCommandArgsFromJSON,
     ApiSubmitProblemAnswerCommandArgsToJSON,
     ApiSubmitProblemAnswerCommandRespFromJSON,
     ApiSubmitProblemAnswerCommandRespToJSON,
+    ApiSwitchFamilyCommandArgsFromJSON,
+    ApiSwitchFamilyCommandArgsToJSON,
+    ApiTaskApprovalFromJSON,
+    ApiTaskApprovalToJSON,
     ApiTaskStatsFromJSON,
     ApiTaskStatsToJSON,
+    ApiUpdateFamilyMemberRoleCommandArgsFromJSON,
+    ApiUpdateFamilyMemberRoleCommandArgsToJSON,
+    ApiUpdateFamilySettingsCommandArgsFromJSON,
+    ApiUpdateFamilySettingsCommandArgsToJSON,
     ApiUpdateFamilyTaskCommandArgsFromJSON,
     ApiUpdateFamilyTaskCommandArgsToJSON,
+    ApiUpdateMemberTaskStatusCommandArgsFromJSON,
+    ApiUpdateMemberTaskStatusCommandArgsToJSON,
+    ApiUpdateProblemCommandArgsFromJSON,
+    ApiUpdateProblemCommandArgsToJSON,
+    ApiUpdateProblemSetCommandArgsFromJSON,
+    ApiUpdateProblemSetCommandArgsToJSON,
+    ApiUpdateProblemSetStatusCommandArgsFromJSON,
+    ApiUpdateProblemSetStatusCommandArgsToJSON,
+    ApiUpdateProblemSetTemplateCommandArgsFromJSON,
+    ApiUpdateProblemSetTemplateCommandArgsToJSON,
+    ApiUpdateProblemStatusCommandArgsFromJSON,
+    ApiUpdateProblemStatusCommandArgsToJSON,
     ApiUpdateTaskStatusCommandArgsFromJSON,
     ApiUpdateTaskStatusCommandArgsToJSON,
+    ApiUpdateTemplateProblemCommandArgsFromJSON,
+    ApiUpdateTemplateProblemCommandArgsToJSON,
+    ApiUserAchievementsFromJSON,
+    ApiUserAchievementsToJSON,
     ApiUserProblemSolutionFromJSON,
     ApiUserProblemSolutionToJSON,
 } from '../models/index';
 
+export interface AddFamilyMemberRequest {
+    apiAddFamilyMemberCommandArgs?: ApiAddFamilyMemberCommandArgs;
+}
+
+export interface AssignProblemSetTemplateRequest {
+    apiAssignProblemSetTemplateCommandArgs?: ApiAssignProblemSetTemplateCommandArgs;
+}
+
 export interface CreateFamilyTaskRequest {
     apiCreateFamilyTaskCommandArgs?: ApiCreateFamilyTaskCommandArgs;
 }
 
+export interface CreateOwnFamilyRequest {
+    apiCreateOwnFamilyCommandArgs?: ApiCreateOwnFamilyCommandArgs;
+}
+
 export interface CreateProblemSetRequest {
     apiCreateProblemSetCommandArgs?: ApiCreateProblemSetCommandArgs;
 }
 
+export interface CreateProblemSetTemplateRequest {
+    apiCreateProblemSetTemplateCommandArgs?: ApiCreateProblemSetTemplateCommandArgs;
+}
+
 export interface CreateProblemsInSetRequest {
     apiCreateProblemsInSetCommandArgs?: ApiCreateProblemsInSetCommandArgs;
 }
 
+export interface CreateProblemsInTemplateRequest {
+    apiCreateProblemsInTemplateCommandArgs?: ApiCreateProblemsInTemplateCommandArgs;
+}
+
+export interface CreateRewardRequest {
+    apiCreateRewardCommandArgs?: ApiCreateRewardCommandArgs;
+}
+
+export interface DecideRewardRedemptionRequest {
+    apiDecideRewardRedemptionCommandArgs?: ApiDecideRewardRedemptionCommandArgs;
+}
+
+export interface DecideTaskApprovalRequest {
+    apiDecideTaskApprovalCommandArgs?: ApiDecideTaskApprovalCommandArgs;
+}
+
 export interface DeleteFamilyTaskRequest {
     apiDeleteFamilyTaskCommandArgs?: ApiDeleteFamilyTaskCommandArgs;
 }
 
+export interface DeleteProblemSetTemplateRequest {
+    apiDeleteProblemSetTemplateCommandArgs?: ApiDeleteProblemSetTemplateCommandArgs;
+}
+
+export interface DeleteRewardRequest {
+    apiDeleteRewardCommandArgs?: ApiDeleteRewardCommandArgs;
+}
+
+export interface DeleteTemplateProblemRequest {
+    apiDeleteTemplateProblemCommandArgs?: ApiDeleteTemplateProblemCommandArgs;
+}
+
+export interface ExportProblemSetRequest {
+    apiExportProblemSetCommandArgs?: ApiExportProblemSetCommandArgs;
+}
+
 export interface GenerateProblemsRequest {
     apiGenerateProblemsCommandArgs?: ApiGenerateProblemsCommandArgs;
 }
 
+export interface GetAchievementsRequest {
+    userId: string;
+}
+
+export interface GetAiUsageReportRequest {
+    from?: Date;
+    to?: Date;
+}
+
+export interface GetPointsBalanceRequest {
+    userId: string;
+}
+
 export interface GetProblemRequest {
     problemSetId: string;
     userId: string;
@@ -104,9 +294,40 @@ export interface GetStatsRequest {
     to?: Date;
 }
 
+export interface ImportProblemSetRequest {
+    apiImportProblemSetCommandArgs?: ApiImportProblemSetCommandArgs;
+}
+
+export interface InviteFamilyMemberRequest {
+    apiInviteFamilyMemberCommandArgs?: ApiInviteFamilyMemberCommandArgs;
+}
+
+export interface ListAuditLogRequest {
+    from?: Date;
+    to?: Date;
+    actorId?: string;
+    action?: string;
+    targetId?: string;
+}
+
+export interface ListPointsLedgerRequest {
+    userId: string;
+    from?: Date;
+    to?: Date;
+}
+
 export interface ListProblemSetProblemsRequest {
     problemSetId: string;
     userId: string;
+    includeArchived?: boolean;
+}
+
+export interface ListProblemSetTemplateProblemsRequest {
+    templateId: string;
+}
+
+export interface ListRewardRedemptionsRequest {
+    status?: ApiRedemptionStatus;
 }
 
 export interface ListUserFamilyProblemSetsRequest {
@@ -122,31 +343,1326 @@ export interface LoadProblemForAssignmentRequest {
     apiLoadProblemForAssignmentCommandArgs?: ApiLoadProblemForAssignmentCommandArgs;
 }
 
+export interface RedeemRewardRequest {
+    apiRedeemRewardCommandArgs?: ApiRedeemRewardCommandArgs;
+}
+
 export interface RefineProblemsRequest {
     apiRefineProblemsCommandArgs?: ApiRefineProblemsCommandArgs;
 }
 
+export interface RemoveFamilyMemberRequest {
+    apiRemoveFamilyMemberCommandArgs?: ApiRemoveFamilyMemberCommandArgs;
+}
+
+export interface ReorderProblemsRequest {
+    apiReorderProblemsCommandArgs?: ApiReorderProblemsCommandArgs;
+}
+
+export interface ReorderTemplateProblemsRequest {
+    apiReorderTemplateProblemsCommandArgs?: ApiReorderTemplateProblemsCommandArgs;
+}
+
+export interface RevealProblemHintRequest {
+    apiRevealProblemHintCommandArgs?: ApiRevealProblemHintCommandArgs;
+}
+
+export interface RevokeFamilyInvitationRequest {
+    apiRevokeFamilyInvitationCommandArgs?: ApiRevokeFamilyInvitationCommandArgs;
+}
+
 export interface SubmitProblemAnswerRequest {
     apiSubmitProblemAnswerCommandArgs?: ApiSubmitProblemAnswerCommandArgs;
 }
 
+export interface SwitchFamilyRequest {
+    apiSwitchFamilyCommandArgs?: ApiSwitchFamilyCommandArgs;
+}
+
+export interface UpdateFamilyMemberRoleRequest {
+    apiUpdateFamilyMemberRoleCommandArgs?: ApiUpdateFamilyMemberRoleCommandArgs;
+}
+
+export interface UpdateFamilySettingsRequest {
+    apiUpdateFamilySettingsCommandArgs?: ApiUpdateFamilySettingsCommandArgs;
+}
+
 export interface UpdateFamilyTaskRequest {
     apiUpdateFamilyTaskCommandArgs?: ApiUpdateFamilyTaskCommandArgs;
 }
 
+export interface UpdateMemberTaskStatusRequest {
+    apiUpdateMemberTaskStatusCommandArgs?: ApiUpdateMemberTaskStatusCommandArgs;
+}
+
+export interface UpdateProblemRequest {
+    apiUpdateProblemCommandArgs?: ApiUpdateProblemCommandArgs;
+}
+
+export interface UpdateProblemSetRequest {
+    apiUpdateProblemSetCommandArgs?: ApiUpdateProblemSetCommandArgs;
+}
+
+export interface UpdateProblemSetStatusRequest {
+    apiUpdateProblemSetStatusCommandArgs?: ApiUpdateProblemSetStatusCommandArgs;
+}
+
+export interface UpdateProblemSetTemplateRequest {
+    apiUpdateProblemSetTemplateCommandArgs?: ApiUpdateProblemSetTemplateCommandArgs;
+}
+
+export interface UpdateProblemStatusRequest {
+    apiUpdateProblemStatusCommandArgs?: ApiUpdateProblemStatusCommandArgs;
+}
+
 export interface UpdateTaskStatusRequest {
     apiUpdateTaskStatusCommandArgs?: ApiUpdateTaskStatusCommandArgs;
 }
 
+export interface UpdateTemplateProblemRequest {
+    apiUpdateTemplateProblemCommandArgs?: ApiUpdateTemplateProblemCommandArgs;
+}
+
 /**
  * 
  */
 export class ShpankidsApi extends runtime.BaseAPI {
 
+    /**
+     * Add a member to the family
+     */
+    async addFamilyMemberRaw(requestParameters: AddFamilyMemberRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/add-family-member`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiAddFamilyMemberCommandArgsToJSON(requestParameters['apiAddFamilyMemberCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Add a member to the family
+     */
+    async addFamilyMember(requestParameters: AddFamilyMemberRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.addFamilyMemberRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Set the family members a Problem Set Template is assigned to
+     */
+    async assignProblemSetTemplateRaw(requestParameters: AssignProblemSetTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/assign-problemset-template`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiAssignProblemSetTemplateCommandArgsToJSON(requestParameters['apiAssignProblemSetTemplateCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Set the family members a Problem Set Template is assigned to
+     */
+    async assignProblemSetTemplate(requestParameters: AssignProblemSetTemplateRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.assignProblemSetTemplateRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Create Family Task
+     */
+    async createFamilyTaskRaw(requestParameters: CreateFamilyTaskRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/create-family-task`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiCreateFamilyTaskCommandArgsToJSON(requestParameters['apiCreateFamilyTaskCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
     /**
      * Create Family Task
      */
-    async createFamilyTaskRaw(requestParameters: CreateFamilyTaskRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+    async createFamilyTask(requestParameters: CreateFamilyTaskRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.createFamilyTaskRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Create a new family managed by the logged in user and switch to it, onboarding users with no family
+     */
+    async createOwnFamilyRaw(requestParameters: CreateOwnFamilyRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/create-own-family`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiCreateOwnFamilyCommandArgsToJSON(requestParameters['apiCreateOwnFamilyCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Create a new family managed by the logged in user and switch to it, onboarding users with no family
+     */
+    async createOwnFamily(requestParameters: CreateOwnFamilyRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.createOwnFamilyRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Create Problem Set
+     */
+    async createProblemSetRaw(requestParameters: CreateProblemSetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/create-problemset`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiCreateProblemSetCommandArgsToJSON(requestParameters['apiCreateProblemSetCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Create Problem Set
+     */
+    async createProblemSet(requestParameters: CreateProblemSetRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.createProblemSetRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Create a Problem Set Template assigned to several family members
+     */
+    async createProblemSetTemplateRaw(requestParameters: CreateProblemSetTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/create-problemset-template`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiCreateProblemSetTemplateCommandArgsToJSON(requestParameters['apiCreateProblemSetTemplateCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Create a Problem Set Template assigned to several family members
+     */
+    async createProblemSetTemplate(requestParameters: CreateProblemSetTemplateRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.createProblemSetTemplateRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Create Problems in Set
+     */
+    async createProblemsInSetRaw(requestParameters: CreateProblemsInSetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/create-problems-in-set`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiCreateProblemsInSetCommandArgsToJSON(requestParameters['apiCreateProblemsInSetCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Create Problems in Set
+     */
+    async createProblemsInSet(requestParameters: CreateProblemsInSetRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.createProblemsInSetRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Create Problems in a Problem Set Template
+     */
+    async createProblemsInTemplateRaw(requestParameters: CreateProblemsInTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/create-problems-in-template`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiCreateProblemsInTemplateCommandArgsToJSON(requestParameters['apiCreateProblemsInTemplateCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Create Problems in a Problem Set Template
+     */
+    async createProblemsInTemplate(requestParameters: CreateProblemsInTemplateRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.createProblemsInTemplateRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Create a reward family members can redeem with their points
+     */
+    async createRewardRaw(requestParameters: CreateRewardRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/create-reward`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiCreateRewardCommandArgsToJSON(requestParameters['apiCreateRewardCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Create a reward family members can redeem with their points
+     */
+    async createReward(requestParameters: CreateRewardRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.createRewardRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Approve or reject a pending reward redemption
+     */
+    async decideRewardRedemptionRaw(requestParameters: DecideRewardRedemptionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/decide-reward-redemption`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiDecideRewardRedemptionCommandArgsToJSON(requestParameters['apiDecideRewardRedemptionCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Approve or reject a pending reward redemption
+     */
+    async decideRewardRedemption(requestParameters: DecideRewardRedemptionRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.decideRewardRedemptionRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Approve or reject a task marked done that requires approval
+     */
+    async decideTaskApprovalRaw(requestParameters: DecideTaskApprovalRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/decide-task-approval`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiDecideTaskApprovalCommandArgsToJSON(requestParameters['apiDecideTaskApprovalCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Approve or reject a task marked done that requires approval
+     */
+    async decideTaskApproval(requestParameters: DecideTaskApprovalRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.decideTaskApprovalRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Delete Family Task
+     */
+    async deleteFamilyTaskRaw(requestParameters: DeleteFamilyTaskRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/delete-family-task`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiDeleteFamilyTaskCommandArgsToJSON(requestParameters['apiDeleteFamilyTaskCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete Family Task
+     */
+    async deleteFamilyTask(requestParameters: DeleteFamilyTaskRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteFamilyTaskRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Delete a Problem Set Template and the copies of its members
+     */
+    async deleteProblemSetTemplateRaw(requestParameters: DeleteProblemSetTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/delete-problemset-template`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiDeleteProblemSetTemplateCommandArgsToJSON(requestParameters['apiDeleteProblemSetTemplateCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete a Problem Set Template and the copies of its members
+     */
+    async deleteProblemSetTemplate(requestParameters: DeleteProblemSetTemplateRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteProblemSetTemplateRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Delete a reward
+     */
+    async deleteRewardRaw(requestParameters: DeleteRewardRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/delete-reward`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiDeleteRewardCommandArgsToJSON(requestParameters['apiDeleteRewardCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete a reward
+     */
+    async deleteReward(requestParameters: DeleteRewardRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteRewardRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Delete a Problem from a Problem Set Template
+     */
+    async deleteTemplateProblemRaw(requestParameters: DeleteTemplateProblemRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/delete-template-problem`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiDeleteTemplateProblemCommandArgsToJSON(requestParameters['apiDeleteTemplateProblemCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete a Problem from a Problem Set Template
+     */
+    async deleteTemplateProblem(requestParameters: DeleteTemplateProblemRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteTemplateProblemRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Export a Problem Set to a file that can be imported back
+     */
+    async exportProblemSetRaw(requestParameters: ExportProblemSetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiExportProblemSetCommandResult>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/export-problem-set`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiExportProblemSetCommandArgsToJSON(requestParameters['apiExportProblemSetCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiExportProblemSetCommandResultFromJSON(jsonValue));
+    }
+
+    /**
+     * Export a Problem Set to a file that can be imported back
+     */
+    async exportProblemSet(requestParameters: ExportProblemSetRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiExportProblemSetCommandResult> {
+        const response = await this.exportProblemSetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Generate problems for problem set
+     */
+    async generateProblemsRaw(requestParameters: GenerateProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemForEdit>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/generate-problems`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiGenerateProblemsCommandArgsToJSON(requestParameters['apiGenerateProblemsCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemForEditFromJSON));
+    }
+
+    /**
+     * Generate problems for problem set
+     */
+    async generateProblems(requestParameters: GenerateProblemsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemForEdit>> {
+        const response = await this.generateProblemsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Get the streaks and badges of a family member
+     */
+    async getAchievementsRaw(requestParameters: GetAchievementsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiUserAchievements>> {
+        if (requestParameters['userId'] == null) {
+            throw new runtime.RequiredError(
+                'userId',
+                'Required parameter "userId" was null or undefined when calling getAchievements().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/family-members/{userId}/achievements`.replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiUserAchievementsFromJSON(jsonValue));
+    }
+
+    /**
+     * Get the streaks and badges of a family member
+     */
+    async getAchievements(requestParameters: GetAchievementsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiUserAchievements> {
+        const response = await this.getAchievementsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Get the daily AI usage of the family members and the daily quotas, days are UTC days, admin only
+     */
+    async getAiUsageReportRaw(requestParameters: GetAiUsageReportRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiAiUsageReport>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['from'] != null) {
+            queryParameters['from'] = (requestParameters['from'] as any).toISOString();
+        }
+
+        if (requestParameters['to'] != null) {
+            queryParameters['to'] = (requestParameters['to'] as any).toISOString();
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/ai-usage`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiAiUsageReportFromJSON(jsonValue));
+    }
+
+    /**
+     * Get the daily AI usage of the family members and the daily quotas, days are UTC days, admin only
+     */
+    async getAiUsageReport(requestParameters: GetAiUsageReportRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiAiUsageReport> {
+        const response = await this.getAiUsageReportRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Get the points balance of a family member
+     */
+    async getPointsBalanceRaw(requestParameters: GetPointsBalanceRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiPointsBalance>> {
+        if (requestParameters['userId'] == null) {
+            throw new runtime.RequiredError(
+                'userId',
+                'Required parameter "userId" was null or undefined when calling getPointsBalance().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/family-members/{userId}/points`.replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiPointsBalanceFromJSON(jsonValue));
+    }
+
+    /**
+     * Get the points balance of a family member
+     */
+    async getPointsBalance(requestParameters: GetPointsBalanceRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiPointsBalance> {
+        const response = await this.getPointsBalanceRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * get Problem Set Problem
+     */
+    async getProblemRaw(requestParameters: GetProblemRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiProblem>> {
+        if (requestParameters['problemSetId'] == null) {
+            throw new runtime.RequiredError(
+                'problemSetId',
+                'Required parameter "problemSetId" was null or undefined when calling getProblem().'
+            );
+        }
+
+        if (requestParameters['userId'] == null) {
+            throw new runtime.RequiredError(
+                'userId',
+                'Required parameter "userId" was null or undefined when calling getProblem().'
+            );
+        }
+
+        if (requestParameters['problemId'] == null) {
+            throw new runtime.RequiredError(
+                'problemId',
+                'Required parameter "problemId" was null or undefined when calling getProblem().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/family-members/{userId}/problem-sets/{problemSetId}/problems/{problemId}`.replace(`{${"problemSetId"}}`, encodeURIComponent(String(requestParameters['problemSetId']))).replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))).replace(`{${"problemId"}}`, encodeURIComponent(String(requestParameters['problemId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiProblemFromJSON(jsonValue));
+    }
+
+    /**
+     * get Problem Set Problem
+     */
+    async getProblem(requestParameters: GetProblemRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiProblem> {
+        const response = await this.getProblemRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Task statistics
+     */
+    async getStatsRaw(requestParameters: GetStatsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiTaskStats>>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['from'] != null) {
+            queryParameters['from'] = (requestParameters['from'] as any).toISOString();
+        }
+
+        if (requestParameters['to'] != null) {
+            queryParameters['to'] = (requestParameters['to'] as any).toISOString();
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/stats`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiTaskStatsFromJSON));
+    }
+
+    /**
+     * Task statistics
+     */
+    async getStats(requestParameters: GetStatsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiTaskStats>> {
+        const response = await this.getStatsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Import Problems from a file, to an existing Problem Set or to a new one
+     */
+    async importProblemSetRaw(requestParameters: ImportProblemSetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiImportProblemSetCommandResult>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/import-problem-set`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiImportProblemSetCommandArgsToJSON(requestParameters['apiImportProblemSetCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiImportProblemSetCommandResultFromJSON(jsonValue));
+    }
+
+    /**
+     * Import Problems from a file, to an existing Problem Set or to a new one
+     */
+    async importProblemSet(requestParameters: ImportProblemSetRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiImportProblemSetCommandResult> {
+        const response = await this.importProblemSetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Invite a user to join the family by email
+     */
+    async inviteFamilyMemberRaw(requestParameters: InviteFamilyMemberRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/invite-family-member`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiInviteFamilyMemberCommandArgsToJSON(requestParameters['apiInviteFamilyMemberCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Invite a user to join the family by email
+     */
+    async inviteFamilyMember(requestParameters: InviteFamilyMemberRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.inviteFamilyMemberRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * list Assignments
+     */
+    async listAssignmentsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiAssignment>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/assignments`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiAssignmentFromJSON));
+    }
+
+    /**
+     * list Assignments
+     */
+    async listAssignments(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiAssignment>> {
+        const response = await this.listAssignmentsRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List the family audit log, admin only
+     */
+    async listAuditLogRaw(requestParameters: ListAuditLogRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiAuditEntry>>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['from'] != null) {
+            queryParameters['from'] = (requestParameters['from'] as any).toISOString();
+        }
+
+        if (requestParameters['to'] != null) {
+            queryParameters['to'] = (requestParameters['to'] as any).toISOString();
+        }
+
+        if (requestParameters['actorId'] != null) {
+            queryParameters['actorId'] = requestParameters['actorId'];
+        }
+
+        if (requestParameters['action'] != null) {
+            queryParameters['action'] = requestParameters['action'];
+        }
+
+        if (requestParameters['targetId'] != null) {
+            queryParameters['targetId'] = requestParameters['targetId'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/audit-log`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiAuditEntryFromJSON));
+    }
+
+    /**
+     * List the family audit log, admin only
+     */
+    async listAuditLog(requestParameters: ListAuditLogRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiAuditEntry>> {
+        const response = await this.listAuditLogRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * list pending invitations to the family
+     */
+    async listFamilyInvitationsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiFamilyInvitation>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/family-invitations`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiFamilyInvitationFromJSON));
+    }
+
+    /**
+     * list pending invitations to the family
+     */
+    async listFamilyInvitations(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiFamilyInvitation>> {
+        const response = await this.listFamilyInvitationsRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List the family tasks marked done and waiting for an admin approval
+     */
+    async listPendingTaskApprovalsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiTaskApproval>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/task-approvals`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiTaskApprovalFromJSON));
+    }
+
+    /**
+     * List the family tasks marked done and waiting for an admin approval
+     */
+    async listPendingTaskApprovals(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiTaskApproval>> {
+        const response = await this.listPendingTaskApprovalsRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List the points ledger entries of a family member
+     */
+    async listPointsLedgerRaw(requestParameters: ListPointsLedgerRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiPointsEntry>>> {
+        if (requestParameters['userId'] == null) {
+            throw new runtime.RequiredError(
+                'userId',
+                'Required parameter "userId" was null or undefined when calling listPointsLedger().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['from'] != null) {
+            queryParameters['from'] = (requestParameters['from'] as any).toISOString();
+        }
+
+        if (requestParameters['to'] != null) {
+            queryParameters['to'] = (requestParameters['to'] as any).toISOString();
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/family-members/{userId}/points-ledger`.replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiPointsEntryFromJSON));
+    }
+
+    /**
+     * List the points ledger entries of a family member
+     */
+    async listPointsLedger(requestParameters: ListPointsLedgerRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiPointsEntry>> {
+        const response = await this.listPointsLedgerRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * list Problem Set Problems for editing
+     */
+    async listProblemSetProblemsRaw(requestParameters: ListProblemSetProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemForEdit>>> {
+        if (requestParameters['problemSetId'] == null) {
+            throw new runtime.RequiredError(
+                'problemSetId',
+                'Required parameter "problemSetId" was null or undefined when calling listProblemSetProblems().'
+            );
+        }
+
+        if (requestParameters['userId'] == null) {
+            throw new runtime.RequiredError(
+                'userId',
+                'Required parameter "userId" was null or undefined when calling listProblemSetProblems().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['includeArchived'] != null) {
+            queryParameters['includeArchived'] = requestParameters['includeArchived'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/family-members/{userId}/problem-sets/{problemSetId}/problems-for-edit`.replace(`{${"problemSetId"}}`, encodeURIComponent(String(requestParameters['problemSetId']))).replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemForEditFromJSON));
+    }
+
+    /**
+     * list Problem Set Problems for editing
+     */
+    async listProblemSetProblems(requestParameters: ListProblemSetProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemForEdit>> {
+        const response = await this.listProblemSetProblemsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * list Problem Set Template Problems for editing
+     */
+    async listProblemSetTemplateProblemsRaw(requestParameters: ListProblemSetTemplateProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemForEdit>>> {
+        if (requestParameters['templateId'] == null) {
+            throw new runtime.RequiredError(
+                'templateId',
+                'Required parameter "templateId" was null or undefined when calling listProblemSetTemplateProblems().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/problem-set-templates/{templateId}/problems-for-edit`.replace(`{${"templateId"}}`, encodeURIComponent(String(requestParameters['templateId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemForEditFromJSON));
+    }
+
+    /**
+     * list Problem Set Template Problems for editing
+     */
+    async listProblemSetTemplateProblems(requestParameters: ListProblemSetTemplateProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemForEdit>> {
+        const response = await this.listProblemSetTemplateProblemsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * list the family Problem Set Templates
+     */
+    async listProblemSetTemplatesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemSetTemplate>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/problem-set-templates`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemSetTemplateFromJSON));
+    }
+
+    /**
+     * list the family Problem Set Templates
+     */
+    async listProblemSetTemplates(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemSetTemplate>> {
+        const response = await this.listProblemSetTemplatesRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List reward redemptions, admins see the whole family redemptions
+     */
+    async listRewardRedemptionsRaw(requestParameters: ListRewardRedemptionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiRewardRedemption>>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['status'] != null) {
+            queryParameters['status'] = requestParameters['status'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/reward-redemptions`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiRewardRedemptionFromJSON));
+    }
+
+    /**
+     * List reward redemptions, admins see the whole family redemptions
+     */
+    async listRewardRedemptions(requestParameters: ListRewardRedemptionsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiRewardRedemption>> {
+        const response = await this.listRewardRedemptionsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List the family rewards
+     */
+    async listRewardsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiReward>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/rewards`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiRewardFromJSON));
+    }
+
+    /**
+     * List the family rewards
+     */
+    async listRewards(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiReward>> {
+        const response = await this.listRewardsRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * list Problem Sets assign by family
+     */
+    async listUserFamilyProblemSetsRaw(requestParameters: ListUserFamilyProblemSetsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemSet>>> {
+        if (requestParameters['userId'] == null) {
+            throw new runtime.RequiredError(
+                'userId',
+                'Required parameter "userId" was null or undefined when calling listUserFamilyProblemSets().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['userId'] != null) {
+            queryParameters['userId'] = requestParameters['userId'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/family-problem-sets`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemSetFromJSON));
+    }
+
+    /**
+     * list Problem Sets assign by family
+     */
+    async listUserFamilyProblemSets(requestParameters: ListUserFamilyProblemSetsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemSet>> {
+        const response = await this.listUserFamilyProblemSetsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * list solutions for problem set by user
+     */
+    async listUserProblemsSolutionsRaw(requestParameters: ListUserProblemsSolutionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiUserProblemSolution>>> {
+        if (requestParameters['problemSetId'] == null) {
+            throw new runtime.RequiredError(
+                'problemSetId',
+                'Required parameter "problemSetId" was null or undefined when calling listUserProblemsSolutions().'
+            );
+        }
+
+        if (requestParameters['userId'] == null) {
+            throw new runtime.RequiredError(
+                'userId',
+                'Required parameter "userId" was null or undefined when calling listUserProblemsSolutions().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/family-problem-sets/{problemSetId}/{userId}/solutions`.replace(`{${"problemSetId"}}`, encodeURIComponent(String(requestParameters['problemSetId']))).replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiUserProblemSolutionFromJSON));
+    }
+
+    /**
+     * list solutions for problem set by user
+     */
+    async listUserProblemsSolutions(requestParameters: ListUserProblemsSolutionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiUserProblemSolution>> {
+        const response = await this.listUserProblemsSolutionsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Loads next problem for assignment
+     */
+    async loadProblemForAssignmentRaw(requestParameters: LoadProblemForAssignmentRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiLoadProblemForAssignmentCommandResult>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/load-problem-for-assignment`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiLoadProblemForAssignmentCommandArgsToJSON(requestParameters['apiLoadProblemForAssignmentCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiLoadProblemForAssignmentCommandResultFromJSON(jsonValue));
+    }
+
+    /**
+     * Loads next problem for assignment
+     */
+    async loadProblemForAssignment(requestParameters: LoadProblemForAssignmentRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiLoadProblemForAssignmentCommandResult> {
+        const response = await this.loadProblemForAssignmentRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Ask to redeem a reward, points are spent once an admin approves
+     */
+    async redeemRewardRaw(requestParameters: RedeemRewardRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/redeem-reward`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiRedeemRewardCommandArgsToJSON(requestParameters['apiRedeemRewardCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Ask to redeem a reward, points are spent once an admin approves
+     */
+    async redeemReward(requestParameters: RedeemRewardRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.redeemRewardRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Refine problems for problem set
+     */
+    async refineProblemsRaw(requestParameters: RefineProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemForEdit>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/refine-problems`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiRefineProblemsCommandArgsToJSON(requestParameters['apiRefineProblemsCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemForEditFromJSON));
+    }
+
+    /**
+     * Refine problems for problem set
+     */
+    async refineProblems(requestParameters: RefineProblemsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemForEdit>> {
+        const response = await this.refineProblemsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Remove a member from the family
+     */
+    async removeFamilyMemberRaw(requestParameters: RemoveFamilyMemberRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/remove-family-member`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiRemoveFamilyMemberCommandArgsToJSON(requestParameters['apiRemoveFamilyMemberCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Remove a member from the family
+     */
+    async removeFamilyMember(requestParameters: RemoveFamilyMemberRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.removeFamilyMemberRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Reorder the Problems in Set
+     */
+    async reorderProblemsRaw(requestParameters: ReorderProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -154,27 +1670,27 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/create-family-task`,
+            path: `/api/commands/reorder-problems`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiCreateFamilyTaskCommandArgsToJSON(requestParameters['apiCreateFamilyTaskCommandArgs']),
+            body: ApiReorderProblemsCommandArgsToJSON(requestParameters['apiReorderProblemsCommandArgs']),
         }, initOverrides);
 
         return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * Create Family Task
+     * Reorder the Problems in Set
      */
-    async createFamilyTask(requestParameters: CreateFamilyTaskRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
-        await this.createFamilyTaskRaw(requestParameters, initOverrides);
+    async reorderProblems(requestParameters: ReorderProblemsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.reorderProblemsRaw(requestParameters, initOverrides);
     }
 
     /**
-     * Create Problem Set
+     * Reorder the Problems in a Problem Set Template
      */
-    async createProblemSetRaw(requestParameters: CreateProblemSetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+    async reorderTemplateProblemsRaw(requestParameters: ReorderTemplateProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -182,27 +1698,27 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/create-problemset`,
+            path: `/api/commands/reorder-template-problems`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiCreateProblemSetCommandArgsToJSON(requestParameters['apiCreateProblemSetCommandArgs']),
+            body: ApiReorderTemplateProblemsCommandArgsToJSON(requestParameters['apiReorderTemplateProblemsCommandArgs']),
         }, initOverrides);
 
         return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * Create Problem Set
+     * Reorder the Problems in a Problem Set Template
      */
-    async createProblemSet(requestParameters: CreateProblemSetRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
-        await this.createProblemSetRaw(requestParameters, initOverrides);
+    async reorderTemplateProblems(requestParameters: ReorderTemplateProblemsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.reorderTemplateProblemsRaw(requestParameters, initOverrides);
     }
 
     /**
-     * Create Problems in Set
+     * Reveal the next hint of a problem, hints used are recorded with the answer and reduce the points earned
      */
-    async createProblemsInSetRaw(requestParameters: CreateProblemsInSetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+    async revealProblemHintRaw(requestParameters: RevealProblemHintRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiRevealProblemHintCommandResp>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -210,27 +1726,28 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/create-problems-in-set`,
+            path: `/api/commands/reveal-problem-hint`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiCreateProblemsInSetCommandArgsToJSON(requestParameters['apiCreateProblemsInSetCommandArgs']),
+            body: ApiRevealProblemHintCommandArgsToJSON(requestParameters['apiRevealProblemHintCommandArgs']),
         }, initOverrides);
 
-        return new runtime.VoidApiResponse(response);
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiRevealProblemHintCommandRespFromJSON(jsonValue));
     }
 
     /**
-     * Create Problems in Set
+     * Reveal the next hint of a problem, hints used are recorded with the answer and reduce the points earned
      */
-    async createProblemsInSet(requestParameters: CreateProblemsInSetRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
-        await this.createProblemsInSetRaw(requestParameters, initOverrides);
+    async revealProblemHint(requestParameters: RevealProblemHintRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiRevealProblemHintCommandResp> {
+        const response = await this.revealProblemHintRaw(requestParameters, initOverrides);
+        return await response.value();
     }
 
     /**
-     * Delete Family Task
+     * Revoke a pending family invitation
      */
-    async deleteFamilyTaskRaw(requestParameters: DeleteFamilyTaskRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+    async revokeFamilyInvitationRaw(requestParameters: RevokeFamilyInvitationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -238,27 +1755,27 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/delete-family-task`,
+            path: `/api/commands/revoke-family-invitation`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiDeleteFamilyTaskCommandArgsToJSON(requestParameters['apiDeleteFamilyTaskCommandArgs']),
+            body: ApiRevokeFamilyInvitationCommandArgsToJSON(requestParameters['apiRevokeFamilyInvitationCommandArgs']),
         }, initOverrides);
 
         return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * Delete Family Task
+     * Revoke a pending family invitation
      */
-    async deleteFamilyTask(requestParameters: DeleteFamilyTaskRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
-        await this.deleteFamilyTaskRaw(requestParameters, initOverrides);
+    async revokeFamilyInvitation(requestParameters: RevokeFamilyInvitationRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.revokeFamilyInvitationRaw(requestParameters, initOverrides);
     }
 
     /**
-     * Generate problems for problem set
+     * Submit problem answer
      */
-    async generateProblemsRaw(requestParameters: GenerateProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemForEdit>>> {
+    async submitProblemAnswerRaw(requestParameters: SubmitProblemAnswerRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiSubmitProblemAnswerCommandResp>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -266,252 +1783,196 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/generate-problems`,
+            path: `/api/commands/submit-problem-answer`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiGenerateProblemsCommandArgsToJSON(requestParameters['apiGenerateProblemsCommandArgs']),
+            body: ApiSubmitProblemAnswerCommandArgsToJSON(requestParameters['apiSubmitProblemAnswerCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemForEditFromJSON));
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiSubmitProblemAnswerCommandRespFromJSON(jsonValue));
     }
 
     /**
-     * Generate problems for problem set
+     * Submit problem answer
      */
-    async generateProblems(requestParameters: GenerateProblemsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemForEdit>> {
-        const response = await this.generateProblemsRaw(requestParameters, initOverrides);
+    async submitProblemAnswer(requestParameters: SubmitProblemAnswerRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiSubmitProblemAnswerCommandResp> {
+        const response = await this.submitProblemAnswerRaw(requestParameters, initOverrides);
         return await response.value();
     }
 
     /**
-     * get Problem Set Problem
+     * Switch the active family of the logged in user
      */
-    async getProblemRaw(requestParameters: GetProblemRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiProblem>> {
-        if (requestParameters['problemSetId'] == null) {
-            throw new runtime.RequiredError(
-                'problemSetId',
-                'Required parameter "problemSetId" was null or undefined when calling getProblem().'
-            );
-        }
-
-        if (requestParameters['userId'] == null) {
-            throw new runtime.RequiredError(
-                'userId',
-                'Required parameter "userId" was null or undefined when calling getProblem().'
-            );
-        }
-
-        if (requestParameters['problemId'] == null) {
-            throw new runtime.RequiredError(
-                'problemId',
-                'Required parameter "problemId" was null or undefined when calling getProblem().'
-            );
-        }
-
+    async switchFamilyRaw(requestParameters: SwitchFamilyRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
+
         const response = await this.request({
-            path: `/api/family-members/{userId}/problem-sets/{problemSetId}/problems/{problemId}`.replace(`{${"problemSetId"}}`, encodeURIComponent(String(requestParameters['problemSetId']))).replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))).replace(`{${"problemId"}}`, encodeURIComponent(String(requestParameters['problemId']))),
-            method: 'GET',
+            path: `/api/commands/switch-family`,
+            method: 'POST',
             headers: headerParameters,
             query: queryParameters,
+            body: ApiSwitchFamilyCommandArgsToJSON(requestParameters['apiSwitchFamilyCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => ApiProblemFromJSON(jsonValue));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * get Problem Set Problem
+     * Switch the active family of the logged in user
      */
-    async getProblem(requestParameters: GetProblemRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiProblem> {
-        const response = await this.getProblemRaw(requestParameters, initOverrides);
-        return await response.value();
+    async switchFamily(requestParameters: SwitchFamilyRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.switchFamilyRaw(requestParameters, initOverrides);
     }
 
     /**
-     * Task statistics
+     * Promote or demote a family member
      */
-    async getStatsRaw(requestParameters: GetStatsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiTaskStats>>> {
+    async updateFamilyMemberRoleRaw(requestParameters: UpdateFamilyMemberRoleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
-        if (requestParameters['from'] != null) {
-            queryParameters['from'] = (requestParameters['from'] as any).toISOString();
-        }
-
-        if (requestParameters['to'] != null) {
-            queryParameters['to'] = (requestParameters['to'] as any).toISOString();
-        }
-
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
+
         const response = await this.request({
-            path: `/api/stats`,
-            method: 'GET',
+            path: `/api/commands/update-family-member-role`,
+            method: 'POST',
             headers: headerParameters,
             query: queryParameters,
+            body: ApiUpdateFamilyMemberRoleCommandArgsToJSON(requestParameters['apiUpdateFamilyMemberRoleCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiTaskStatsFromJSON));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * Task statistics
+     * Promote or demote a family member
      */
-    async getStats(requestParameters: GetStatsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiTaskStats>> {
-        const response = await this.getStatsRaw(requestParameters, initOverrides);
-        return await response.value();
+    async updateFamilyMemberRole(requestParameters: UpdateFamilyMemberRoleRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateFamilyMemberRoleRaw(requestParameters, initOverrides);
     }
 
     /**
-     * list Assignments
+     * Update the family settings
      */
-    async listAssignmentsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiAssignment>>> {
+    async updateFamilySettingsRaw(requestParameters: UpdateFamilySettingsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
+
         const response = await this.request({
-            path: `/api/assignments`,
-            method: 'GET',
+            path: `/api/commands/update-family-settings`,
+            method: 'POST',
             headers: headerParameters,
             query: queryParameters,
+            body: ApiUpdateFamilySettingsCommandArgsToJSON(requestParameters['apiUpdateFamilySettingsCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiAssignmentFromJSON));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * list Assignments
+     * Update the family settings
      */
-    async listAssignments(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiAssignment>> {
-        const response = await this.listAssignmentsRaw(initOverrides);
-        return await response.value();
+    async updateFamilySettings(requestParameters: UpdateFamilySettingsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateFamilySettingsRaw(requestParameters, initOverrides);
     }
 
     /**
-     * list Problem Set Problems for editing
+     * Update Family Task
      */
-    async listProblemSetProblemsRaw(requestParameters: ListProblemSetProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemForEdit>>> {
-        if (requestParameters['problemSetId'] == null) {
-            throw new runtime.RequiredError(
-                'problemSetId',
-                'Required parameter "problemSetId" was null or undefined when calling listProblemSetProblems().'
-            );
-        }
-
-        if (requestParameters['userId'] == null) {
-            throw new runtime.RequiredError(
-                'userId',
-                'Required parameter "userId" was null or undefined when calling listProblemSetProblems().'
-            );
-        }
-
+    async updateFamilyTaskRaw(requestParameters: UpdateFamilyTaskRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
+
         const response = await this.request({
-            path: `/api/family-members/{userId}/problem-sets/{problemSetId}/problems-for-edit`.replace(`{${"problemSetId"}}`, encodeURIComponent(String(requestParameters['problemSetId']))).replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))),
-            method: 'GET',
+            path: `/api/commands/update-family-task`,
+            method: 'POST',
             headers: headerParameters,
             query: queryParameters,
+            body: ApiUpdateFamilyTaskCommandArgsToJSON(requestParameters['apiUpdateFamilyTaskCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemForEditFromJSON));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * list Problem Set Problems for editing
+     * Update Family Task
      */
-    async listProblemSetProblems(requestParameters: ListProblemSetProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemForEdit>> {
-        const response = await this.listProblemSetProblemsRaw(requestParameters, initOverrides);
-        return await response.value();
+    async updateFamilyTask(requestParameters: UpdateFamilyTaskRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateFamilyTaskRaw(requestParameters, initOverrides);
     }
 
     /**
-     * list Problem Sets assign by family
+     * Update the task status of a family member, admin only
      */
-    async listUserFamilyProblemSetsRaw(requestParameters: ListUserFamilyProblemSetsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemSet>>> {
-        if (requestParameters['userId'] == null) {
-            throw new runtime.RequiredError(
-                'userId',
-                'Required parameter "userId" was null or undefined when calling listUserFamilyProblemSets().'
-            );
-        }
-
+    async updateMemberTaskStatusRaw(requestParameters: UpdateMemberTaskStatusRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
-        if (requestParameters['userId'] != null) {
-            queryParameters['userId'] = requestParameters['userId'];
-        }
-
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
+
         const response = await this.request({
-            path: `/api/family-problem-sets`,
-            method: 'GET',
+            path: `/api/commands/update-member-task-status`,
+            method: 'POST',
             headers: headerParameters,
             query: queryParameters,
+            body: ApiUpdateMemberTaskStatusCommandArgsToJSON(requestParameters['apiUpdateMemberTaskStatusCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemSetFromJSON));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * list Problem Sets assign by family
+     * Update the task status of a family member, admin only
      */
-    async listUserFamilyProblemSets(requestParameters: ListUserFamilyProblemSetsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemSet>> {
-        const response = await this.listUserFamilyProblemSetsRaw(requestParameters, initOverrides);
-        return await response.value();
+    async updateMemberTaskStatus(requestParameters: UpdateMemberTaskStatusRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateMemberTaskStatusRaw(requestParameters, initOverrides);
     }
 
     /**
-     * list solutions for problem set by user
+     * Update a Problem in Set
      */
-    async listUserProblemsSolutionsRaw(requestParameters: ListUserProblemsSolutionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiUserProblemSolution>>> {
-        if (requestParameters['problemSetId'] == null) {
-            throw new runtime.RequiredError(
-                'problemSetId',
-                'Required parameter "problemSetId" was null or undefined when calling listUserProblemsSolutions().'
-            );
-        }
-
-        if (requestParameters['userId'] == null) {
-            throw new runtime.RequiredError(
-                'userId',
-                'Required parameter "userId" was null or undefined when calling listUserProblemsSolutions().'
-            );
-        }
-
+    async updateProblemRaw(requestParameters: UpdateProblemRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
+
         const response = await this.request({
-            path: `/api/family-problem-sets/{problemSetId}/{userId}/solutions`.replace(`{${"problemSetId"}}`, encodeURIComponent(String(requestParameters['problemSetId']))).replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))),
-            method: 'GET',
+            path: `/api/commands/update-problem`,
+            method: 'POST',
             headers: headerParameters,
             query: queryParameters,
+            body: ApiUpdateProblemCommandArgsToJSON(requestParameters['apiUpdateProblemCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiUserProblemSolutionFromJSON));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * list solutions for problem set by user
+     * Update a Problem in Set
      */
-    async listUserProblemsSolutions(requestParameters: ListUserProblemsSolutionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiUserProblemSolution>> {
-        const response = await this.listUserProblemsSolutionsRaw(requestParameters, initOverrides);
-        return await response.value();
+    async updateProblem(requestParameters: UpdateProblemRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateProblemRaw(requestParameters, initOverrides);
     }
 
     /**
-     * Loads next problem for assignment
+     * Update Problem Set
      */
-    async loadProblemForAssignmentRaw(requestParameters: LoadProblemForAssignmentRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiLoadProblemForAssignmentCommandResult>> {
+    async updateProblemSetRaw(requestParameters: UpdateProblemSetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -519,28 +1980,27 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/load-problem-for-assignment`,
+            path: `/api/commands/update-problemset`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiLoadProblemForAssignmentCommandArgsToJSON(requestParameters['apiLoadProblemForAssignmentCommandArgs']),
+            body: ApiUpdateProblemSetCommandArgsToJSON(requestParameters['apiUpdateProblemSetCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => ApiLoadProblemForAssignmentCommandResultFromJSON(jsonValue));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * Loads next problem for assignment
+     * Update Problem Set
      */
-    async loadProblemForAssignment(requestParameters: LoadProblemForAssignmentRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiLoadProblemForAssignmentCommandResult> {
-        const response = await this.loadProblemForAssignmentRaw(requestParameters, initOverrides);
-        return await response.value();
+    async updateProblemSet(requestParameters: UpdateProblemSetRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateProblemSetRaw(requestParameters, initOverrides);
     }
 
     /**
-     * Refine problems for problem set
+     * Archive, restore or delete a Problem Set
      */
-    async refineProblemsRaw(requestParameters: RefineProblemsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<ApiProblemForEdit>>> {
+    async updateProblemSetStatusRaw(requestParameters: UpdateProblemSetStatusRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -548,28 +2008,27 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/refine-problems`,
+            path: `/api/commands/update-problemset-status`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiRefineProblemsCommandArgsToJSON(requestParameters['apiRefineProblemsCommandArgs']),
+            body: ApiUpdateProblemSetStatusCommandArgsToJSON(requestParameters['apiUpdateProblemSetStatusCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(ApiProblemForEditFromJSON));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * Refine problems for problem set
+     * Archive, restore or delete a Problem Set
      */
-    async refineProblems(requestParameters: RefineProblemsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<ApiProblemForEdit>> {
-        const response = await this.refineProblemsRaw(requestParameters, initOverrides);
-        return await response.value();
+    async updateProblemSetStatus(requestParameters: UpdateProblemSetStatusRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateProblemSetStatusRaw(requestParameters, initOverrides);
     }
 
     /**
-     * Submit problem answer
+     * Update a Problem Set Template and the copies of its members
      */
-    async submitProblemAnswerRaw(requestParameters: SubmitProblemAnswerRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiSubmitProblemAnswerCommandResp>> {
+    async updateProblemSetTemplateRaw(requestParameters: UpdateProblemSetTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -577,28 +2036,27 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/submit-problem-answer`,
+            path: `/api/commands/update-problemset-template`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiSubmitProblemAnswerCommandArgsToJSON(requestParameters['apiSubmitProblemAnswerCommandArgs']),
+            body: ApiUpdateProblemSetTemplateCommandArgsToJSON(requestParameters['apiUpdateProblemSetTemplateCommandArgs']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => ApiSubmitProblemAnswerCommandRespFromJSON(jsonValue));
+        return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * Submit problem answer
+     * Update a Problem Set Template and the copies of its members
      */
-    async submitProblemAnswer(requestParameters: SubmitProblemAnswerRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiSubmitProblemAnswerCommandResp> {
-        const response = await this.submitProblemAnswerRaw(requestParameters, initOverrides);
-        return await response.value();
+    async updateProblemSetTemplate(requestParameters: UpdateProblemSetTemplateRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateProblemSetTemplateRaw(requestParameters, initOverrides);
     }
 
     /**
-     * Update Family Task
+     * Archive, restore or delete a Problem in Set
      */
-    async updateFamilyTaskRaw(requestParameters: UpdateFamilyTaskRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+    async updateProblemStatusRaw(requestParameters: UpdateProblemStatusRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
@@ -606,21 +2064,21 @@ export class ShpankidsApi extends runtime.BaseAPI {
         headerParameters['Content-Type'] = 'application/json';
 
         const response = await this.request({
-            path: `/api/commands/update-family-task`,
+            path: `/api/commands/update-problem-status`,
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: ApiUpdateFamilyTaskCommandArgsToJSON(requestParameters['apiUpdateFamilyTaskCommandArgs']),
+            body: ApiUpdateProblemStatusCommandArgsToJSON(requestParameters['apiUpdateProblemStatusCommandArgs']),
         }, initOverrides);
 
         return new runtime.VoidApiResponse(response);
     }
 
     /**
-     * Update Family Task
+     * Archive, restore or delete a Problem in Set
      */
-    async updateFamilyTask(requestParameters: UpdateFamilyTaskRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
-        await this.updateFamilyTaskRaw(requestParameters, initOverrides);
+    async updateProblemStatus(requestParameters: UpdateProblemStatusRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateProblemStatusRaw(requestParameters, initOverrides);
     }
 
     /**
@@ -651,4 +2109,32 @@ export class ShpankidsApi extends runtime.BaseAPI {
         await this.updateTaskStatusRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Update a Problem in a Problem Set Template
+     */
+    async updateTemplateProblemRaw(requestParameters: UpdateTemplateProblemRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        const response = await this.request({
+            path: `/api/commands/update-template-problem`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiUpdateTemplateProblemCommandArgsToJSON(requestParameters['apiUpdateTemplateProblemCommandArgs']),
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Update a Problem in a Problem Set Template
+     */
+    async updateTemplateProblem(requestParameters: UpdateTemplateProblemRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.updateTemplateProblemRaw(requestParameters, initOverrides);
+    }
+
 }
@@ -16,11 +16,14 @@
 import * as runtime from '../runtime';
 import type {
   UIFamilyInfo,
+  UIUserFamily,
   UIUserInfo,
 } from '../models/index';
 import {
     UIFamilyInfoFromJSON,
     UIFamilyInfoToJSON,
+    UIUserFamilyFromJSON,
+    UIUserFamilyToJSON,
     UIUserInfoFromJSON,
     UIUserInfoToJSON,
 } from '../models/index';
@@ -82,4 +85,30 @@ export class UIApi extends runtime.BaseAPI {
         return await response.value();
     }
 
+    /**
+     * List the families the logged in user is a member of
+     */
+    async listUserFamiliesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<UIUserFamily>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/api/ui/families`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(UIUserFamilyFromJSON));
+    }
+
+    /**
+     * List the families the logged in user is a member of
+     */
+    async listUserFamilies(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<UIUserFamily>> {
+        const response = await this.listUserFamiliesRaw(initOverrides);
+        return await response.value();
+    }
+
 }
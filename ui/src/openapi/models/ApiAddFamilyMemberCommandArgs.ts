@@ -0,0 +1,77 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiFamilyRole } from './ApiFamilyRole';
+import {
+    ApiFamilyRoleFromJSON,
+    ApiFamilyRoleFromJSONTyped,
+    ApiFamilyRoleToJSON,
+} from './ApiFamilyRole';
+
+/**
+ * 
+ * @export
+ * @interface ApiAddFamilyMemberCommandArgs
+ */
+export interface ApiAddFamilyMemberCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiAddFamilyMemberCommandArgs
+     */
+    userId: string;
+    /**
+     * 
+     * @type {ApiFamilyRole}
+     * @memberof ApiAddFamilyMemberCommandArgs
+     */
+    role: ApiFamilyRole;
+}
+
+/**
+ * Check if a given object implements the ApiAddFamilyMemberCommandArgs interface.
+ */
+export function instanceOfApiAddFamilyMemberCommandArgs(value: object): boolean {
+    if (!('userId' in value)) return false;
+    if (!('role' in value)) return false;
+    return true;
+}
+
+export function ApiAddFamilyMemberCommandArgsFromJSON(json: any): ApiAddFamilyMemberCommandArgs {
+    return ApiAddFamilyMemberCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiAddFamilyMemberCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiAddFamilyMemberCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'userId': json['userId'],
+        'role': ApiFamilyRoleFromJSON(json['role']),
+    };
+}
+
+export function ApiAddFamilyMemberCommandArgsToJSON(value?: ApiAddFamilyMemberCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'userId': value['userId'],
+        'role': ApiFamilyRoleToJSON(value['role']),
+    };
+}
+
@@ -0,0 +1,97 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiAiUsage
+ */
+export interface ApiAiUsage {
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiAiUsage
+     */
+    date: Date;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiAiUsage
+     */
+    userId: string;
+    /**
+     * Number of generate and refine requests
+     * @type {number}
+     * @memberof ApiAiUsage
+     */
+    calls: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiAiUsage
+     */
+    promptTokens: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiAiUsage
+     */
+    responseTokens: number;
+}
+
+/**
+ * Check if a given object implements the ApiAiUsage interface.
+ */
+export function instanceOfApiAiUsage(value: object): boolean {
+    if (!('date' in value)) return false;
+    if (!('userId' in value)) return false;
+    if (!('calls' in value)) return false;
+    if (!('promptTokens' in value)) return false;
+    if (!('responseTokens' in value)) return false;
+    return true;
+}
+
+export function ApiAiUsageFromJSON(json: any): ApiAiUsage {
+    return ApiAiUsageFromJSONTyped(json, false);
+}
+
+export function ApiAiUsageFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiAiUsage {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'date': (new Date(json['date'])),
+        'userId': json['userId'],
+        'calls': json['calls'],
+        'promptTokens': json['promptTokens'],
+        'responseTokens': json['responseTokens'],
+    };
+}
+
+export function ApiAiUsageToJSON(value?: ApiAiUsage | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'date': ((value['date']).toISOString()),
+        'userId': value['userId'],
+        'calls': value['calls'],
+        'promptTokens': value['promptTokens'],
+        'responseTokens': value['responseTokens'],
+    };
+}
+
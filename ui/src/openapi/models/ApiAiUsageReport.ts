@@ -0,0 +1,86 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiAiUsage } from './ApiAiUsage';
+import {
+    ApiAiUsageFromJSON,
+    ApiAiUsageFromJSONTyped,
+    ApiAiUsageToJSON,
+} from './ApiAiUsage';
+
+/**
+ * 
+ * @export
+ * @interface ApiAiUsageReport
+ */
+export interface ApiAiUsageReport {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiAiUsageReport
+     */
+    familyDailyCallsLimit: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiAiUsageReport
+     */
+    userDailyCallsLimit: number;
+    /**
+     * 
+     * @type {Array<ApiAiUsage>}
+     * @memberof ApiAiUsageReport
+     */
+    usage: Array<ApiAiUsage>;
+}
+
+/**
+ * Check if a given object implements the ApiAiUsageReport interface.
+ */
+export function instanceOfApiAiUsageReport(value: object): boolean {
+    if (!('familyDailyCallsLimit' in value)) return false;
+    if (!('userDailyCallsLimit' in value)) return false;
+    if (!('usage' in value)) return false;
+    return true;
+}
+
+export function ApiAiUsageReportFromJSON(json: any): ApiAiUsageReport {
+    return ApiAiUsageReportFromJSONTyped(json, false);
+}
+
+export function ApiAiUsageReportFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiAiUsageReport {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'familyDailyCallsLimit': json['familyDailyCallsLimit'],
+        'userDailyCallsLimit': json['userDailyCallsLimit'],
+        'usage': ((json['usage'] as Array<any>).map(ApiAiUsageFromJSON)),
+    };
+}
+
+export function ApiAiUsageReportToJSON(value?: ApiAiUsageReport | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'familyDailyCallsLimit': value['familyDailyCallsLimit'],
+        'userDailyCallsLimit': value['userDailyCallsLimit'],
+        'usage': ((value['usage'] as Array<any>).map(ApiAiUsageToJSON)),
+    };
+}
+
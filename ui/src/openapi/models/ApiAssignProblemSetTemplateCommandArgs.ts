@@ -0,0 +1,70 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiAssignProblemSetTemplateCommandArgs
+ */
+export interface ApiAssignProblemSetTemplateCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiAssignProblemSetTemplateCommandArgs
+     */
+    templateId: string;
+    /**
+     * All the members the template is assigned to, copies of members left out are archived
+     * @type {Array<string>}
+     * @memberof ApiAssignProblemSetTemplateCommandArgs
+     */
+    memberIds: Array<string>;
+}
+
+/**
+ * Check if a given object implements the ApiAssignProblemSetTemplateCommandArgs interface.
+ */
+export function instanceOfApiAssignProblemSetTemplateCommandArgs(value: object): boolean {
+    if (!('templateId' in value)) return false;
+    if (!('memberIds' in value)) return false;
+    return true;
+}
+
+export function ApiAssignProblemSetTemplateCommandArgsFromJSON(json: any): ApiAssignProblemSetTemplateCommandArgs {
+    return ApiAssignProblemSetTemplateCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiAssignProblemSetTemplateCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiAssignProblemSetTemplateCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'templateId': json['templateId'],
+        'memberIds': json['memberIds'],
+    };
+}
+
+export function ApiAssignProblemSetTemplateCommandArgsToJSON(value?: ApiAssignProblemSetTemplateCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'templateId': value['templateId'],
+        'memberIds': value['memberIds'],
+    };
+}
+
@@ -13,6 +13,12 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiAssignmentProgress } from './ApiAssignmentProgress';
+import {
+    ApiAssignmentProgressFromJSON,
+    ApiAssignmentProgressFromJSONTyped,
+    ApiAssignmentProgressToJSON,
+} from './ApiAssignmentProgress';
 import type { ApiAssignmentStatus } from './ApiAssignmentStatus';
 import {
     ApiAssignmentStatusFromJSON,
@@ -68,6 +74,12 @@ export interface ApiAssignment {
      * @memberof ApiAssignment
      */
     description?: string;
+    /**
+     * 
+     * @type {ApiAssignmentProgress}
+     * @memberof ApiAssignment
+     */
+    progress?: ApiAssignmentProgress;
 }
 
 /**
@@ -98,6 +110,7 @@ export function ApiAssignmentFromJSONTyped(json: any, ignoreDiscriminator: boole
         'forDate': (new Date(json['forDate'])),
         'status': ApiAssignmentStatusFromJSON(json['status']),
         'description': json['description'] == null ? undefined : json['description'],
+        'progress': json['progress'] == null ? undefined : ApiAssignmentProgressFromJSON(json['progress']),
     };
 }
 
@@ -113,6 +126,7 @@ export function ApiAssignmentToJSON(value?: ApiAssignment | null): any {
         'forDate': ((value['forDate']).toISOString()),
         'status': ApiAssignmentStatusToJSON(value['status']),
         'description': value['description'],
+        'progress': ApiAssignmentProgressToJSON(value['progress']),
     };
 }
 
@@ -0,0 +1,70 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Progress towards the daily quota of a problem set assignment
+ * @export
+ * @interface ApiAssignmentProgress
+ */
+export interface ApiAssignmentProgress {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiAssignmentProgress
+     */
+    completed: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiAssignmentProgress
+     */
+    required: number;
+}
+
+/**
+ * Check if a given object implements the ApiAssignmentProgress interface.
+ */
+export function instanceOfApiAssignmentProgress(value: object): boolean {
+    if (!('completed' in value)) return false;
+    if (!('required' in value)) return false;
+    return true;
+}
+
+export function ApiAssignmentProgressFromJSON(json: any): ApiAssignmentProgress {
+    return ApiAssignmentProgressFromJSONTyped(json, false);
+}
+
+export function ApiAssignmentProgressFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiAssignmentProgress {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'completed': json['completed'],
+        'required': json['required'],
+    };
+}
+
+export function ApiAssignmentProgressToJSON(value?: ApiAssignmentProgress | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'completed': value['completed'],
+        'required': value['required'],
+    };
+}
+
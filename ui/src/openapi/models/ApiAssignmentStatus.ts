@@ -21,7 +21,8 @@ export const ApiAssignmentStatus = {
     Open: 'open',
     Done: 'done',
     Blocked: 'blocked',
-    Irrelevant: 'irrelevant'
+    Irrelevant: 'irrelevant',
+    PendingApproval: 'pendingApproval'
 } as const;
 export type ApiAssignmentStatus = typeof ApiAssignmentStatus[keyof typeof ApiAssignmentStatus];
 
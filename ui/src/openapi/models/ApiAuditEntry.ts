@@ -0,0 +1,113 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiAuditEntry
+ */
+export interface ApiAuditEntry {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiAuditEntry
+     */
+    id: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiAuditEntry
+     */
+    time: Date;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiAuditEntry
+     */
+    actorId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiAuditEntry
+     */
+    action: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiAuditEntry
+     */
+    targetId: string;
+    /**
+     * JSON of the changed record before the change, missing when it was created
+     * @type {string}
+     * @memberof ApiAuditEntry
+     */
+    before?: string;
+    /**
+     * JSON of the changed record after the change, missing when it was removed
+     * @type {string}
+     * @memberof ApiAuditEntry
+     */
+    after?: string;
+}
+
+/**
+ * Check if a given object implements the ApiAuditEntry interface.
+ */
+export function instanceOfApiAuditEntry(value: object): boolean {
+    if (!('id' in value)) return false;
+    if (!('time' in value)) return false;
+    if (!('actorId' in value)) return false;
+    if (!('action' in value)) return false;
+    if (!('targetId' in value)) return false;
+    return true;
+}
+
+export function ApiAuditEntryFromJSON(json: any): ApiAuditEntry {
+    return ApiAuditEntryFromJSONTyped(json, false);
+}
+
+export function ApiAuditEntryFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiAuditEntry {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'time': (new Date(json['time'])),
+        'actorId': json['actorId'],
+        'action': json['action'],
+        'targetId': json['targetId'],
+        'before': json['before'] == null ? undefined : json['before'],
+        'after': json['after'] == null ? undefined : json['after'],
+    };
+}
+
+export function ApiAuditEntryToJSON(value?: ApiAuditEntry | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'time': ((value['time']).toISOString()),
+        'actorId': value['actorId'],
+        'action': value['action'],
+        'targetId': value['targetId'],
+        'before': value['before'],
+        'after': value['after'],
+    };
+}
+
@@ -0,0 +1,114 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiBadge
+ */
+export interface ApiBadge {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiBadge
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiBadge
+     */
+    title: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiBadge
+     */
+    description: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiBadge
+     */
+    target: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiBadge
+     */
+    progress: number;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof ApiBadge
+     */
+    achieved: boolean;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiBadge
+     */
+    achievedDate?: Date;
+}
+
+/**
+ * Check if a given object implements the ApiBadge interface.
+ */
+export function instanceOfApiBadge(value: object): boolean {
+    if (!('id' in value)) return false;
+    if (!('title' in value)) return false;
+    if (!('description' in value)) return false;
+    if (!('target' in value)) return false;
+    if (!('progress' in value)) return false;
+    if (!('achieved' in value)) return false;
+    return true;
+}
+
+export function ApiBadgeFromJSON(json: any): ApiBadge {
+    return ApiBadgeFromJSONTyped(json, false);
+}
+
+export function ApiBadgeFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiBadge {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'title': json['title'],
+        'description': json['description'],
+        'target': json['target'],
+        'progress': json['progress'],
+        'achieved': json['achieved'],
+        'achievedDate': json['achievedDate'] == null ? undefined : (new Date(json['achievedDate'])),
+    };
+}
+
+export function ApiBadgeToJSON(value?: ApiBadge | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'title': value['title'],
+        'description': value['description'],
+        'target': value['target'],
+        'progress': value['progress'],
+        'achieved': value['achieved'],
+        'achievedDate': value['achievedDate'] == null ? undefined : ((value['achievedDate']).toISOString()),
+    };
+}
+
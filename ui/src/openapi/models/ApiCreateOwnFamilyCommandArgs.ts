@@ -0,0 +1,86 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiCreateOwnFamilyCommandArgs
+ */
+export interface ApiCreateOwnFamilyCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiCreateOwnFamilyCommandArgs
+     */
+    familyName: string;
+    /**
+     * IANA time zone name, e.g. Asia/Jerusalem
+     * @type {string}
+     * @memberof ApiCreateOwnFamilyCommandArgs
+     */
+    timeZone: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiCreateOwnFamilyCommandArgs
+     */
+    firstName?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiCreateOwnFamilyCommandArgs
+     */
+    lastName?: string;
+}
+
+/**
+ * Check if a given object implements the ApiCreateOwnFamilyCommandArgs interface.
+ */
+export function instanceOfApiCreateOwnFamilyCommandArgs(value: object): boolean {
+    if (!('familyName' in value)) return false;
+    if (!('timeZone' in value)) return false;
+    return true;
+}
+
+export function ApiCreateOwnFamilyCommandArgsFromJSON(json: any): ApiCreateOwnFamilyCommandArgs {
+    return ApiCreateOwnFamilyCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiCreateOwnFamilyCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiCreateOwnFamilyCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'familyName': json['familyName'],
+        'timeZone': json['timeZone'],
+        'firstName': json['firstName'] == null ? undefined : json['firstName'],
+        'lastName': json['lastName'] == null ? undefined : json['lastName'],
+    };
+}
+
+export function ApiCreateOwnFamilyCommandArgsToJSON(value?: ApiCreateOwnFamilyCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'familyName': value['familyName'],
+        'timeZone': value['timeZone'],
+        'firstName': value['firstName'],
+        'lastName': value['lastName'],
+    };
+}
+
@@ -13,6 +13,13 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiProblemSetQuotaType } from './ApiProblemSetQuotaType';
+import {
+    ApiProblemSetQuotaTypeFromJSON,
+    ApiProblemSetQuotaTypeFromJSONTyped,
+    ApiProblemSetQuotaTypeToJSON,
+} from './ApiProblemSetQuotaType';
+
 /**
  * 
  * @export
@@ -37,6 +44,30 @@ export interface ApiCreateProblemSetCommandArgs {
      * @memberof ApiCreateProblemSetCommandArgs
      */
     description?: string;
+    /**
+     * Points earned for each correctly solved problem
+     * @type {number}
+     * @memberof ApiCreateProblemSetCommandArgs
+     */
+    points?: number;
+    /**
+     * Number of problems that complete the daily assignment, defaults to 1
+     * @type {number}
+     * @memberof ApiCreateProblemSetCommandArgs
+     */
+    dailyQuota?: number;
+    /**
+     * 
+     * @type {ApiProblemSetQuotaType}
+     * @memberof ApiCreateProblemSetCommandArgs
+     */
+    quotaType?: ApiProblemSetQuotaType;
+    /**
+     * Number of answers allowed for each problem until it is answered correctly, defaults to 1
+     * @type {number}
+     * @memberof ApiCreateProblemSetCommandArgs
+     */
+    maxAttempts?: number;
 }
 
 /**
@@ -61,6 +92,10 @@ export function ApiCreateProblemSetCommandArgsFromJSONTyped(json: any, ignoreDis
         'title': json['title'],
         'forUserId': json['forUserId'],
         'description': json['description'] == null ? undefined : json['description'],
+        'points': json['points'] == null ? undefined : json['points'],
+        'dailyQuota': json['dailyQuota'] == null ? undefined : json['dailyQuota'],
+        'quotaType': json['quotaType'] == null ? undefined : ApiProblemSetQuotaTypeFromJSON(json['quotaType']),
+        'maxAttempts': json['maxAttempts'] == null ? undefined : json['maxAttempts'],
     };
 }
 
@@ -73,6 +108,10 @@ export function ApiCreateProblemSetCommandArgsToJSON(value?: ApiCreateProblemSet
         'title': value['title'],
         'forUserId': value['forUserId'],
         'description': value['description'],
+        'points': value['points'],
+        'dailyQuota': value['dailyQuota'],
+        'quotaType': ApiProblemSetQuotaTypeToJSON(value['quotaType']),
+        'maxAttempts': value['maxAttempts'],
     };
 }
 
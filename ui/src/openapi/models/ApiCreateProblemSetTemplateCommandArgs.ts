@@ -0,0 +1,117 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemSetQuotaType } from './ApiProblemSetQuotaType';
+import {
+    ApiProblemSetQuotaTypeFromJSON,
+    ApiProblemSetQuotaTypeFromJSONTyped,
+    ApiProblemSetQuotaTypeToJSON,
+} from './ApiProblemSetQuotaType';
+
+/**
+ * 
+ * @export
+ * @interface ApiCreateProblemSetTemplateCommandArgs
+ */
+export interface ApiCreateProblemSetTemplateCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiCreateProblemSetTemplateCommandArgs
+     */
+    title: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof ApiCreateProblemSetTemplateCommandArgs
+     */
+    memberIds: Array<string>;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiCreateProblemSetTemplateCommandArgs
+     */
+    description?: string;
+    /**
+     * Points earned for each correctly solved problem
+     * @type {number}
+     * @memberof ApiCreateProblemSetTemplateCommandArgs
+     */
+    points?: number;
+    /**
+     * Number of problems that complete the daily assignment, defaults to 1
+     * @type {number}
+     * @memberof ApiCreateProblemSetTemplateCommandArgs
+     */
+    dailyQuota?: number;
+    /**
+     * 
+     * @type {ApiProblemSetQuotaType}
+     * @memberof ApiCreateProblemSetTemplateCommandArgs
+     */
+    quotaType?: ApiProblemSetQuotaType;
+    /**
+     * Number of answers allowed for each problem until it is answered correctly, defaults to 1
+     * @type {number}
+     * @memberof ApiCreateProblemSetTemplateCommandArgs
+     */
+    maxAttempts?: number;
+}
+
+/**
+ * Check if a given object implements the ApiCreateProblemSetTemplateCommandArgs interface.
+ */
+export function instanceOfApiCreateProblemSetTemplateCommandArgs(value: object): boolean {
+    if (!('title' in value)) return false;
+    if (!('memberIds' in value)) return false;
+    return true;
+}
+
+export function ApiCreateProblemSetTemplateCommandArgsFromJSON(json: any): ApiCreateProblemSetTemplateCommandArgs {
+    return ApiCreateProblemSetTemplateCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiCreateProblemSetTemplateCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiCreateProblemSetTemplateCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'title': json['title'],
+        'memberIds': json['memberIds'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'points': json['points'] == null ? undefined : json['points'],
+        'dailyQuota': json['dailyQuota'] == null ? undefined : json['dailyQuota'],
+        'quotaType': json['quotaType'] == null ? undefined : ApiProblemSetQuotaTypeFromJSON(json['quotaType']),
+        'maxAttempts': json['maxAttempts'] == null ? undefined : json['maxAttempts'],
+    };
+}
+
+export function ApiCreateProblemSetTemplateCommandArgsToJSON(value?: ApiCreateProblemSetTemplateCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'title': value['title'],
+        'memberIds': value['memberIds'],
+        'description': value['description'],
+        'points': value['points'],
+        'dailyQuota': value['dailyQuota'],
+        'quotaType': ApiProblemSetQuotaTypeToJSON(value['quotaType']),
+        'maxAttempts': value['maxAttempts'],
+    };
+}
+
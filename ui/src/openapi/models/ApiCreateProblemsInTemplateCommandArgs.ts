@@ -0,0 +1,77 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemForEdit } from './ApiProblemForEdit';
+import {
+    ApiProblemForEditFromJSON,
+    ApiProblemForEditFromJSONTyped,
+    ApiProblemForEditToJSON,
+} from './ApiProblemForEdit';
+
+/**
+ * 
+ * @export
+ * @interface ApiCreateProblemsInTemplateCommandArgs
+ */
+export interface ApiCreateProblemsInTemplateCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiCreateProblemsInTemplateCommandArgs
+     */
+    templateId: string;
+    /**
+     * 
+     * @type {Array<ApiProblemForEdit>}
+     * @memberof ApiCreateProblemsInTemplateCommandArgs
+     */
+    problems: Array<ApiProblemForEdit>;
+}
+
+/**
+ * Check if a given object implements the ApiCreateProblemsInTemplateCommandArgs interface.
+ */
+export function instanceOfApiCreateProblemsInTemplateCommandArgs(value: object): boolean {
+    if (!('templateId' in value)) return false;
+    if (!('problems' in value)) return false;
+    return true;
+}
+
+export function ApiCreateProblemsInTemplateCommandArgsFromJSON(json: any): ApiCreateProblemsInTemplateCommandArgs {
+    return ApiCreateProblemsInTemplateCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiCreateProblemsInTemplateCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiCreateProblemsInTemplateCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'templateId': json['templateId'],
+        'problems': ((json['problems'] as Array<any>).map(ApiProblemForEditFromJSON)),
+    };
+}
+
+export function ApiCreateProblemsInTemplateCommandArgsToJSON(value?: ApiCreateProblemsInTemplateCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'templateId': value['templateId'],
+        'problems': ((value['problems'] as Array<any>).map(ApiProblemForEditToJSON)),
+    };
+}
+
@@ -0,0 +1,78 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiCreateRewardCommandArgs
+ */
+export interface ApiCreateRewardCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiCreateRewardCommandArgs
+     */
+    title: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiCreateRewardCommandArgs
+     */
+    description?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiCreateRewardCommandArgs
+     */
+    cost: number;
+}
+
+/**
+ * Check if a given object implements the ApiCreateRewardCommandArgs interface.
+ */
+export function instanceOfApiCreateRewardCommandArgs(value: object): boolean {
+    if (!('title' in value)) return false;
+    if (!('cost' in value)) return false;
+    return true;
+}
+
+export function ApiCreateRewardCommandArgsFromJSON(json: any): ApiCreateRewardCommandArgs {
+    return ApiCreateRewardCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiCreateRewardCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiCreateRewardCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'title': json['title'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'cost': json['cost'],
+    };
+}
+
+export function ApiCreateRewardCommandArgsToJSON(value?: ApiCreateRewardCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'title': value['title'],
+        'description': value['description'],
+        'cost': value['cost'],
+    };
+}
+
@@ -0,0 +1,78 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiDecideRewardRedemptionCommandArgs
+ */
+export interface ApiDecideRewardRedemptionCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiDecideRewardRedemptionCommandArgs
+     */
+    redemptionId: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof ApiDecideRewardRedemptionCommandArgs
+     */
+    approve: boolean;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiDecideRewardRedemptionCommandArgs
+     */
+    comment?: string;
+}
+
+/**
+ * Check if a given object implements the ApiDecideRewardRedemptionCommandArgs interface.
+ */
+export function instanceOfApiDecideRewardRedemptionCommandArgs(value: object): boolean {
+    if (!('redemptionId' in value)) return false;
+    if (!('approve' in value)) return false;
+    return true;
+}
+
+export function ApiDecideRewardRedemptionCommandArgsFromJSON(json: any): ApiDecideRewardRedemptionCommandArgs {
+    return ApiDecideRewardRedemptionCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiDecideRewardRedemptionCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiDecideRewardRedemptionCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'redemptionId': json['redemptionId'],
+        'approve': json['approve'],
+        'comment': json['comment'] == null ? undefined : json['comment'],
+    };
+}
+
+export function ApiDecideRewardRedemptionCommandArgsToJSON(value?: ApiDecideRewardRedemptionCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'redemptionId': value['redemptionId'],
+        'approve': value['approve'],
+        'comment': value['comment'],
+    };
+}
+
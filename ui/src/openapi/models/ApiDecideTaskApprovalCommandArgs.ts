@@ -0,0 +1,78 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiDecideTaskApprovalCommandArgs
+ */
+export interface ApiDecideTaskApprovalCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiDecideTaskApprovalCommandArgs
+     */
+    approvalId: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof ApiDecideTaskApprovalCommandArgs
+     */
+    approve: boolean;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiDecideTaskApprovalCommandArgs
+     */
+    comment?: string;
+}
+
+/**
+ * Check if a given object implements the ApiDecideTaskApprovalCommandArgs interface.
+ */
+export function instanceOfApiDecideTaskApprovalCommandArgs(value: object): boolean {
+    if (!('approvalId' in value)) return false;
+    if (!('approve' in value)) return false;
+    return true;
+}
+
+export function ApiDecideTaskApprovalCommandArgsFromJSON(json: any): ApiDecideTaskApprovalCommandArgs {
+    return ApiDecideTaskApprovalCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiDecideTaskApprovalCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiDecideTaskApprovalCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'approvalId': json['approvalId'],
+        'approve': json['approve'],
+        'comment': json['comment'] == null ? undefined : json['comment'],
+    };
+}
+
+export function ApiDecideTaskApprovalCommandArgsToJSON(value?: ApiDecideTaskApprovalCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'approvalId': value['approvalId'],
+        'approve': value['approve'],
+        'comment': value['comment'],
+    };
+}
+
@@ -0,0 +1,61 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiDeleteProblemSetTemplateCommandArgs
+ */
+export interface ApiDeleteProblemSetTemplateCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiDeleteProblemSetTemplateCommandArgs
+     */
+    templateId: string;
+}
+
+/**
+ * Check if a given object implements the ApiDeleteProblemSetTemplateCommandArgs interface.
+ */
+export function instanceOfApiDeleteProblemSetTemplateCommandArgs(value: object): boolean {
+    if (!('templateId' in value)) return false;
+    return true;
+}
+
+export function ApiDeleteProblemSetTemplateCommandArgsFromJSON(json: any): ApiDeleteProblemSetTemplateCommandArgs {
+    return ApiDeleteProblemSetTemplateCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiDeleteProblemSetTemplateCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiDeleteProblemSetTemplateCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'templateId': json['templateId'],
+    };
+}
+
+export function ApiDeleteProblemSetTemplateCommandArgsToJSON(value?: ApiDeleteProblemSetTemplateCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'templateId': value['templateId'],
+    };
+}
+
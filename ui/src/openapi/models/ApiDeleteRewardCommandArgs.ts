@@ -0,0 +1,61 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiDeleteRewardCommandArgs
+ */
+export interface ApiDeleteRewardCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiDeleteRewardCommandArgs
+     */
+    rewardId: string;
+}
+
+/**
+ * Check if a given object implements the ApiDeleteRewardCommandArgs interface.
+ */
+export function instanceOfApiDeleteRewardCommandArgs(value: object): boolean {
+    if (!('rewardId' in value)) return false;
+    return true;
+}
+
+export function ApiDeleteRewardCommandArgsFromJSON(json: any): ApiDeleteRewardCommandArgs {
+    return ApiDeleteRewardCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiDeleteRewardCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiDeleteRewardCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'rewardId': json['rewardId'],
+    };
+}
+
+export function ApiDeleteRewardCommandArgsToJSON(value?: ApiDeleteRewardCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'rewardId': value['rewardId'],
+    };
+}
+
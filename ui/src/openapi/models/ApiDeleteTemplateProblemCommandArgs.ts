@@ -0,0 +1,70 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiDeleteTemplateProblemCommandArgs
+ */
+export interface ApiDeleteTemplateProblemCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiDeleteTemplateProblemCommandArgs
+     */
+    templateId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiDeleteTemplateProblemCommandArgs
+     */
+    problemId: string;
+}
+
+/**
+ * Check if a given object implements the ApiDeleteTemplateProblemCommandArgs interface.
+ */
+export function instanceOfApiDeleteTemplateProblemCommandArgs(value: object): boolean {
+    if (!('templateId' in value)) return false;
+    if (!('problemId' in value)) return false;
+    return true;
+}
+
+export function ApiDeleteTemplateProblemCommandArgsFromJSON(json: any): ApiDeleteTemplateProblemCommandArgs {
+    return ApiDeleteTemplateProblemCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiDeleteTemplateProblemCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiDeleteTemplateProblemCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'templateId': json['templateId'],
+        'problemId': json['problemId'],
+    };
+}
+
+export function ApiDeleteTemplateProblemCommandArgsToJSON(value?: ApiDeleteTemplateProblemCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'templateId': value['templateId'],
+        'problemId': value['problemId'],
+    };
+}
+
@@ -0,0 +1,86 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemSetFileFormat } from './ApiProblemSetFileFormat';
+import {
+    ApiProblemSetFileFormatFromJSON,
+    ApiProblemSetFileFormatFromJSONTyped,
+    ApiProblemSetFileFormatToJSON,
+} from './ApiProblemSetFileFormat';
+
+/**
+ * 
+ * @export
+ * @interface ApiExportProblemSetCommandArgs
+ */
+export interface ApiExportProblemSetCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiExportProblemSetCommandArgs
+     */
+    problemSetId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiExportProblemSetCommandArgs
+     */
+    forUserId: string;
+    /**
+     * 
+     * @type {ApiProblemSetFileFormat}
+     * @memberof ApiExportProblemSetCommandArgs
+     */
+    format: ApiProblemSetFileFormat;
+}
+
+/**
+ * Check if a given object implements the ApiExportProblemSetCommandArgs interface.
+ */
+export function instanceOfApiExportProblemSetCommandArgs(value: object): boolean {
+    if (!('problemSetId' in value)) return false;
+    if (!('forUserId' in value)) return false;
+    if (!('format' in value)) return false;
+    return true;
+}
+
+export function ApiExportProblemSetCommandArgsFromJSON(json: any): ApiExportProblemSetCommandArgs {
+    return ApiExportProblemSetCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiExportProblemSetCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiExportProblemSetCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'],
+        'forUserId': json['forUserId'],
+        'format': ApiProblemSetFileFormatFromJSON(json['format']),
+    };
+}
+
+export function ApiExportProblemSetCommandArgsToJSON(value?: ApiExportProblemSetCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'forUserId': value['forUserId'],
+        'format': ApiProblemSetFileFormatToJSON(value['format']),
+    };
+}
+
@@ -0,0 +1,79 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiExportProblemSetCommandResult
+ */
+export interface ApiExportProblemSetCommandResult {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiExportProblemSetCommandResult
+     */
+    fileName: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiExportProblemSetCommandResult
+     */
+    contentType: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiExportProblemSetCommandResult
+     */
+    content: string;
+}
+
+/**
+ * Check if a given object implements the ApiExportProblemSetCommandResult interface.
+ */
+export function instanceOfApiExportProblemSetCommandResult(value: object): boolean {
+    if (!('fileName' in value)) return false;
+    if (!('contentType' in value)) return false;
+    if (!('content' in value)) return false;
+    return true;
+}
+
+export function ApiExportProblemSetCommandResultFromJSON(json: any): ApiExportProblemSetCommandResult {
+    return ApiExportProblemSetCommandResultFromJSONTyped(json, false);
+}
+
+export function ApiExportProblemSetCommandResultFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiExportProblemSetCommandResult {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'fileName': json['fileName'],
+        'contentType': json['contentType'],
+        'content': json['content'],
+    };
+}
+
+export function ApiExportProblemSetCommandResultToJSON(value?: ApiExportProblemSetCommandResult | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'fileName': value['fileName'],
+        'contentType': value['contentType'],
+        'content': value['content'],
+    };
+}
+
@@ -0,0 +1,111 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiFamilyRole } from './ApiFamilyRole';
+import {
+    ApiFamilyRoleFromJSON,
+    ApiFamilyRoleFromJSONTyped,
+    ApiFamilyRoleToJSON,
+} from './ApiFamilyRole';
+
+/**
+ * 
+ * @export
+ * @interface ApiFamilyInvitation
+ */
+export interface ApiFamilyInvitation {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiFamilyInvitation
+     */
+    email: string;
+    /**
+     * 
+     * @type {ApiFamilyRole}
+     * @memberof ApiFamilyInvitation
+     */
+    role: ApiFamilyRole;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiFamilyInvitation
+     */
+    firstName?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiFamilyInvitation
+     */
+    lastName?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiFamilyInvitation
+     */
+    invitedBy: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiFamilyInvitation
+     */
+    created: Date;
+}
+
+/**
+ * Check if a given object implements the ApiFamilyInvitation interface.
+ */
+export function instanceOfApiFamilyInvitation(value: object): boolean {
+    if (!('email' in value)) return false;
+    if (!('role' in value)) return false;
+    if (!('invitedBy' in value)) return false;
+    if (!('created' in value)) return false;
+    return true;
+}
+
+export function ApiFamilyInvitationFromJSON(json: any): ApiFamilyInvitation {
+    return ApiFamilyInvitationFromJSONTyped(json, false);
+}
+
+export function ApiFamilyInvitationFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiFamilyInvitation {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'email': json['email'],
+        'role': ApiFamilyRoleFromJSON(json['role']),
+        'firstName': json['firstName'] == null ? undefined : json['firstName'],
+        'lastName': json['lastName'] == null ? undefined : json['lastName'],
+        'invitedBy': json['invitedBy'],
+        'created': (new Date(json['created'])),
+    };
+}
+
+export function ApiFamilyInvitationToJSON(value?: ApiFamilyInvitation | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'email': value['email'],
+        'role': ApiFamilyRoleToJSON(value['role']),
+        'firstName': value['firstName'],
+        'lastName': value['lastName'],
+        'invitedBy': value['invitedBy'],
+        'created': ((value['created']).toISOString()),
+    };
+}
+
@@ -13,6 +13,13 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiTaskSchedule } from './ApiTaskSchedule';
+import {
+    ApiTaskScheduleFromJSON,
+    ApiTaskScheduleFromJSONTyped,
+    ApiTaskScheduleToJSON,
+} from './ApiTaskSchedule';
+
 /**
  * 
  * @export
@@ -37,6 +44,24 @@ export interface ApiFamilyTask {
      * @memberof ApiFamilyTask
      */
     memberIds: Array<string>;
+    /**
+     * 
+     * @type {ApiTaskSchedule}
+     * @memberof ApiFamilyTask
+     */
+    schedule?: ApiTaskSchedule;
+    /**
+     * Points earned each time the task is done
+     * @type {number}
+     * @memberof ApiFamilyTask
+     */
+    points?: number;
+    /**
+     * Marking the task done requires an admin approval
+     * @type {boolean}
+     * @memberof ApiFamilyTask
+     */
+    requiresApproval?: boolean;
 }
 
 /**
@@ -61,6 +86,9 @@ export function ApiFamilyTaskFromJSONTyped(json: any, ignoreDiscriminator: boole
         'title': json['title'],
         'description': json['description'] == null ? undefined : json['description'],
         'memberIds': json['memberIds'],
+        'schedule': json['schedule'] == null ? undefined : ApiTaskScheduleFromJSON(json['schedule']),
+        'points': json['points'] == null ? undefined : json['points'],
+        'requiresApproval': json['requiresApproval'] == null ? undefined : json['requiresApproval'],
     };
 }
 
@@ -73,6 +101,9 @@ export function ApiFamilyTaskToJSON(value?: ApiFamilyTask | null): any {
         'title': value['title'],
         'description': value['description'],
         'memberIds': value['memberIds'],
+        'schedule': ApiTaskScheduleToJSON(value['schedule']),
+        'points': value['points'],
+        'requiresApproval': value['requiresApproval'],
     };
 }
 
@@ -0,0 +1,102 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemSetFileFormat } from './ApiProblemSetFileFormat';
+import {
+    ApiProblemSetFileFormatFromJSON,
+    ApiProblemSetFileFormatFromJSONTyped,
+    ApiProblemSetFileFormatToJSON,
+} from './ApiProblemSetFileFormat';
+
+/**
+ * 
+ * @export
+ * @interface ApiImportProblemSetCommandArgs
+ */
+export interface ApiImportProblemSetCommandArgs {
+    /**
+     * Problem set to import the problems to, a new problem set is created from a json file when missing
+     * @type {string}
+     * @memberof ApiImportProblemSetCommandArgs
+     */
+    problemSetId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiImportProblemSetCommandArgs
+     */
+    forUserId: string;
+    /**
+     * 
+     * @type {ApiProblemSetFileFormat}
+     * @memberof ApiImportProblemSetCommandArgs
+     */
+    format: ApiProblemSetFileFormat;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiImportProblemSetCommandArgs
+     */
+    content: string;
+    /**
+     * Only validate the file, reporting the errors without importing
+     * @type {boolean}
+     * @memberof ApiImportProblemSetCommandArgs
+     */
+    dryRun?: boolean;
+}
+
+/**
+ * Check if a given object implements the ApiImportProblemSetCommandArgs interface.
+ */
+export function instanceOfApiImportProblemSetCommandArgs(value: object): boolean {
+    if (!('forUserId' in value)) return false;
+    if (!('format' in value)) return false;
+    if (!('content' in value)) return false;
+    return true;
+}
+
+export function ApiImportProblemSetCommandArgsFromJSON(json: any): ApiImportProblemSetCommandArgs {
+    return ApiImportProblemSetCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiImportProblemSetCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiImportProblemSetCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'] == null ? undefined : json['problemSetId'],
+        'forUserId': json['forUserId'],
+        'format': ApiProblemSetFileFormatFromJSON(json['format']),
+        'content': json['content'],
+        'dryRun': json['dryRun'] == null ? undefined : json['dryRun'],
+    };
+}
+
+export function ApiImportProblemSetCommandArgsToJSON(value?: ApiImportProblemSetCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'forUserId': value['forUserId'],
+        'format': ApiProblemSetFileFormatToJSON(value['format']),
+        'content': value['content'],
+        'dryRun': value['dryRun'],
+    };
+}
+
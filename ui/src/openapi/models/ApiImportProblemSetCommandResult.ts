@@ -0,0 +1,85 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemImportError } from './ApiProblemImportError';
+import {
+    ApiProblemImportErrorFromJSON,
+    ApiProblemImportErrorFromJSONTyped,
+    ApiProblemImportErrorToJSON,
+} from './ApiProblemImportError';
+
+/**
+ * 
+ * @export
+ * @interface ApiImportProblemSetCommandResult
+ */
+export interface ApiImportProblemSetCommandResult {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiImportProblemSetCommandResult
+     */
+    problemSetId?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiImportProblemSetCommandResult
+     */
+    importedCount: number;
+    /**
+     * 
+     * @type {Array<ApiProblemImportError>}
+     * @memberof ApiImportProblemSetCommandResult
+     */
+    errors: Array<ApiProblemImportError>;
+}
+
+/**
+ * Check if a given object implements the ApiImportProblemSetCommandResult interface.
+ */
+export function instanceOfApiImportProblemSetCommandResult(value: object): boolean {
+    if (!('importedCount' in value)) return false;
+    if (!('errors' in value)) return false;
+    return true;
+}
+
+export function ApiImportProblemSetCommandResultFromJSON(json: any): ApiImportProblemSetCommandResult {
+    return ApiImportProblemSetCommandResultFromJSONTyped(json, false);
+}
+
+export function ApiImportProblemSetCommandResultFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiImportProblemSetCommandResult {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'] == null ? undefined : json['problemSetId'],
+        'importedCount': json['importedCount'],
+        'errors': ((json['errors'] as Array<any>).map(ApiProblemImportErrorFromJSON)),
+    };
+}
+
+export function ApiImportProblemSetCommandResultToJSON(value?: ApiImportProblemSetCommandResult | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'importedCount': value['importedCount'],
+        'errors': ((value['errors'] as Array<any>).map(ApiProblemImportErrorToJSON)),
+    };
+}
+
@@ -0,0 +1,93 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiFamilyRole } from './ApiFamilyRole';
+import {
+    ApiFamilyRoleFromJSON,
+    ApiFamilyRoleFromJSONTyped,
+    ApiFamilyRoleToJSON,
+} from './ApiFamilyRole';
+
+/**
+ * 
+ * @export
+ * @interface ApiInviteFamilyMemberCommandArgs
+ */
+export interface ApiInviteFamilyMemberCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiInviteFamilyMemberCommandArgs
+     */
+    email: string;
+    /**
+     * 
+     * @type {ApiFamilyRole}
+     * @memberof ApiInviteFamilyMemberCommandArgs
+     */
+    role: ApiFamilyRole;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiInviteFamilyMemberCommandArgs
+     */
+    firstName?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiInviteFamilyMemberCommandArgs
+     */
+    lastName?: string;
+}
+
+/**
+ * Check if a given object implements the ApiInviteFamilyMemberCommandArgs interface.
+ */
+export function instanceOfApiInviteFamilyMemberCommandArgs(value: object): boolean {
+    if (!('email' in value)) return false;
+    if (!('role' in value)) return false;
+    return true;
+}
+
+export function ApiInviteFamilyMemberCommandArgsFromJSON(json: any): ApiInviteFamilyMemberCommandArgs {
+    return ApiInviteFamilyMemberCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiInviteFamilyMemberCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiInviteFamilyMemberCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'email': json['email'],
+        'role': ApiFamilyRoleFromJSON(json['role']),
+        'firstName': json['firstName'] == null ? undefined : json['firstName'],
+        'lastName': json['lastName'] == null ? undefined : json['lastName'],
+    };
+}
+
+export function ApiInviteFamilyMemberCommandArgsToJSON(value?: ApiInviteFamilyMemberCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'email': value['email'],
+        'role': ApiFamilyRoleToJSON(value['role']),
+        'firstName': value['firstName'],
+        'lastName': value['lastName'],
+    };
+}
+
@@ -0,0 +1,39 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * 
+ * @export
+ */
+export const ApiLifecycleStatus = {
+    Active: 'active',
+    Archived: 'archived',
+    Deleted: 'deleted'
+} as const;
+export type ApiLifecycleStatus = typeof ApiLifecycleStatus[keyof typeof ApiLifecycleStatus];
+
+
+export function ApiLifecycleStatusFromJSON(json: any): ApiLifecycleStatus {
+    return ApiLifecycleStatusFromJSONTyped(json, false);
+}
+
+export function ApiLifecycleStatusFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiLifecycleStatus {
+    return json as ApiLifecycleStatus;
+}
+
+export function ApiLifecycleStatusToJSON(value?: ApiLifecycleStatus | null): any {
+    return value as any;
+}
+
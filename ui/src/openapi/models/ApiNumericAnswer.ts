@@ -0,0 +1,69 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiNumericAnswer
+ */
+export interface ApiNumericAnswer {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiNumericAnswer
+     */
+    value: number;
+    /**
+     * Maximal allowed distance from the value, defaults to 0
+     * @type {number}
+     * @memberof ApiNumericAnswer
+     */
+    tolerance?: number;
+}
+
+/**
+ * Check if a given object implements the ApiNumericAnswer interface.
+ */
+export function instanceOfApiNumericAnswer(value: object): boolean {
+    if (!('value' in value)) return false;
+    return true;
+}
+
+export function ApiNumericAnswerFromJSON(json: any): ApiNumericAnswer {
+    return ApiNumericAnswerFromJSONTyped(json, false);
+}
+
+export function ApiNumericAnswerFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiNumericAnswer {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'value': json['value'],
+        'tolerance': json['tolerance'] == null ? undefined : json['tolerance'],
+    };
+}
+
+export function ApiNumericAnswerToJSON(value?: ApiNumericAnswer | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'value': value['value'],
+        'tolerance': value['tolerance'],
+    };
+}
+
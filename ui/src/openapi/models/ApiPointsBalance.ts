@@ -0,0 +1,79 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiPointsBalance
+ */
+export interface ApiPointsBalance {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiPointsBalance
+     */
+    userId: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiPointsBalance
+     */
+    balance: number;
+    /**
+     * Points reserved by redemptions pending approval
+     * @type {number}
+     * @memberof ApiPointsBalance
+     */
+    reserved: number;
+}
+
+/**
+ * Check if a given object implements the ApiPointsBalance interface.
+ */
+export function instanceOfApiPointsBalance(value: object): boolean {
+    if (!('userId' in value)) return false;
+    if (!('balance' in value)) return false;
+    if (!('reserved' in value)) return false;
+    return true;
+}
+
+export function ApiPointsBalanceFromJSON(json: any): ApiPointsBalance {
+    return ApiPointsBalanceFromJSONTyped(json, false);
+}
+
+export function ApiPointsBalanceFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiPointsBalance {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'userId': json['userId'],
+        'balance': json['balance'],
+        'reserved': json['reserved'],
+    };
+}
+
+export function ApiPointsBalanceToJSON(value?: ApiPointsBalance | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'userId': value['userId'],
+        'balance': value['balance'],
+        'reserved': value['reserved'],
+    };
+}
+
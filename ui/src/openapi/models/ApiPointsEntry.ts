@@ -0,0 +1,88 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiPointsEntry
+ */
+export interface ApiPointsEntry {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiPointsEntry
+     */
+    id: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiPointsEntry
+     */
+    forDate: Date;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiPointsEntry
+     */
+    points: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiPointsEntry
+     */
+    reason: string;
+}
+
+/**
+ * Check if a given object implements the ApiPointsEntry interface.
+ */
+export function instanceOfApiPointsEntry(value: object): boolean {
+    if (!('id' in value)) return false;
+    if (!('forDate' in value)) return false;
+    if (!('points' in value)) return false;
+    if (!('reason' in value)) return false;
+    return true;
+}
+
+export function ApiPointsEntryFromJSON(json: any): ApiPointsEntry {
+    return ApiPointsEntryFromJSONTyped(json, false);
+}
+
+export function ApiPointsEntryFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiPointsEntry {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'forDate': (new Date(json['forDate'])),
+        'points': json['points'],
+        'reason': json['reason'],
+    };
+}
+
+export function ApiPointsEntryToJSON(value?: ApiPointsEntry | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'forDate': ((value['forDate']).toISOString()),
+        'points': value['points'],
+        'reason': value['reason'],
+    };
+}
+
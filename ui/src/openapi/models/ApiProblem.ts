@@ -19,6 +19,12 @@ import {
     ApiProblemAnswerFromJSONTyped,
     ApiProblemAnswerToJSON,
 } from './ApiProblemAnswer';
+import type { ApiProblemType } from './ApiProblemType';
+import {
+    ApiProblemTypeFromJSON,
+    ApiProblemTypeFromJSONTyped,
+    ApiProblemTypeToJSON,
+} from './ApiProblemType';
 
 /**
  * 
@@ -32,6 +38,12 @@ export interface ApiProblem {
      * @memberof ApiProblem
      */
     id: string;
+    /**
+     * 
+     * @type {ApiProblemType}
+     * @memberof ApiProblem
+     */
+    type: ApiProblemType;
     /**
      * 
      * @type {string}
@@ -45,11 +57,23 @@ export interface ApiProblem {
      */
     description?: string;
     /**
-     * 
+     * Choices to select from, or the items to arrange for ordering problems
      * @type {Array<ApiProblemAnswer>}
      * @memberof ApiProblem
      */
     answers: Array<ApiProblemAnswer>;
+    /**
+     * Number of hints the problem has
+     * @type {number}
+     * @memberof ApiProblem
+     */
+    hintsCount: number;
+    /**
+     * Hints revealed so far today
+     * @type {Array<string>}
+     * @memberof ApiProblem
+     */
+    revealedHints?: Array<string>;
 }
 
 /**
@@ -57,8 +81,10 @@ export interface ApiProblem {
  */
 export function instanceOfApiProblem(value: object): boolean {
     if (!('id' in value)) return false;
+    if (!('type' in value)) return false;
     if (!('title' in value)) return false;
     if (!('answers' in value)) return false;
+    if (!('hintsCount' in value)) return false;
     return true;
 }
 
@@ -73,9 +99,12 @@ export function ApiProblemFromJSONTyped(json: any, ignoreDiscriminator: boolean)
     return {
         
         'id': json['id'],
+        'type': ApiProblemTypeFromJSON(json['type']),
         'title': json['title'],
         'description': json['description'] == null ? undefined : json['description'],
         'answers': ((json['answers'] as Array<any>).map(ApiProblemAnswerFromJSON)),
+        'hintsCount': json['hintsCount'],
+        'revealedHints': json['revealedHints'] == null ? undefined : json['revealedHints'],
     };
 }
 
@@ -86,9 +115,12 @@ export function ApiProblemToJSON(value?: ApiProblem | null): any {
     return {
         
         'id': value['id'],
+        'type': ApiProblemTypeToJSON(value['type']),
         'title': value['title'],
         'description': value['description'],
         'answers': ((value['answers'] as Array<any>).map(ApiProblemAnswerToJSON)),
+        'hintsCount': value['hintsCount'],
+        'revealedHints': value['revealedHints'],
     };
 }
 
@@ -13,12 +13,24 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiNumericAnswer } from './ApiNumericAnswer';
+import {
+    ApiNumericAnswerFromJSON,
+    ApiNumericAnswerFromJSONTyped,
+    ApiNumericAnswerToJSON,
+} from './ApiNumericAnswer';
 import type { ApiProblemAnswerForEdit } from './ApiProblemAnswerForEdit';
 import {
     ApiProblemAnswerForEditFromJSON,
     ApiProblemAnswerForEditFromJSONTyped,
     ApiProblemAnswerForEditToJSON,
 } from './ApiProblemAnswerForEdit';
+import type { ApiProblemType } from './ApiProblemType';
+import {
+    ApiProblemTypeFromJSON,
+    ApiProblemTypeFromJSONTyped,
+    ApiProblemTypeToJSON,
+} from './ApiProblemType';
 
 /**
  * 
@@ -32,6 +44,12 @@ export interface ApiProblemForEdit {
      * @memberof ApiProblemForEdit
      */
     id?: string;
+    /**
+     * 
+     * @type {ApiProblemType}
+     * @memberof ApiProblemForEdit
+     */
+    type?: ApiProblemType;
     /**
      * 
      * @type {string}
@@ -45,11 +63,47 @@ export interface ApiProblemForEdit {
      */
     description?: string;
     /**
-     * 
+     * Choices for choice problems, the items in the correct order for ordering problems, empty otherwise
      * @type {Array<ApiProblemAnswerForEdit>}
      * @memberof ApiProblemForEdit
      */
     answers: Array<ApiProblemAnswerForEdit>;
+    /**
+     * 
+     * @type {ApiNumericAnswer}
+     * @memberof ApiProblemForEdit
+     */
+    numericAnswer?: ApiNumericAnswer;
+    /**
+     * Accepted answer variants of short text problems
+     * @type {Array<string>}
+     * @memberof ApiProblemForEdit
+     */
+    acceptedAnswers?: Array<string>;
+    /**
+     * Hints revealed one by one on request, in order
+     * @type {Array<string>}
+     * @memberof ApiProblemForEdit
+     */
+    hints?: Array<string>;
+    /**
+     * Explanation of the correct answer, shown once the problem is answered
+     * @type {string}
+     * @memberof ApiProblemForEdit
+     */
+    explanation?: string;
+    /**
+     * Position of the problem in the set
+     * @type {number}
+     * @memberof ApiProblemForEdit
+     */
+    order?: number;
+    /**
+     * Archived problems were solved or retired, they are not presented as new problems
+     * @type {boolean}
+     * @memberof ApiProblemForEdit
+     */
+    archived?: boolean;
 }
 
 /**
@@ -72,9 +126,16 @@ export function ApiProblemForEditFromJSONTyped(json: any, ignoreDiscriminator: b
     return {
         
         'id': json['id'] == null ? undefined : json['id'],
+        'type': json['type'] == null ? undefined : ApiProblemTypeFromJSON(json['type']),
         'title': json['title'],
         'description': json['description'] == null ? undefined : json['description'],
         'answers': ((json['answers'] as Array<any>).map(ApiProblemAnswerForEditFromJSON)),
+        'numericAnswer': json['numericAnswer'] == null ? undefined : ApiNumericAnswerFromJSON(json['numericAnswer']),
+        'acceptedAnswers': json['acceptedAnswers'] == null ? undefined : json['acceptedAnswers'],
+        'hints': json['hints'] == null ? undefined : json['hints'],
+        'explanation': json['explanation'] == null ? undefined : json['explanation'],
+        'order': json['order'] == null ? undefined : json['order'],
+        'archived': json['archived'] == null ? undefined : json['archived'],
     };
 }
 
@@ -85,9 +146,16 @@ export function ApiProblemForEditToJSON(value?: ApiProblemForEdit | null): any {
     return {
         
         'id': value['id'],
+        'type': ApiProblemTypeToJSON(value['type']),
         'title': value['title'],
         'description': value['description'],
         'answers': ((value['answers'] as Array<any>).map(ApiProblemAnswerForEditToJSON)),
+        'numericAnswer': ApiNumericAnswerToJSON(value['numericAnswer']),
+        'acceptedAnswers': value['acceptedAnswers'],
+        'hints': value['hints'],
+        'explanation': value['explanation'],
+        'order': value['order'],
+        'archived': value['archived'],
     };
 }
 
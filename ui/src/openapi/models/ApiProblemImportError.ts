@@ -0,0 +1,70 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiProblemImportError
+ */
+export interface ApiProblemImportError {
+    /**
+     * Problem index in a json file, or line in a csv file, 0 when the error is not of a specific problem
+     * @type {number}
+     * @memberof ApiProblemImportError
+     */
+    row: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiProblemImportError
+     */
+    message: string;
+}
+
+/**
+ * Check if a given object implements the ApiProblemImportError interface.
+ */
+export function instanceOfApiProblemImportError(value: object): boolean {
+    if (!('row' in value)) return false;
+    if (!('message' in value)) return false;
+    return true;
+}
+
+export function ApiProblemImportErrorFromJSON(json: any): ApiProblemImportError {
+    return ApiProblemImportErrorFromJSONTyped(json, false);
+}
+
+export function ApiProblemImportErrorFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiProblemImportError {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'row': json['row'],
+        'message': json['message'],
+    };
+}
+
+export function ApiProblemImportErrorToJSON(value?: ApiProblemImportError | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'row': value['row'],
+        'message': value['message'],
+    };
+}
+
@@ -13,6 +13,19 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiLifecycleStatus } from './ApiLifecycleStatus';
+import {
+    ApiLifecycleStatusFromJSON,
+    ApiLifecycleStatusFromJSONTyped,
+    ApiLifecycleStatusToJSON,
+} from './ApiLifecycleStatus';
+import type { ApiProblemSetQuotaType } from './ApiProblemSetQuotaType';
+import {
+    ApiProblemSetQuotaTypeFromJSON,
+    ApiProblemSetQuotaTypeFromJSONTyped,
+    ApiProblemSetQuotaTypeToJSON,
+} from './ApiProblemSetQuotaType';
+
 /**
  * 
  * @export
@@ -31,12 +44,48 @@ export interface ApiProblemSet {
      * @memberof ApiProblemSet
      */
     title: string;
+    /**
+     * 
+     * @type {ApiLifecycleStatus}
+     * @memberof ApiProblemSet
+     */
+    status: ApiLifecycleStatus;
     /**
      * 
      * @type {string}
      * @memberof ApiProblemSet
      */
     description?: string;
+    /**
+     * Points earned for each correctly solved problem
+     * @type {number}
+     * @memberof ApiProblemSet
+     */
+    points?: number;
+    /**
+     * Number of problems that complete the daily assignment
+     * @type {number}
+     * @memberof ApiProblemSet
+     */
+    dailyQuota?: number;
+    /**
+     * 
+     * @type {ApiProblemSetQuotaType}
+     * @memberof ApiProblemSet
+     */
+    quotaType?: ApiProblemSetQuotaType;
+    /**
+     * Number of answers allowed for each problem until it is answered correctly
+     * @type {number}
+     * @memberof ApiProblemSet
+     */
+    maxAttempts?: number;
+    /**
+     * Set for a member copy of a problem set template, which is only changed through the template
+     * @type {string}
+     * @memberof ApiProblemSet
+     */
+    templateId?: string;
 }
 
 /**
@@ -45,6 +94,7 @@ export interface ApiProblemSet {
 export function instanceOfApiProblemSet(value: object): boolean {
     if (!('id' in value)) return false;
     if (!('title' in value)) return false;
+    if (!('status' in value)) return false;
     return true;
 }
 
@@ -60,7 +110,13 @@ export function ApiProblemSetFromJSONTyped(json: any, ignoreDiscriminator: boole
         
         'id': json['id'],
         'title': json['title'],
+        'status': ApiLifecycleStatusFromJSON(json['status']),
         'description': json['description'] == null ? undefined : json['description'],
+        'points': json['points'] == null ? undefined : json['points'],
+        'dailyQuota': json['dailyQuota'] == null ? undefined : json['dailyQuota'],
+        'quotaType': json['quotaType'] == null ? undefined : ApiProblemSetQuotaTypeFromJSON(json['quotaType']),
+        'maxAttempts': json['maxAttempts'] == null ? undefined : json['maxAttempts'],
+        'templateId': json['templateId'] == null ? undefined : json['templateId'],
     };
 }
 
@@ -72,7 +128,13 @@ export function ApiProblemSetToJSON(value?: ApiProblemSet | null): any {
         
         'id': value['id'],
         'title': value['title'],
+        'status': ApiLifecycleStatusToJSON(value['status']),
         'description': value['description'],
+        'points': value['points'],
+        'dailyQuota': value['dailyQuota'],
+        'quotaType': ApiProblemSetQuotaTypeToJSON(value['quotaType']),
+        'maxAttempts': value['maxAttempts'],
+        'templateId': value['templateId'],
     };
 }
 
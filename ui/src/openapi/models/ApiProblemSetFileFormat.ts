@@ -0,0 +1,38 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * 
+ * @export
+ */
+export const ApiProblemSetFileFormat = {
+    Json: 'json',
+    Csv: 'csv'
+} as const;
+export type ApiProblemSetFileFormat = typeof ApiProblemSetFileFormat[keyof typeof ApiProblemSetFileFormat];
+
+
+export function ApiProblemSetFileFormatFromJSON(json: any): ApiProblemSetFileFormat {
+    return ApiProblemSetFileFormatFromJSONTyped(json, false);
+}
+
+export function ApiProblemSetFileFormatFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiProblemSetFileFormat {
+    return json as ApiProblemSetFileFormat;
+}
+
+export function ApiProblemSetFileFormatToJSON(value?: ApiProblemSetFileFormat | null): any {
+    return value as any;
+}
+
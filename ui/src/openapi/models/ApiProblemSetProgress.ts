@@ -0,0 +1,106 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiProblemSetProgress
+ */
+export interface ApiProblemSetProgress {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiProblemSetProgress
+     */
+    problemSetId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiProblemSetProgress
+     */
+    title: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiProblemSetProgress
+     */
+    completed: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiProblemSetProgress
+     */
+    required: number;
+    /**
+     * Problems answered correctly, including the ones solved with hints
+     * @type {number}
+     * @memberof ApiProblemSetProgress
+     */
+    correctCount: number;
+    /**
+     * Problems answered correctly with the help of hints
+     * @type {number}
+     * @memberof ApiProblemSetProgress
+     */
+    correctWithHintsCount: number;
+}
+
+/**
+ * Check if a given object implements the ApiProblemSetProgress interface.
+ */
+export function instanceOfApiProblemSetProgress(value: object): boolean {
+    if (!('problemSetId' in value)) return false;
+    if (!('title' in value)) return false;
+    if (!('completed' in value)) return false;
+    if (!('required' in value)) return false;
+    if (!('correctCount' in value)) return false;
+    if (!('correctWithHintsCount' in value)) return false;
+    return true;
+}
+
+export function ApiProblemSetProgressFromJSON(json: any): ApiProblemSetProgress {
+    return ApiProblemSetProgressFromJSONTyped(json, false);
+}
+
+export function ApiProblemSetProgressFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiProblemSetProgress {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'],
+        'title': json['title'],
+        'completed': json['completed'],
+        'required': json['required'],
+        'correctCount': json['correctCount'],
+        'correctWithHintsCount': json['correctWithHintsCount'],
+    };
+}
+
+export function ApiProblemSetProgressToJSON(value?: ApiProblemSetProgress | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'title': value['title'],
+        'completed': value['completed'],
+        'required': value['required'],
+        'correctCount': value['correctCount'],
+        'correctWithHintsCount': value['correctWithHintsCount'],
+    };
+}
+
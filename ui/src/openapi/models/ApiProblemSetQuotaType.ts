@@ -0,0 +1,38 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * Whether the daily quota counts answered problems or correct answers only
+ * @export
+ */
+export const ApiProblemSetQuotaType = {
+    Problems: 'problems',
+    CorrectAnswers: 'correctAnswers'
+} as const;
+export type ApiProblemSetQuotaType = typeof ApiProblemSetQuotaType[keyof typeof ApiProblemSetQuotaType];
+
+
+export function ApiProblemSetQuotaTypeFromJSON(json: any): ApiProblemSetQuotaType {
+    return ApiProblemSetQuotaTypeFromJSONTyped(json, false);
+}
+
+export function ApiProblemSetQuotaTypeFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiProblemSetQuotaType {
+    return json as ApiProblemSetQuotaType;
+}
+
+export function ApiProblemSetQuotaTypeToJSON(value?: ApiProblemSetQuotaType | null): any {
+    return value as any;
+}
+
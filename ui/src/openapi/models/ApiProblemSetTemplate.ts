@@ -0,0 +1,141 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiLifecycleStatus } from './ApiLifecycleStatus';
+import {
+    ApiLifecycleStatusFromJSON,
+    ApiLifecycleStatusFromJSONTyped,
+    ApiLifecycleStatusToJSON,
+} from './ApiLifecycleStatus';
+import type { ApiProblemSetQuotaType } from './ApiProblemSetQuotaType';
+import {
+    ApiProblemSetQuotaTypeFromJSON,
+    ApiProblemSetQuotaTypeFromJSONTyped,
+    ApiProblemSetQuotaTypeToJSON,
+} from './ApiProblemSetQuotaType';
+
+/**
+ * 
+ * @export
+ * @interface ApiProblemSetTemplate
+ */
+export interface ApiProblemSetTemplate {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiProblemSetTemplate
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiProblemSetTemplate
+     */
+    title: string;
+    /**
+     * 
+     * @type {ApiLifecycleStatus}
+     * @memberof ApiProblemSetTemplate
+     */
+    status: ApiLifecycleStatus;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiProblemSetTemplate
+     */
+    description?: string;
+    /**
+     * Points earned for each correctly solved problem
+     * @type {number}
+     * @memberof ApiProblemSetTemplate
+     */
+    points?: number;
+    /**
+     * Number of problems that complete the daily assignment
+     * @type {number}
+     * @memberof ApiProblemSetTemplate
+     */
+    dailyQuota?: number;
+    /**
+     * 
+     * @type {ApiProblemSetQuotaType}
+     * @memberof ApiProblemSetTemplate
+     */
+    quotaType?: ApiProblemSetQuotaType;
+    /**
+     * Number of answers allowed for each problem until it is answered correctly
+     * @type {number}
+     * @memberof ApiProblemSetTemplate
+     */
+    maxAttempts?: number;
+    /**
+     * Family members solving a copy of the template
+     * @type {Array<string>}
+     * @memberof ApiProblemSetTemplate
+     */
+    memberIds: Array<string>;
+}
+
+/**
+ * Check if a given object implements the ApiProblemSetTemplate interface.
+ */
+export function instanceOfApiProblemSetTemplate(value: object): boolean {
+    if (!('id' in value)) return false;
+    if (!('title' in value)) return false;
+    if (!('status' in value)) return false;
+    if (!('memberIds' in value)) return false;
+    return true;
+}
+
+export function ApiProblemSetTemplateFromJSON(json: any): ApiProblemSetTemplate {
+    return ApiProblemSetTemplateFromJSONTyped(json, false);
+}
+
+export function ApiProblemSetTemplateFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiProblemSetTemplate {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'title': json['title'],
+        'status': ApiLifecycleStatusFromJSON(json['status']),
+        'description': json['description'] == null ? undefined : json['description'],
+        'points': json['points'] == null ? undefined : json['points'],
+        'dailyQuota': json['dailyQuota'] == null ? undefined : json['dailyQuota'],
+        'quotaType': json['quotaType'] == null ? undefined : ApiProblemSetQuotaTypeFromJSON(json['quotaType']),
+        'maxAttempts': json['maxAttempts'] == null ? undefined : json['maxAttempts'],
+        'memberIds': json['memberIds'],
+    };
+}
+
+export function ApiProblemSetTemplateToJSON(value?: ApiProblemSetTemplate | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'title': value['title'],
+        'status': ApiLifecycleStatusToJSON(value['status']),
+        'description': value['description'],
+        'points': value['points'],
+        'dailyQuota': value['dailyQuota'],
+        'quotaType': ApiProblemSetQuotaTypeToJSON(value['quotaType']),
+        'maxAttempts': value['maxAttempts'],
+        'memberIds': value['memberIds'],
+    };
+}
+
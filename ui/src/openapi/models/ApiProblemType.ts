@@ -0,0 +1,41 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * 
+ * @export
+ */
+export const ApiProblemType = {
+    SingleChoice: 'singleChoice',
+    MultipleSelect: 'multipleSelect',
+    Numeric: 'numeric',
+    ShortText: 'shortText',
+    Ordering: 'ordering'
+} as const;
+export type ApiProblemType = typeof ApiProblemType[keyof typeof ApiProblemType];
+
+
+export function ApiProblemTypeFromJSON(json: any): ApiProblemType {
+    return ApiProblemTypeFromJSONTyped(json, false);
+}
+
+export function ApiProblemTypeFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiProblemType {
+    return json as ApiProblemType;
+}
+
+export function ApiProblemTypeToJSON(value?: ApiProblemType | null): any {
+    return value as any;
+}
+
@@ -0,0 +1,61 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiRedeemRewardCommandArgs
+ */
+export interface ApiRedeemRewardCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRedeemRewardCommandArgs
+     */
+    rewardId: string;
+}
+
+/**
+ * Check if a given object implements the ApiRedeemRewardCommandArgs interface.
+ */
+export function instanceOfApiRedeemRewardCommandArgs(value: object): boolean {
+    if (!('rewardId' in value)) return false;
+    return true;
+}
+
+export function ApiRedeemRewardCommandArgsFromJSON(json: any): ApiRedeemRewardCommandArgs {
+    return ApiRedeemRewardCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiRedeemRewardCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiRedeemRewardCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'rewardId': json['rewardId'],
+    };
+}
+
+export function ApiRedeemRewardCommandArgsToJSON(value?: ApiRedeemRewardCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'rewardId': value['rewardId'],
+    };
+}
+
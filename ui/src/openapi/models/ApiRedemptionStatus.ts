@@ -0,0 +1,39 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * 
+ * @export
+ */
+export const ApiRedemptionStatus = {
+    Pending: 'pending',
+    Approved: 'approved',
+    Rejected: 'rejected'
+} as const;
+export type ApiRedemptionStatus = typeof ApiRedemptionStatus[keyof typeof ApiRedemptionStatus];
+
+
+export function ApiRedemptionStatusFromJSON(json: any): ApiRedemptionStatus {
+    return ApiRedemptionStatusFromJSONTyped(json, false);
+}
+
+export function ApiRedemptionStatusFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiRedemptionStatus {
+    return json as ApiRedemptionStatus;
+}
+
+export function ApiRedemptionStatusToJSON(value?: ApiRedemptionStatus | null): any {
+    return value as any;
+}
+
@@ -0,0 +1,61 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiRemoveFamilyMemberCommandArgs
+ */
+export interface ApiRemoveFamilyMemberCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRemoveFamilyMemberCommandArgs
+     */
+    userId: string;
+}
+
+/**
+ * Check if a given object implements the ApiRemoveFamilyMemberCommandArgs interface.
+ */
+export function instanceOfApiRemoveFamilyMemberCommandArgs(value: object): boolean {
+    if (!('userId' in value)) return false;
+    return true;
+}
+
+export function ApiRemoveFamilyMemberCommandArgsFromJSON(json: any): ApiRemoveFamilyMemberCommandArgs {
+    return ApiRemoveFamilyMemberCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiRemoveFamilyMemberCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiRemoveFamilyMemberCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'userId': json['userId'],
+    };
+}
+
+export function ApiRemoveFamilyMemberCommandArgsToJSON(value?: ApiRemoveFamilyMemberCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'userId': value['userId'],
+    };
+}
+
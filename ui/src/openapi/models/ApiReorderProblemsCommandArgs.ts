@@ -0,0 +1,79 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiReorderProblemsCommandArgs
+ */
+export interface ApiReorderProblemsCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiReorderProblemsCommandArgs
+     */
+    problemSetId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiReorderProblemsCommandArgs
+     */
+    forUserId: string;
+    /**
+     * All active problems of the set, in the new order
+     * @type {Array<string>}
+     * @memberof ApiReorderProblemsCommandArgs
+     */
+    problemIds: Array<string>;
+}
+
+/**
+ * Check if a given object implements the ApiReorderProblemsCommandArgs interface.
+ */
+export function instanceOfApiReorderProblemsCommandArgs(value: object): boolean {
+    if (!('problemSetId' in value)) return false;
+    if (!('forUserId' in value)) return false;
+    if (!('problemIds' in value)) return false;
+    return true;
+}
+
+export function ApiReorderProblemsCommandArgsFromJSON(json: any): ApiReorderProblemsCommandArgs {
+    return ApiReorderProblemsCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiReorderProblemsCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiReorderProblemsCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'],
+        'forUserId': json['forUserId'],
+        'problemIds': json['problemIds'],
+    };
+}
+
+export function ApiReorderProblemsCommandArgsToJSON(value?: ApiReorderProblemsCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'forUserId': value['forUserId'],
+        'problemIds': value['problemIds'],
+    };
+}
+
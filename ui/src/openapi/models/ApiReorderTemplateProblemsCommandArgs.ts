@@ -0,0 +1,70 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiReorderTemplateProblemsCommandArgs
+ */
+export interface ApiReorderTemplateProblemsCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiReorderTemplateProblemsCommandArgs
+     */
+    templateId: string;
+    /**
+     * All problems of the template, in the new order
+     * @type {Array<string>}
+     * @memberof ApiReorderTemplateProblemsCommandArgs
+     */
+    problemIds: Array<string>;
+}
+
+/**
+ * Check if a given object implements the ApiReorderTemplateProblemsCommandArgs interface.
+ */
+export function instanceOfApiReorderTemplateProblemsCommandArgs(value: object): boolean {
+    if (!('templateId' in value)) return false;
+    if (!('problemIds' in value)) return false;
+    return true;
+}
+
+export function ApiReorderTemplateProblemsCommandArgsFromJSON(json: any): ApiReorderTemplateProblemsCommandArgs {
+    return ApiReorderTemplateProblemsCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiReorderTemplateProblemsCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiReorderTemplateProblemsCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'templateId': json['templateId'],
+        'problemIds': json['problemIds'],
+    };
+}
+
+export function ApiReorderTemplateProblemsCommandArgsToJSON(value?: ApiReorderTemplateProblemsCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'templateId': value['templateId'],
+        'problemIds': value['problemIds'],
+    };
+}
+
@@ -0,0 +1,70 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiRevealProblemHintCommandArgs
+ */
+export interface ApiRevealProblemHintCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRevealProblemHintCommandArgs
+     */
+    assignmentId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRevealProblemHintCommandArgs
+     */
+    problemId: string;
+}
+
+/**
+ * Check if a given object implements the ApiRevealProblemHintCommandArgs interface.
+ */
+export function instanceOfApiRevealProblemHintCommandArgs(value: object): boolean {
+    if (!('assignmentId' in value)) return false;
+    if (!('problemId' in value)) return false;
+    return true;
+}
+
+export function ApiRevealProblemHintCommandArgsFromJSON(json: any): ApiRevealProblemHintCommandArgs {
+    return ApiRevealProblemHintCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiRevealProblemHintCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiRevealProblemHintCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'assignmentId': json['assignmentId'],
+        'problemId': json['problemId'],
+    };
+}
+
+export function ApiRevealProblemHintCommandArgsToJSON(value?: ApiRevealProblemHintCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'assignmentId': value['assignmentId'],
+        'problemId': value['problemId'],
+    };
+}
+
@@ -0,0 +1,70 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiRevealProblemHintCommandResp
+ */
+export interface ApiRevealProblemHintCommandResp {
+    /**
+     * All hints revealed so far, the last one is the newly revealed hint
+     * @type {Array<string>}
+     * @memberof ApiRevealProblemHintCommandResp
+     */
+    revealedHints: Array<string>;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiRevealProblemHintCommandResp
+     */
+    hintsCount: number;
+}
+
+/**
+ * Check if a given object implements the ApiRevealProblemHintCommandResp interface.
+ */
+export function instanceOfApiRevealProblemHintCommandResp(value: object): boolean {
+    if (!('revealedHints' in value)) return false;
+    if (!('hintsCount' in value)) return false;
+    return true;
+}
+
+export function ApiRevealProblemHintCommandRespFromJSON(json: any): ApiRevealProblemHintCommandResp {
+    return ApiRevealProblemHintCommandRespFromJSONTyped(json, false);
+}
+
+export function ApiRevealProblemHintCommandRespFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiRevealProblemHintCommandResp {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'revealedHints': json['revealedHints'],
+        'hintsCount': json['hintsCount'],
+    };
+}
+
+export function ApiRevealProblemHintCommandRespToJSON(value?: ApiRevealProblemHintCommandResp | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'revealedHints': value['revealedHints'],
+        'hintsCount': value['hintsCount'],
+    };
+}
+
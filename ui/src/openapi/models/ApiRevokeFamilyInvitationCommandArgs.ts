@@ -0,0 +1,61 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiRevokeFamilyInvitationCommandArgs
+ */
+export interface ApiRevokeFamilyInvitationCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRevokeFamilyInvitationCommandArgs
+     */
+    email: string;
+}
+
+/**
+ * Check if a given object implements the ApiRevokeFamilyInvitationCommandArgs interface.
+ */
+export function instanceOfApiRevokeFamilyInvitationCommandArgs(value: object): boolean {
+    if (!('email' in value)) return false;
+    return true;
+}
+
+export function ApiRevokeFamilyInvitationCommandArgsFromJSON(json: any): ApiRevokeFamilyInvitationCommandArgs {
+    return ApiRevokeFamilyInvitationCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiRevokeFamilyInvitationCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiRevokeFamilyInvitationCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'email': json['email'],
+    };
+}
+
+export function ApiRevokeFamilyInvitationCommandArgsToJSON(value?: ApiRevokeFamilyInvitationCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'email': value['email'],
+    };
+}
+
@@ -0,0 +1,87 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiReward
+ */
+export interface ApiReward {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiReward
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiReward
+     */
+    title: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiReward
+     */
+    description?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiReward
+     */
+    cost: number;
+}
+
+/**
+ * Check if a given object implements the ApiReward interface.
+ */
+export function instanceOfApiReward(value: object): boolean {
+    if (!('id' in value)) return false;
+    if (!('title' in value)) return false;
+    if (!('cost' in value)) return false;
+    return true;
+}
+
+export function ApiRewardFromJSON(json: any): ApiReward {
+    return ApiRewardFromJSONTyped(json, false);
+}
+
+export function ApiRewardFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiReward {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'title': json['title'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'cost': json['cost'],
+    };
+}
+
+export function ApiRewardToJSON(value?: ApiReward | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'title': value['title'],
+        'description': value['description'],
+        'cost': value['cost'],
+    };
+}
+
@@ -0,0 +1,146 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiRedemptionStatus } from './ApiRedemptionStatus';
+import {
+    ApiRedemptionStatusFromJSON,
+    ApiRedemptionStatusFromJSONTyped,
+    ApiRedemptionStatusToJSON,
+} from './ApiRedemptionStatus';
+
+/**
+ * 
+ * @export
+ * @interface ApiRewardRedemption
+ */
+export interface ApiRewardRedemption {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRewardRedemption
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRewardRedemption
+     */
+    userId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRewardRedemption
+     */
+    rewardId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRewardRedemption
+     */
+    rewardTitle: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiRewardRedemption
+     */
+    cost: number;
+    /**
+     * 
+     * @type {ApiRedemptionStatus}
+     * @memberof ApiRewardRedemption
+     */
+    status: ApiRedemptionStatus;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiRewardRedemption
+     */
+    requested: Date;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRewardRedemption
+     */
+    decidedBy?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiRewardRedemption
+     */
+    decidedAt?: Date;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiRewardRedemption
+     */
+    comment?: string;
+}
+
+/**
+ * Check if a given object implements the ApiRewardRedemption interface.
+ */
+export function instanceOfApiRewardRedemption(value: object): boolean {
+    if (!('id' in value)) return false;
+    if (!('userId' in value)) return false;
+    if (!('rewardId' in value)) return false;
+    if (!('rewardTitle' in value)) return false;
+    if (!('cost' in value)) return false;
+    if (!('status' in value)) return false;
+    if (!('requested' in value)) return false;
+    return true;
+}
+
+export function ApiRewardRedemptionFromJSON(json: any): ApiRewardRedemption {
+    return ApiRewardRedemptionFromJSONTyped(json, false);
+}
+
+export function ApiRewardRedemptionFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiRewardRedemption {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'userId': json['userId'],
+        'rewardId': json['rewardId'],
+        'rewardTitle': json['rewardTitle'],
+        'cost': json['cost'],
+        'status': ApiRedemptionStatusFromJSON(json['status']),
+        'requested': (new Date(json['requested'])),
+        'decidedBy': json['decidedBy'] == null ? undefined : json['decidedBy'],
+        'decidedAt': json['decidedAt'] == null ? undefined : (new Date(json['decidedAt'])),
+        'comment': json['comment'] == null ? undefined : json['comment'],
+    };
+}
+
+export function ApiRewardRedemptionToJSON(value?: ApiRewardRedemption | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'userId': value['userId'],
+        'rewardId': value['rewardId'],
+        'rewardTitle': value['rewardTitle'],
+        'cost': value['cost'],
+        'status': ApiRedemptionStatusToJSON(value['status']),
+        'requested': ((value['requested']).toISOString()),
+        'decidedBy': value['decidedBy'],
+        'decidedAt': value['decidedAt'] == null ? undefined : ((value['decidedAt']).toISOString()),
+        'comment': value['comment'],
+    };
+}
+
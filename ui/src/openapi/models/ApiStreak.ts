@@ -0,0 +1,70 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiStreak
+ */
+export interface ApiStreak {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiStreak
+     */
+    current: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiStreak
+     */
+    best: number;
+}
+
+/**
+ * Check if a given object implements the ApiStreak interface.
+ */
+export function instanceOfApiStreak(value: object): boolean {
+    if (!('current' in value)) return false;
+    if (!('best' in value)) return false;
+    return true;
+}
+
+export function ApiStreakFromJSON(json: any): ApiStreak {
+    return ApiStreakFromJSONTyped(json, false);
+}
+
+export function ApiStreakFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiStreak {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'current': json['current'],
+        'best': json['best'],
+    };
+}
+
+export function ApiStreakToJSON(value?: ApiStreak | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'current': value['current'],
+        'best': value['best'],
+    };
+}
+
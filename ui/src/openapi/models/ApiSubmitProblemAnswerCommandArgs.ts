@@ -32,11 +32,23 @@ export interface ApiSubmitProblemAnswerCommandArgs {
      */
     problemId: string;
     /**
-     * 
+     * Selected answer of single choice problems
+     * @type {string}
+     * @memberof ApiSubmitProblemAnswerCommandArgs
+     */
+    answerId?: string;
+    /**
+     * Selected answers of multiple select problems, or all items in the submitted order for ordering problems
+     * @type {Array<string>}
+     * @memberof ApiSubmitProblemAnswerCommandArgs
+     */
+    answerIds?: Array<string>;
+    /**
+     * Answer of numeric and short text problems
      * @type {string}
      * @memberof ApiSubmitProblemAnswerCommandArgs
      */
-    answerId: string;
+    value?: string;
 }
 
 /**
@@ -45,7 +57,6 @@ export interface ApiSubmitProblemAnswerCommandArgs {
 export function instanceOfApiSubmitProblemAnswerCommandArgs(value: object): boolean {
     if (!('assignmentId' in value)) return false;
     if (!('problemId' in value)) return false;
-    if (!('answerId' in value)) return false;
     return true;
 }
 
@@ -61,7 +72,9 @@ export function ApiSubmitProblemAnswerCommandArgsFromJSONTyped(json: any, ignore
         
         'assignmentId': json['assignmentId'],
         'problemId': json['problemId'],
-        'answerId': json['answerId'],
+        'answerId': json['answerId'] == null ? undefined : json['answerId'],
+        'answerIds': json['answerIds'] == null ? undefined : json['answerIds'],
+        'value': json['value'] == null ? undefined : json['value'],
     };
 }
 
@@ -74,6 +87,8 @@ export function ApiSubmitProblemAnswerCommandArgsToJSON(value?: ApiSubmitProblem
         'assignmentId': value['assignmentId'],
         'problemId': value['problemId'],
         'answerId': value['answerId'],
+        'answerIds': value['answerIds'],
+        'value': value['value'],
     };
 }
 
@@ -26,11 +26,29 @@ export interface ApiSubmitProblemAnswerCommandResp {
      */
     isCorrect: boolean;
     /**
-     * 
+     * Attempts left for a wrong answer, the correct answer and explanation are only revealed when none are left
+     * @type {number}
+     * @memberof ApiSubmitProblemAnswerCommandResp
+     */
+    remainingAttempts: number;
+    /**
+     * Correct answer of single choice problems, empty for other problem types or while attempts are left
      * @type {string}
      * @memberof ApiSubmitProblemAnswerCommandResp
      */
     correctAnswerId: string;
+    /**
+     * Correct answers of multiple select problems, or the items in the correct order for ordering problems
+     * @type {Array<string>}
+     * @memberof ApiSubmitProblemAnswerCommandResp
+     */
+    correctAnswerIds?: Array<string>;
+    /**
+     * Correct answer of numeric and short text problems
+     * @type {string}
+     * @memberof ApiSubmitProblemAnswerCommandResp
+     */
+    correctValue?: string;
     /**
      * 
      * @type {string}
@@ -44,6 +62,7 @@ export interface ApiSubmitProblemAnswerCommandResp {
  */
 export function instanceOfApiSubmitProblemAnswerCommandResp(value: object): boolean {
     if (!('isCorrect' in value)) return false;
+    if (!('remainingAttempts' in value)) return false;
     if (!('correctAnswerId' in value)) return false;
     return true;
 }
@@ -59,7 +78,10 @@ export function ApiSubmitProblemAnswerCommandRespFromJSONTyped(json: any, ignore
     return {
         
         'isCorrect': json['isCorrect'],
+        'remainingAttempts': json['remainingAttempts'],
         'correctAnswerId': json['correctAnswerId'],
+        'correctAnswerIds': json['correctAnswerIds'] == null ? undefined : json['correctAnswerIds'],
+        'correctValue': json['correctValue'] == null ? undefined : json['correctValue'],
         'explanation': json['explanation'] == null ? undefined : json['explanation'],
     };
 }
@@ -71,7 +93,10 @@ export function ApiSubmitProblemAnswerCommandRespToJSON(value?: ApiSubmitProblem
     return {
         
         'isCorrect': value['isCorrect'],
+        'remainingAttempts': value['remainingAttempts'],
         'correctAnswerId': value['correctAnswerId'],
+        'correctAnswerIds': value['correctAnswerIds'],
+        'correctValue': value['correctValue'],
         'explanation': value['explanation'],
     };
 }
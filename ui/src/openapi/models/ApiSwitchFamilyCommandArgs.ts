@@ -0,0 +1,61 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiSwitchFamilyCommandArgs
+ */
+export interface ApiSwitchFamilyCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiSwitchFamilyCommandArgs
+     */
+    familyId: string;
+}
+
+/**
+ * Check if a given object implements the ApiSwitchFamilyCommandArgs interface.
+ */
+export function instanceOfApiSwitchFamilyCommandArgs(value: object): boolean {
+    if (!('familyId' in value)) return false;
+    return true;
+}
+
+export function ApiSwitchFamilyCommandArgsFromJSON(json: any): ApiSwitchFamilyCommandArgs {
+    return ApiSwitchFamilyCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiSwitchFamilyCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiSwitchFamilyCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'familyId': json['familyId'],
+    };
+}
+
+export function ApiSwitchFamilyCommandArgsToJSON(value?: ApiSwitchFamilyCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'familyId': value['familyId'],
+    };
+}
+
@@ -0,0 +1,114 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiTaskApproval
+ */
+export interface ApiTaskApproval {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiTaskApproval
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiTaskApproval
+     */
+    userId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiTaskApproval
+     */
+    taskId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiTaskApproval
+     */
+    taskTitle: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiTaskApproval
+     */
+    forDate: Date;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiTaskApproval
+     */
+    comment?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiTaskApproval
+     */
+    claimed: Date;
+}
+
+/**
+ * Check if a given object implements the ApiTaskApproval interface.
+ */
+export function instanceOfApiTaskApproval(value: object): boolean {
+    if (!('id' in value)) return false;
+    if (!('userId' in value)) return false;
+    if (!('taskId' in value)) return false;
+    if (!('taskTitle' in value)) return false;
+    if (!('forDate' in value)) return false;
+    if (!('claimed' in value)) return false;
+    return true;
+}
+
+export function ApiTaskApprovalFromJSON(json: any): ApiTaskApproval {
+    return ApiTaskApprovalFromJSONTyped(json, false);
+}
+
+export function ApiTaskApprovalFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiTaskApproval {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'userId': json['userId'],
+        'taskId': json['taskId'],
+        'taskTitle': json['taskTitle'],
+        'forDate': (new Date(json['forDate'])),
+        'comment': json['comment'] == null ? undefined : json['comment'],
+        'claimed': (new Date(json['claimed'])),
+    };
+}
+
+export function ApiTaskApprovalToJSON(value?: ApiTaskApproval | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'userId': value['userId'],
+        'taskId': value['taskId'],
+        'taskTitle': value['taskTitle'],
+        'forDate': ((value['forDate']).toISOString()),
+        'comment': value['comment'],
+        'claimed': ((value['claimed']).toISOString()),
+    };
+}
+
@@ -0,0 +1,99 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiWeekDay } from './ApiWeekDay';
+import {
+    ApiWeekDayFromJSON,
+    ApiWeekDayFromJSONTyped,
+    ApiWeekDayToJSON,
+} from './ApiWeekDay';
+
+/**
+ * When a task is due, a task without a schedule is due every day
+ * @export
+ * @interface ApiTaskSchedule
+ */
+export interface ApiTaskSchedule {
+    /**
+     * Days of the week the task is due on, all days when empty
+     * @type {Array<ApiWeekDay>}
+     * @memberof ApiTaskSchedule
+     */
+    weekDays?: Array<ApiWeekDay>;
+    /**
+     * Task is due every N days, counting from the start date (or the task creation date)
+     * @type {number}
+     * @memberof ApiTaskSchedule
+     */
+    everyNDays?: number;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiTaskSchedule
+     */
+    startDate?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiTaskSchedule
+     */
+    endDate?: Date;
+    /**
+     * Specific dates the task is not due on (e.g. holidays)
+     * @type {Array<Date>}
+     * @memberof ApiTaskSchedule
+     */
+    excludedDates?: Array<Date>;
+}
+
+/**
+ * Check if a given object implements the ApiTaskSchedule interface.
+ */
+export function instanceOfApiTaskSchedule(value: object): boolean {
+    return true;
+}
+
+export function ApiTaskScheduleFromJSON(json: any): ApiTaskSchedule {
+    return ApiTaskScheduleFromJSONTyped(json, false);
+}
+
+export function ApiTaskScheduleFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiTaskSchedule {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'weekDays': json['weekDays'] == null ? undefined : ((json['weekDays'] as Array<any>).map(ApiWeekDayFromJSON)),
+        'everyNDays': json['everyNDays'] == null ? undefined : json['everyNDays'],
+        'startDate': json['startDate'] == null ? undefined : (new Date(json['startDate'])),
+        'endDate': json['endDate'] == null ? undefined : (new Date(json['endDate'])),
+        'excludedDates': json['excludedDates'] == null ? undefined : json['excludedDates'],
+    };
+}
+
+export function ApiTaskScheduleToJSON(value?: ApiTaskSchedule | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'weekDays': value['weekDays'] == null ? undefined : ((value['weekDays'] as Array<any>).map(ApiWeekDayToJSON)),
+        'everyNDays': value['everyNDays'],
+        'startDate': value['startDate'] == null ? undefined : ((value['startDate']).toISOString()),
+        'endDate': value['endDate'] == null ? undefined : ((value['endDate']).toISOString()),
+        'excludedDates': value['excludedDates'],
+    };
+}
+
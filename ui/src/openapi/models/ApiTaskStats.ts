@@ -13,6 +13,13 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiProblemSetProgress } from './ApiProblemSetProgress';
+import {
+    ApiProblemSetProgressFromJSON,
+    ApiProblemSetProgressFromJSONTyped,
+    ApiProblemSetProgressToJSON,
+} from './ApiProblemSetProgress';
+
 /**
  * 
  * @export
@@ -32,17 +39,29 @@ export interface ApiTaskStats {
      */
     userId: string;
     /**
-     * 
+     * Tasks done, not including the ones pending approval
      * @type {number}
      * @memberof ApiTaskStats
      */
     doneTasksCount: number;
+    /**
+     * Tasks marked done, including the ones pending approval
+     * @type {number}
+     * @memberof ApiTaskStats
+     */
+    claimedTasksCount: number;
     /**
      * 
      * @type {number}
      * @memberof ApiTaskStats
      */
     totalTasksCount: number;
+    /**
+     * 
+     * @type {Array<ApiProblemSetProgress>}
+     * @memberof ApiTaskStats
+     */
+    problemSets?: Array<ApiProblemSetProgress>;
 }
 
 /**
@@ -52,6 +71,7 @@ export function instanceOfApiTaskStats(value: object): boolean {
     if (!('forDate' in value)) return false;
     if (!('userId' in value)) return false;
     if (!('doneTasksCount' in value)) return false;
+    if (!('claimedTasksCount' in value)) return false;
     if (!('totalTasksCount' in value)) return false;
     return true;
 }
@@ -69,7 +89,9 @@ export function ApiTaskStatsFromJSONTyped(json: any, ignoreDiscriminator: boolea
         'forDate': (new Date(json['forDate'])),
         'userId': json['userId'],
         'doneTasksCount': json['doneTasksCount'],
+        'claimedTasksCount': json['claimedTasksCount'],
         'totalTasksCount': json['totalTasksCount'],
+        'problemSets': json['problemSets'] == null ? undefined : ((json['problemSets'] as Array<any>).map(ApiProblemSetProgressFromJSON)),
     };
 }
 
@@ -82,7 +104,9 @@ export function ApiTaskStatsToJSON(value?: ApiTaskStats | null): any {
         'forDate': ((value['forDate']).toISOString()),
         'userId': value['userId'],
         'doneTasksCount': value['doneTasksCount'],
+        'claimedTasksCount': value['claimedTasksCount'],
         'totalTasksCount': value['totalTasksCount'],
+        'problemSets': value['problemSets'] == null ? undefined : ((value['problemSets'] as Array<any>).map(ApiProblemSetProgressToJSON)),
     };
 }
 
@@ -0,0 +1,77 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiFamilyRole } from './ApiFamilyRole';
+import {
+    ApiFamilyRoleFromJSON,
+    ApiFamilyRoleFromJSONTyped,
+    ApiFamilyRoleToJSON,
+} from './ApiFamilyRole';
+
+/**
+ * 
+ * @export
+ * @interface ApiUpdateFamilyMemberRoleCommandArgs
+ */
+export interface ApiUpdateFamilyMemberRoleCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateFamilyMemberRoleCommandArgs
+     */
+    userId: string;
+    /**
+     * 
+     * @type {ApiFamilyRole}
+     * @memberof ApiUpdateFamilyMemberRoleCommandArgs
+     */
+    role: ApiFamilyRole;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateFamilyMemberRoleCommandArgs interface.
+ */
+export function instanceOfApiUpdateFamilyMemberRoleCommandArgs(value: object): boolean {
+    if (!('userId' in value)) return false;
+    if (!('role' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateFamilyMemberRoleCommandArgsFromJSON(json: any): ApiUpdateFamilyMemberRoleCommandArgs {
+    return ApiUpdateFamilyMemberRoleCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateFamilyMemberRoleCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateFamilyMemberRoleCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'userId': json['userId'],
+        'role': ApiFamilyRoleFromJSON(json['role']),
+    };
+}
+
+export function ApiUpdateFamilyMemberRoleCommandArgsToJSON(value?: ApiUpdateFamilyMemberRoleCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'userId': value['userId'],
+        'role': ApiFamilyRoleToJSON(value['role']),
+    };
+}
+
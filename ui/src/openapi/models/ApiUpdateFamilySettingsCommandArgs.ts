@@ -0,0 +1,77 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiUpdateFamilySettingsCommandArgs
+ */
+export interface ApiUpdateFamilySettingsCommandArgs {
+    /**
+     * How many days back admins can update the task status of family members
+     * @type {number}
+     * @memberof ApiUpdateFamilySettingsCommandArgs
+     */
+    taskBackEditDays: number;
+    /**
+     * How many AI calls the whole family can make a day, unchanged when missing
+     * @type {number}
+     * @memberof ApiUpdateFamilySettingsCommandArgs
+     */
+    aiFamilyDailyCallsLimit?: number;
+    /**
+     * How many AI calls each family member can make a day, unchanged when missing
+     * @type {number}
+     * @memberof ApiUpdateFamilySettingsCommandArgs
+     */
+    aiUserDailyCallsLimit?: number;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateFamilySettingsCommandArgs interface.
+ */
+export function instanceOfApiUpdateFamilySettingsCommandArgs(value: object): boolean {
+    if (!('taskBackEditDays' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateFamilySettingsCommandArgsFromJSON(json: any): ApiUpdateFamilySettingsCommandArgs {
+    return ApiUpdateFamilySettingsCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateFamilySettingsCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateFamilySettingsCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'taskBackEditDays': json['taskBackEditDays'],
+        'aiFamilyDailyCallsLimit': json['aiFamilyDailyCallsLimit'] == null ? undefined : json['aiFamilyDailyCallsLimit'],
+        'aiUserDailyCallsLimit': json['aiUserDailyCallsLimit'] == null ? undefined : json['aiUserDailyCallsLimit'],
+    };
+}
+
+export function ApiUpdateFamilySettingsCommandArgsToJSON(value?: ApiUpdateFamilySettingsCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'taskBackEditDays': value['taskBackEditDays'],
+        'aiFamilyDailyCallsLimit': value['aiFamilyDailyCallsLimit'],
+        'aiUserDailyCallsLimit': value['aiUserDailyCallsLimit'],
+    };
+}
+
@@ -26,6 +26,12 @@ import {
  * @interface ApiUpdateFamilyTaskCommandArgs
  */
 export interface ApiUpdateFamilyTaskCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateFamilyTaskCommandArgs
+     */
+    taskId: string;
     /**
      * 
      * @type {ApiFamilyTask}
@@ -38,6 +44,7 @@ export interface ApiUpdateFamilyTaskCommandArgs {
  * Check if a given object implements the ApiUpdateFamilyTaskCommandArgs interface.
  */
 export function instanceOfApiUpdateFamilyTaskCommandArgs(value: object): boolean {
+    if (!('taskId' in value)) return false;
     if (!('task' in value)) return false;
     return true;
 }
@@ -52,6 +59,7 @@ export function ApiUpdateFamilyTaskCommandArgsFromJSONTyped(json: any, ignoreDis
     }
     return {
         
+        'taskId': json['taskId'],
         'task': ApiFamilyTaskFromJSON(json['task']),
     };
 }
@@ -62,6 +70,7 @@ export function ApiUpdateFamilyTaskCommandArgsToJSON(value?: ApiUpdateFamilyTask
     }
     return {
         
+        'taskId': value['taskId'],
         'task': ApiFamilyTaskToJSON(value['task']),
     };
 }
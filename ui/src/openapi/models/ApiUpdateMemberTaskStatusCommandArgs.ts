@@ -0,0 +1,103 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiAssignmentStatus } from './ApiAssignmentStatus';
+import {
+    ApiAssignmentStatusFromJSON,
+    ApiAssignmentStatusFromJSONTyped,
+    ApiAssignmentStatusToJSON,
+} from './ApiAssignmentStatus';
+
+/**
+ * 
+ * @export
+ * @interface ApiUpdateMemberTaskStatusCommandArgs
+ */
+export interface ApiUpdateMemberTaskStatusCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateMemberTaskStatusCommandArgs
+     */
+    userId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateMemberTaskStatusCommandArgs
+     */
+    taskId: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiUpdateMemberTaskStatusCommandArgs
+     */
+    forDate: Date;
+    /**
+     * 
+     * @type {ApiAssignmentStatus}
+     * @memberof ApiUpdateMemberTaskStatusCommandArgs
+     */
+    status: ApiAssignmentStatus;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateMemberTaskStatusCommandArgs
+     */
+    comment?: string;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateMemberTaskStatusCommandArgs interface.
+ */
+export function instanceOfApiUpdateMemberTaskStatusCommandArgs(value: object): boolean {
+    if (!('userId' in value)) return false;
+    if (!('taskId' in value)) return false;
+    if (!('forDate' in value)) return false;
+    if (!('status' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateMemberTaskStatusCommandArgsFromJSON(json: any): ApiUpdateMemberTaskStatusCommandArgs {
+    return ApiUpdateMemberTaskStatusCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateMemberTaskStatusCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateMemberTaskStatusCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'userId': json['userId'],
+        'taskId': json['taskId'],
+        'forDate': (new Date(json['forDate'])),
+        'status': ApiAssignmentStatusFromJSON(json['status']),
+        'comment': json['comment'] == null ? undefined : json['comment'],
+    };
+}
+
+export function ApiUpdateMemberTaskStatusCommandArgsToJSON(value?: ApiUpdateMemberTaskStatusCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'userId': value['userId'],
+        'taskId': value['taskId'],
+        'forDate': ((value['forDate']).toISOString()),
+        'status': ApiAssignmentStatusToJSON(value['status']),
+        'comment': value['comment'],
+    };
+}
+
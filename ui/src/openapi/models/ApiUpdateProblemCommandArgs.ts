@@ -0,0 +1,95 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemForEdit } from './ApiProblemForEdit';
+import {
+    ApiProblemForEditFromJSON,
+    ApiProblemForEditFromJSONTyped,
+    ApiProblemForEditToJSON,
+} from './ApiProblemForEdit';
+
+/**
+ * 
+ * @export
+ * @interface ApiUpdateProblemCommandArgs
+ */
+export interface ApiUpdateProblemCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemCommandArgs
+     */
+    problemSetId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemCommandArgs
+     */
+    forUserId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemCommandArgs
+     */
+    problemId: string;
+    /**
+     * 
+     * @type {ApiProblemForEdit}
+     * @memberof ApiUpdateProblemCommandArgs
+     */
+    problem: ApiProblemForEdit;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateProblemCommandArgs interface.
+ */
+export function instanceOfApiUpdateProblemCommandArgs(value: object): boolean {
+    if (!('problemSetId' in value)) return false;
+    if (!('forUserId' in value)) return false;
+    if (!('problemId' in value)) return false;
+    if (!('problem' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateProblemCommandArgsFromJSON(json: any): ApiUpdateProblemCommandArgs {
+    return ApiUpdateProblemCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateProblemCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateProblemCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'],
+        'forUserId': json['forUserId'],
+        'problemId': json['problemId'],
+        'problem': ApiProblemForEditFromJSON(json['problem']),
+    };
+}
+
+export function ApiUpdateProblemCommandArgsToJSON(value?: ApiUpdateProblemCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'forUserId': value['forUserId'],
+        'problemId': value['problemId'],
+        'problem': ApiProblemForEditToJSON(value['problem']),
+    };
+}
+
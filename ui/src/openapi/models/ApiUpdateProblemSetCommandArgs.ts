@@ -0,0 +1,126 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemSetQuotaType } from './ApiProblemSetQuotaType';
+import {
+    ApiProblemSetQuotaTypeFromJSON,
+    ApiProblemSetQuotaTypeFromJSONTyped,
+    ApiProblemSetQuotaTypeToJSON,
+} from './ApiProblemSetQuotaType';
+
+/**
+ * 
+ * @export
+ * @interface ApiUpdateProblemSetCommandArgs
+ */
+export interface ApiUpdateProblemSetCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetCommandArgs
+     */
+    problemSetId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetCommandArgs
+     */
+    title: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetCommandArgs
+     */
+    forUserId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetCommandArgs
+     */
+    description?: string;
+    /**
+     * Points earned for each correctly solved problem
+     * @type {number}
+     * @memberof ApiUpdateProblemSetCommandArgs
+     */
+    points?: number;
+    /**
+     * Number of problems that complete the daily assignment, defaults to 1
+     * @type {number}
+     * @memberof ApiUpdateProblemSetCommandArgs
+     */
+    dailyQuota?: number;
+    /**
+     * 
+     * @type {ApiProblemSetQuotaType}
+     * @memberof ApiUpdateProblemSetCommandArgs
+     */
+    quotaType?: ApiProblemSetQuotaType;
+    /**
+     * Number of answers allowed for each problem until it is answered correctly, defaults to 1
+     * @type {number}
+     * @memberof ApiUpdateProblemSetCommandArgs
+     */
+    maxAttempts?: number;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateProblemSetCommandArgs interface.
+ */
+export function instanceOfApiUpdateProblemSetCommandArgs(value: object): boolean {
+    if (!('problemSetId' in value)) return false;
+    if (!('title' in value)) return false;
+    if (!('forUserId' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateProblemSetCommandArgsFromJSON(json: any): ApiUpdateProblemSetCommandArgs {
+    return ApiUpdateProblemSetCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateProblemSetCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateProblemSetCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'],
+        'title': json['title'],
+        'forUserId': json['forUserId'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'points': json['points'] == null ? undefined : json['points'],
+        'dailyQuota': json['dailyQuota'] == null ? undefined : json['dailyQuota'],
+        'quotaType': json['quotaType'] == null ? undefined : ApiProblemSetQuotaTypeFromJSON(json['quotaType']),
+        'maxAttempts': json['maxAttempts'] == null ? undefined : json['maxAttempts'],
+    };
+}
+
+export function ApiUpdateProblemSetCommandArgsToJSON(value?: ApiUpdateProblemSetCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'title': value['title'],
+        'forUserId': value['forUserId'],
+        'description': value['description'],
+        'points': value['points'],
+        'dailyQuota': value['dailyQuota'],
+        'quotaType': ApiProblemSetQuotaTypeToJSON(value['quotaType']),
+        'maxAttempts': value['maxAttempts'],
+    };
+}
+
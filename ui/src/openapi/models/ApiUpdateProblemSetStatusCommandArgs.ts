@@ -0,0 +1,86 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiLifecycleStatus } from './ApiLifecycleStatus';
+import {
+    ApiLifecycleStatusFromJSON,
+    ApiLifecycleStatusFromJSONTyped,
+    ApiLifecycleStatusToJSON,
+} from './ApiLifecycleStatus';
+
+/**
+ * 
+ * @export
+ * @interface ApiUpdateProblemSetStatusCommandArgs
+ */
+export interface ApiUpdateProblemSetStatusCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetStatusCommandArgs
+     */
+    problemSetId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetStatusCommandArgs
+     */
+    forUserId: string;
+    /**
+     * 
+     * @type {ApiLifecycleStatus}
+     * @memberof ApiUpdateProblemSetStatusCommandArgs
+     */
+    status: ApiLifecycleStatus;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateProblemSetStatusCommandArgs interface.
+ */
+export function instanceOfApiUpdateProblemSetStatusCommandArgs(value: object): boolean {
+    if (!('problemSetId' in value)) return false;
+    if (!('forUserId' in value)) return false;
+    if (!('status' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateProblemSetStatusCommandArgsFromJSON(json: any): ApiUpdateProblemSetStatusCommandArgs {
+    return ApiUpdateProblemSetStatusCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateProblemSetStatusCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateProblemSetStatusCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'],
+        'forUserId': json['forUserId'],
+        'status': ApiLifecycleStatusFromJSON(json['status']),
+    };
+}
+
+export function ApiUpdateProblemSetStatusCommandArgsToJSON(value?: ApiUpdateProblemSetStatusCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'forUserId': value['forUserId'],
+        'status': ApiLifecycleStatusToJSON(value['status']),
+    };
+}
+
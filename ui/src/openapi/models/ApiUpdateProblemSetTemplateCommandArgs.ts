@@ -0,0 +1,117 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemSetQuotaType } from './ApiProblemSetQuotaType';
+import {
+    ApiProblemSetQuotaTypeFromJSON,
+    ApiProblemSetQuotaTypeFromJSONTyped,
+    ApiProblemSetQuotaTypeToJSON,
+} from './ApiProblemSetQuotaType';
+
+/**
+ * 
+ * @export
+ * @interface ApiUpdateProblemSetTemplateCommandArgs
+ */
+export interface ApiUpdateProblemSetTemplateCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetTemplateCommandArgs
+     */
+    templateId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetTemplateCommandArgs
+     */
+    title: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemSetTemplateCommandArgs
+     */
+    description?: string;
+    /**
+     * Points earned for each correctly solved problem
+     * @type {number}
+     * @memberof ApiUpdateProblemSetTemplateCommandArgs
+     */
+    points?: number;
+    /**
+     * Number of problems that complete the daily assignment, defaults to 1
+     * @type {number}
+     * @memberof ApiUpdateProblemSetTemplateCommandArgs
+     */
+    dailyQuota?: number;
+    /**
+     * 
+     * @type {ApiProblemSetQuotaType}
+     * @memberof ApiUpdateProblemSetTemplateCommandArgs
+     */
+    quotaType?: ApiProblemSetQuotaType;
+    /**
+     * Number of answers allowed for each problem until it is answered correctly, defaults to 1
+     * @type {number}
+     * @memberof ApiUpdateProblemSetTemplateCommandArgs
+     */
+    maxAttempts?: number;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateProblemSetTemplateCommandArgs interface.
+ */
+export function instanceOfApiUpdateProblemSetTemplateCommandArgs(value: object): boolean {
+    if (!('templateId' in value)) return false;
+    if (!('title' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateProblemSetTemplateCommandArgsFromJSON(json: any): ApiUpdateProblemSetTemplateCommandArgs {
+    return ApiUpdateProblemSetTemplateCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateProblemSetTemplateCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateProblemSetTemplateCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'templateId': json['templateId'],
+        'title': json['title'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'points': json['points'] == null ? undefined : json['points'],
+        'dailyQuota': json['dailyQuota'] == null ? undefined : json['dailyQuota'],
+        'quotaType': json['quotaType'] == null ? undefined : ApiProblemSetQuotaTypeFromJSON(json['quotaType']),
+        'maxAttempts': json['maxAttempts'] == null ? undefined : json['maxAttempts'],
+    };
+}
+
+export function ApiUpdateProblemSetTemplateCommandArgsToJSON(value?: ApiUpdateProblemSetTemplateCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'templateId': value['templateId'],
+        'title': value['title'],
+        'description': value['description'],
+        'points': value['points'],
+        'dailyQuota': value['dailyQuota'],
+        'quotaType': ApiProblemSetQuotaTypeToJSON(value['quotaType']),
+        'maxAttempts': value['maxAttempts'],
+    };
+}
+
@@ -0,0 +1,95 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiLifecycleStatus } from './ApiLifecycleStatus';
+import {
+    ApiLifecycleStatusFromJSON,
+    ApiLifecycleStatusFromJSONTyped,
+    ApiLifecycleStatusToJSON,
+} from './ApiLifecycleStatus';
+
+/**
+ * 
+ * @export
+ * @interface ApiUpdateProblemStatusCommandArgs
+ */
+export interface ApiUpdateProblemStatusCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemStatusCommandArgs
+     */
+    problemSetId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemStatusCommandArgs
+     */
+    forUserId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateProblemStatusCommandArgs
+     */
+    problemId: string;
+    /**
+     * 
+     * @type {ApiLifecycleStatus}
+     * @memberof ApiUpdateProblemStatusCommandArgs
+     */
+    status: ApiLifecycleStatus;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateProblemStatusCommandArgs interface.
+ */
+export function instanceOfApiUpdateProblemStatusCommandArgs(value: object): boolean {
+    if (!('problemSetId' in value)) return false;
+    if (!('forUserId' in value)) return false;
+    if (!('problemId' in value)) return false;
+    if (!('status' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateProblemStatusCommandArgsFromJSON(json: any): ApiUpdateProblemStatusCommandArgs {
+    return ApiUpdateProblemStatusCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateProblemStatusCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateProblemStatusCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'problemSetId': json['problemSetId'],
+        'forUserId': json['forUserId'],
+        'problemId': json['problemId'],
+        'status': ApiLifecycleStatusFromJSON(json['status']),
+    };
+}
+
+export function ApiUpdateProblemStatusCommandArgsToJSON(value?: ApiUpdateProblemStatusCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'problemSetId': value['problemSetId'],
+        'forUserId': value['forUserId'],
+        'problemId': value['problemId'],
+        'status': ApiLifecycleStatusToJSON(value['status']),
+    };
+}
+
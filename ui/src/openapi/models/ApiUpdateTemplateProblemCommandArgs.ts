@@ -0,0 +1,86 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiProblemForEdit } from './ApiProblemForEdit';
+import {
+    ApiProblemForEditFromJSON,
+    ApiProblemForEditFromJSONTyped,
+    ApiProblemForEditToJSON,
+} from './ApiProblemForEdit';
+
+/**
+ * 
+ * @export
+ * @interface ApiUpdateTemplateProblemCommandArgs
+ */
+export interface ApiUpdateTemplateProblemCommandArgs {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateTemplateProblemCommandArgs
+     */
+    templateId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUpdateTemplateProblemCommandArgs
+     */
+    problemId: string;
+    /**
+     * 
+     * @type {ApiProblemForEdit}
+     * @memberof ApiUpdateTemplateProblemCommandArgs
+     */
+    problem: ApiProblemForEdit;
+}
+
+/**
+ * Check if a given object implements the ApiUpdateTemplateProblemCommandArgs interface.
+ */
+export function instanceOfApiUpdateTemplateProblemCommandArgs(value: object): boolean {
+    if (!('templateId' in value)) return false;
+    if (!('problemId' in value)) return false;
+    if (!('problem' in value)) return false;
+    return true;
+}
+
+export function ApiUpdateTemplateProblemCommandArgsFromJSON(json: any): ApiUpdateTemplateProblemCommandArgs {
+    return ApiUpdateTemplateProblemCommandArgsFromJSONTyped(json, false);
+}
+
+export function ApiUpdateTemplateProblemCommandArgsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUpdateTemplateProblemCommandArgs {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'templateId': json['templateId'],
+        'problemId': json['problemId'],
+        'problem': ApiProblemForEditFromJSON(json['problem']),
+    };
+}
+
+export function ApiUpdateTemplateProblemCommandArgsToJSON(value?: ApiUpdateTemplateProblemCommandArgs | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'templateId': value['templateId'],
+        'problemId': value['problemId'],
+        'problem': ApiProblemForEditToJSON(value['problem']),
+    };
+}
+
@@ -0,0 +1,110 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiBadge } from './ApiBadge';
+import {
+    ApiBadgeFromJSON,
+    ApiBadgeFromJSONTyped,
+    ApiBadgeToJSON,
+} from './ApiBadge';
+import type { ApiStreak } from './ApiStreak';
+import {
+    ApiStreakFromJSON,
+    ApiStreakFromJSONTyped,
+    ApiStreakToJSON,
+} from './ApiStreak';
+
+/**
+ * 
+ * @export
+ * @interface ApiUserAchievements
+ */
+export interface ApiUserAchievements {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUserAchievements
+     */
+    userId: string;
+    /**
+     * 
+     * @type {ApiStreak}
+     * @memberof ApiUserAchievements
+     */
+    tasksStreak: ApiStreak;
+    /**
+     * 
+     * @type {ApiStreak}
+     * @memberof ApiUserAchievements
+     */
+    problemsStreak: ApiStreak;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiUserAchievements
+     */
+    problemsSolvedCount: number;
+    /**
+     * 
+     * @type {Array<ApiBadge>}
+     * @memberof ApiUserAchievements
+     */
+    badges: Array<ApiBadge>;
+}
+
+/**
+ * Check if a given object implements the ApiUserAchievements interface.
+ */
+export function instanceOfApiUserAchievements(value: object): boolean {
+    if (!('userId' in value)) return false;
+    if (!('tasksStreak' in value)) return false;
+    if (!('problemsStreak' in value)) return false;
+    if (!('problemsSolvedCount' in value)) return false;
+    if (!('badges' in value)) return false;
+    return true;
+}
+
+export function ApiUserAchievementsFromJSON(json: any): ApiUserAchievements {
+    return ApiUserAchievementsFromJSONTyped(json, false);
+}
+
+export function ApiUserAchievementsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiUserAchievements {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'userId': json['userId'],
+        'tasksStreak': ApiStreakFromJSON(json['tasksStreak']),
+        'problemsStreak': ApiStreakFromJSON(json['problemsStreak']),
+        'problemsSolvedCount': json['problemsSolvedCount'],
+        'badges': ((json['badges'] as Array<any>).map(ApiBadgeFromJSON)),
+    };
+}
+
+export function ApiUserAchievementsToJSON(value?: ApiUserAchievements | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'userId': value['userId'],
+        'tasksStreak': ApiStreakToJSON(value['tasksStreak']),
+        'problemsStreak': ApiStreakToJSON(value['problemsStreak']),
+        'problemsSolvedCount': value['problemsSolvedCount'],
+        'badges': ((value['badges'] as Array<any>).map(ApiBadgeToJSON)),
+    };
+}
+
@@ -43,6 +43,18 @@ export interface ApiUserProblemSolution {
      * @memberof ApiUserProblemSolution
      */
     userProvidedAnswerId: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof ApiUserProblemSolution
+     */
+    userProvidedAnswerIds?: Array<string>;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiUserProblemSolution
+     */
+    userProvidedValue?: string;
     /**
      * 
      * @type {string}
@@ -55,6 +67,24 @@ export interface ApiUserProblemSolution {
      * @memberof ApiUserProblemSolution
      */
     correct: boolean;
+    /**
+     * Number of hints revealed before answering, 0 when solved alone
+     * @type {number}
+     * @memberof ApiUserProblemSolution
+     */
+    hintsUsed: number;
+    /**
+     * Number of answers submitted, the user provided answer is the last one
+     * @type {number}
+     * @memberof ApiUserProblemSolution
+     */
+    attemptsCount: number;
+    /**
+     * Answered wrong with attempts left, the problem is presented again
+     * @type {boolean}
+     * @memberof ApiUserProblemSolution
+     */
+    inProgress: boolean;
 }
 
 /**
@@ -67,6 +97,9 @@ export function instanceOfApiUserProblemSolution(value: object): boolean {
     if (!('userProvidedAnswerId' in value)) return false;
     if (!('correctAnswerId' in value)) return false;
     if (!('correct' in value)) return false;
+    if (!('hintsUsed' in value)) return false;
+    if (!('attemptsCount' in value)) return false;
+    if (!('inProgress' in value)) return false;
     return true;
 }
 
@@ -84,8 +117,13 @@ export function ApiUserProblemSolutionFromJSONTyped(json: any, ignoreDiscriminat
         'problemTitle': json['problemTitle'],
         'solvedDate': (new Date(json['solvedDate'])),
         'userProvidedAnswerId': json['userProvidedAnswerId'],
+        'userProvidedAnswerIds': json['userProvidedAnswerIds'] == null ? undefined : json['userProvidedAnswerIds'],
+        'userProvidedValue': json['userProvidedValue'] == null ? undefined : json['userProvidedValue'],
         'correctAnswerId': json['correctAnswerId'],
         'correct': json['correct'],
+        'hintsUsed': json['hintsUsed'],
+        'attemptsCount': json['attemptsCount'],
+        'inProgress': json['inProgress'],
     };
 }
 
@@ -99,8 +137,13 @@ export function ApiUserProblemSolutionToJSON(value?: ApiUserProblemSolution | nu
         'problemTitle': value['problemTitle'],
         'solvedDate': ((value['solvedDate']).toISOString()),
         'userProvidedAnswerId': value['userProvidedAnswerId'],
+        'userProvidedAnswerIds': value['userProvidedAnswerIds'],
+        'userProvidedValue': value['userProvidedValue'],
         'correctAnswerId': value['correctAnswerId'],
         'correct': value['correct'],
+        'hintsUsed': value['hintsUsed'],
+        'attemptsCount': value['attemptsCount'],
+        'inProgress': value['inProgress'],
     };
 }
 
@@ -0,0 +1,43 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * 
+ * @export
+ */
+export const ApiWeekDay = {
+    Sunday: 'sunday',
+    Monday: 'monday',
+    Tuesday: 'tuesday',
+    Wednesday: 'wednesday',
+    Thursday: 'thursday',
+    Friday: 'friday',
+    Saturday: 'saturday'
+} as const;
+export type ApiWeekDay = typeof ApiWeekDay[keyof typeof ApiWeekDay];
+
+
+export function ApiWeekDayFromJSON(json: any): ApiWeekDay {
+    return ApiWeekDayFromJSONTyped(json, false);
+}
+
+export function ApiWeekDayFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiWeekDay {
+    return json as ApiWeekDay;
+}
+
+export function ApiWeekDayToJSON(value?: ApiWeekDay | null): any {
+    return value as any;
+}
+
@@ -62,6 +62,24 @@ export interface UIFamilyInfo {
      * @memberof UIFamilyInfo
      */
     tasks: Array<UIFamilyTask>;
+    /**
+     * How many days back admins can update the task status of family members
+     * @type {number}
+     * @memberof UIFamilyInfo
+     */
+    taskBackEditDays: number;
+    /**
+     * How many AI calls the whole family can make a day
+     * @type {number}
+     * @memberof UIFamilyInfo
+     */
+    aiFamilyDailyCallsLimit: number;
+    /**
+     * How many AI calls each family member can make a day
+     * @type {number}
+     * @memberof UIFamilyInfo
+     */
+    aiUserDailyCallsLimit: number;
 }
 
 /**
@@ -73,6 +91,9 @@ export function instanceOfUIFamilyInfo(value: object): boolean {
     if (!('familyDisplayName' in value)) return false;
     if (!('members' in value)) return false;
     if (!('tasks' in value)) return false;
+    if (!('taskBackEditDays' in value)) return false;
+    if (!('aiFamilyDailyCallsLimit' in value)) return false;
+    if (!('aiUserDailyCallsLimit' in value)) return false;
     return true;
 }
 
@@ -91,6 +112,9 @@ export function UIFamilyInfoFromJSONTyped(json: any, ignoreDiscriminator: boolea
         'familyDisplayName': json['familyDisplayName'],
         'members': ((json['members'] as Array<any>).map(UIFamilyMemberFromJSON)),
         'tasks': ((json['tasks'] as Array<any>).map(UIFamilyTaskFromJSON)),
+        'taskBackEditDays': json['taskBackEditDays'],
+        'aiFamilyDailyCallsLimit': json['aiFamilyDailyCallsLimit'],
+        'aiUserDailyCallsLimit': json['aiUserDailyCallsLimit'],
     };
 }
 
@@ -105,6 +129,9 @@ export function UIFamilyInfoToJSON(value?: UIFamilyInfo | null): any {
         'familyDisplayName': value['familyDisplayName'],
         'members': ((value['members'] as Array<any>).map(UIFamilyMemberToJSON)),
         'tasks': ((value['tasks'] as Array<any>).map(UIFamilyTaskToJSON)),
+        'taskBackEditDays': value['taskBackEditDays'],
+        'aiFamilyDailyCallsLimit': value['aiFamilyDailyCallsLimit'],
+        'aiUserDailyCallsLimit': value['aiUserDailyCallsLimit'],
     };
 }
 
@@ -13,6 +13,13 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiTaskSchedule } from './ApiTaskSchedule';
+import {
+    ApiTaskScheduleFromJSON,
+    ApiTaskScheduleFromJSONTyped,
+    ApiTaskScheduleToJSON,
+} from './ApiTaskSchedule';
+
 /**
  * 
  * @export
@@ -43,6 +50,24 @@ export interface UIFamilyTask {
      * @memberof UIFamilyTask
      */
     memberIds: Array<string>;
+    /**
+     * 
+     * @type {ApiTaskSchedule}
+     * @memberof UIFamilyTask
+     */
+    schedule?: ApiTaskSchedule;
+    /**
+     * Points earned each time the task is done
+     * @type {number}
+     * @memberof UIFamilyTask
+     */
+    points?: number;
+    /**
+     * Marking the task done requires an admin approval
+     * @type {boolean}
+     * @memberof UIFamilyTask
+     */
+    requiresApproval?: boolean;
 }
 
 /**
@@ -69,6 +94,9 @@ export function UIFamilyTaskFromJSONTyped(json: any, ignoreDiscriminator: boolea
         'title': json['title'],
         'description': json['description'] == null ? undefined : json['description'],
         'memberIds': json['memberIds'],
+        'schedule': json['schedule'] == null ? undefined : ApiTaskScheduleFromJSON(json['schedule']),
+        'points': json['points'] == null ? undefined : json['points'],
+        'requiresApproval': json['requiresApproval'] == null ? undefined : json['requiresApproval'],
     };
 }
 
@@ -82,6 +110,9 @@ export function UIFamilyTaskToJSON(value?: UIFamilyTask | null): any {
         'title': value['title'],
         'description': value['description'],
         'memberIds': value['memberIds'],
+        'schedule': ApiTaskScheduleToJSON(value['schedule']),
+        'points': value['points'],
+        'requiresApproval': value['requiresApproval'],
     };
 }
 
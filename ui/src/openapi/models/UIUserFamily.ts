@@ -0,0 +1,95 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * ShpanKids API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiFamilyRole } from './ApiFamilyRole';
+import {
+    ApiFamilyRoleFromJSON,
+    ApiFamilyRoleFromJSONTyped,
+    ApiFamilyRoleToJSON,
+} from './ApiFamilyRole';
+
+/**
+ * A family the user is a member of
+ * @export
+ * @interface UIUserFamily
+ */
+export interface UIUserFamily {
+    /**
+     * 
+     * @type {string}
+     * @memberof UIUserFamily
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof UIUserFamily
+     */
+    name: string;
+    /**
+     * 
+     * @type {ApiFamilyRole}
+     * @memberof UIUserFamily
+     */
+    role: ApiFamilyRole;
+    /**
+     * True for the family currently selected in the user session
+     * @type {boolean}
+     * @memberof UIUserFamily
+     */
+    active: boolean;
+}
+
+/**
+ * Check if a given object implements the UIUserFamily interface.
+ */
+export function instanceOfUIUserFamily(value: object): boolean {
+    if (!('id' in value)) return false;
+    if (!('name' in value)) return false;
+    if (!('role' in value)) return false;
+    if (!('active' in value)) return false;
+    return true;
+}
+
+export function UIUserFamilyFromJSON(json: any): UIUserFamily {
+    return UIUserFamilyFromJSONTyped(json, false);
+}
+
+export function UIUserFamilyFromJSONTyped(json: any, ignoreDiscriminator: boolean): UIUserFamily {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'role': ApiFamilyRoleFromJSON(json['role']),
+        'active': json['active'],
+    };
+}
+
+export function UIUserFamilyToJSON(value?: UIUserFamily | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'role': ApiFamilyRoleToJSON(value['role']),
+        'active': value['active'],
+    };
+}
+
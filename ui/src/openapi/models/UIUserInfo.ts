@@ -50,6 +50,12 @@ export interface UIUserInfo {
      * @memberof UIUserInfo
      */
     lastName?: string;
+    /**
+     * True when the user is not part of any family yet and must create one or be invited
+     * @type {boolean}
+     * @memberof UIUserInfo
+     */
+    onboardingRequired?: boolean;
 }
 
 /**
@@ -75,6 +81,7 @@ export function UIUserInfoFromJSONTyped(json: any, ignoreDiscriminator: boolean)
         'role': UIUserRoleFromJSON(json['role']),
         'firstName': json['firstName'] == null ? undefined : json['firstName'],
         'lastName': json['lastName'] == null ? undefined : json['lastName'],
+        'onboardingRequired': json['onboardingRequired'] == null ? undefined : json['onboardingRequired'],
     };
 }
 
@@ -88,6 +95,7 @@ export function UIUserInfoToJSON(value?: UIUserInfo | null): any {
         'role': UIUserRoleToJSON(value['role']),
         'firstName': value['firstName'],
         'lastName': value['lastName'],
+        'onboardingRequired': value['onboardingRequired'],
     };
 }
 
@@ -1,31 +1,89 @@
 /* tslint:disable */
 /* eslint-disable */
+export * from './ApiAddFamilyMemberCommandArgs';
+export * from './ApiAiUsage';
+export * from './ApiAiUsageReport';
+export * from './ApiAssignProblemSetTemplateCommandArgs';
 export * from './ApiAssignment';
+export * from './ApiAssignmentProgress';
 export * from './ApiAssignmentStatus';
 export * from './ApiAssignmentType';
+export * from './ApiAuditEntry';
+export * from './ApiBadge';
 export * from './ApiCreateFamilyTaskCommandArgs';
+export * from './ApiCreateOwnFamilyCommandArgs';
 export * from './ApiCreateProblemSetCommandArgs';
+export * from './ApiCreateProblemSetTemplateCommandArgs';
 export * from './ApiCreateProblemsInSetCommandArgs';
+export * from './ApiCreateProblemsInTemplateCommandArgs';
+export * from './ApiCreateRewardCommandArgs';
+export * from './ApiDecideRewardRedemptionCommandArgs';
+export * from './ApiDecideTaskApprovalCommandArgs';
 export * from './ApiDeleteFamilyTaskCommandArgs';
+export * from './ApiDeleteProblemSetTemplateCommandArgs';
+export * from './ApiDeleteRewardCommandArgs';
+export * from './ApiDeleteTemplateProblemCommandArgs';
+export * from './ApiExportProblemSetCommandArgs';
+export * from './ApiExportProblemSetCommandResult';
+export * from './ApiFamilyInvitation';
 export * from './ApiFamilyRole';
 export * from './ApiFamilyTask';
 export * from './ApiGenerateProblemsCommandArgs';
+export * from './ApiImportProblemSetCommandArgs';
+export * from './ApiImportProblemSetCommandResult';
+export * from './ApiInviteFamilyMemberCommandArgs';
+export * from './ApiLifecycleStatus';
 export * from './ApiLoadProblemForAssignmentCommandArgs';
 export * from './ApiLoadProblemForAssignmentCommandResult';
+export * from './ApiNumericAnswer';
+export * from './ApiPointsBalance';
+export * from './ApiPointsEntry';
 export * from './ApiProblem';
 export * from './ApiProblemAnswer';
 export * from './ApiProblemAnswerForEdit';
 export * from './ApiProblemForEdit';
+export * from './ApiProblemImportError';
 export * from './ApiProblemSet';
+export * from './ApiProblemSetFileFormat';
+export * from './ApiProblemSetProgress';
+export * from './ApiProblemSetQuotaType';
+export * from './ApiProblemSetTemplate';
+export * from './ApiProblemType';
+export * from './ApiRedeemRewardCommandArgs';
+export * from './ApiRedemptionStatus';
 export * from './ApiRefineProblemsCommandArgs';
+export * from './ApiRemoveFamilyMemberCommandArgs';
+export * from './ApiReorderProblemsCommandArgs';
+export * from './ApiReorderTemplateProblemsCommandArgs';
+export * from './ApiRevealProblemHintCommandArgs';
+export * from './ApiRevealProblemHintCommandResp';
+export * from './ApiRevokeFamilyInvitationCommandArgs';
+export * from './ApiReward';
+export * from './ApiRewardRedemption';
+export * from './ApiStreak';
 export * from './ApiSubmitProblemAnswerCommandArgs';
 export * from './ApiSubmitProblemAnswerCommandResp';
+export * from './ApiSwitchFamilyCommandArgs';
+export * from './ApiTaskApproval';
+export * from './ApiTaskSchedule';
 export * from './ApiTaskStats';
+export * from './ApiUpdateFamilyMemberRoleCommandArgs';
+export * from './ApiUpdateFamilySettingsCommandArgs';
 export * from './ApiUpdateFamilyTaskCommandArgs';
+export * from './ApiUpdateMemberTaskStatusCommandArgs';
+export * from './ApiUpdateProblemCommandArgs';
+export * from './ApiUpdateProblemSetCommandArgs';
+export * from './ApiUpdateProblemSetStatusCommandArgs';
+export * from './ApiUpdateProblemSetTemplateCommandArgs';
+export * from './ApiUpdateProblemStatusCommandArgs';
 export * from './ApiUpdateTaskStatusCommandArgs';
+export * from './ApiUpdateTemplateProblemCommandArgs';
+export * from './ApiUserAchievements';
 export * from './ApiUserProblemSolution';
+export * from './ApiWeekDay';
 export * from './UIFamilyInfo';
 export * from './UIFamilyMember';
 export * from './UIFamilyTask';
+export * from './UIUserFamily';
 export * from './UIUserInfo';
 export * from './UIUserRole';
@@ -23,6 +23,10 @@ func Start(
 	userManager shpankids.UserManager,
 	familyManager shpankids.FamilyManager,
 	sessionManager shpankids.SessionManager,
+	onboardingManager shpankids.OnboardingManager,
+	pointsManager shpankids.PointsManager,
+	auditManager shpankids.AuditManager,
+	aiUsageManager shpankids.AiUsageManager,
 ) error {
 
 	router := mux.NewRouter().StrictSlash(true)
@@ -44,6 +48,10 @@ func Start(
 		assignmentManager,
 		familyManager,
 		sessionManager,
+		onboardingManager,
+		pointsManager,
+		auditManager,
+		aiUsageManager,
 	)
 	withStrictHandler := openapi.NewStrictHandlerWithOptions(
 		apiImpl,
@@ -75,6 +83,12 @@ func Start(
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 		slog.Info(fmt.Sprintf("User logged in: %s", email))
+
+		// Accepting pending invitations, failing to do so should not block the login
+		err = onboardingManager.OnLogin(auth.EnrichContext(r.Context(), email))
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed onboarding user %s: %v", email, err))
+		}
 		http.Redirect(w, r, "/ui", http.StatusTemporaryRedirect)
 	})
 